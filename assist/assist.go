@@ -8,22 +8,165 @@ package assist
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/prompt"
+	"logmd/vault"
 )
 
-// assistCmd represents the assist command (placeholder for Phase 3)
-// Learn: Even placeholder code should follow Go conventions and be well-documented.
-// See: https://go.dev/blog/godoc
+// Flag values for the assist command
+var (
+	// noCache bypasses the suggestion cache entirely
+	noCache bool
+	// refreshCache regenerates suggestions and overwrites the cached result
+	refreshCache bool
+)
+
+// AssistCmd represents the assist command
+// Learn: Exported commands let other packages register them on their root command.
+// See: https://pkg.go.dev/github.com/spf13/cobra#Command.AddCommand
 var AssistCmd = &cobra.Command{
-	Use:   "assist",
-	Short: "AI-powered writing assistance (coming soon)",
-	Long: `The assist command will provide AI-powered features for journal writing
-including content suggestions, writing prompts, and entry analysis.
-This feature is planned for Phase 3 implementation.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("assist is not implemented yet. Planned for Phase 3.")
-	},
+	Use:   "assist [YYYY-MM-DD]",
+	Short: "Get writing suggestions for a journal entry",
+	Long: `Reads a journal entry and prints numbered suggestions and reflective
+questions to help you expand on it. Without a date, today's entry is used.
+If the entry doesn't exist yet, you are asked whether to create it.
+
+Suggestions are cached per entry content, so re-running assist on an
+unchanged entry does not call the engine again. Use --refresh to regenerate
+and --no-cache to skip the cache completely.
+
+Suggestions come from an OpenAI-compatible chat completions API when
+LOGMD_LLM_API_KEY (or llm_api_key in the config file) is set. Use
+LOGMD_LLM_URL and LOGMD_LLM_MODEL to point at another provider or model.
+Without a key, built-in offline suggestions are used.
+
+Examples:
+  logmd assist
+  logmd assist 2024-01-15
+  logmd assist --refresh
+  logmd assist summary 2024-01-15`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runAssistCommand,
+}
+
+// summaryCmd represents the assist summary command
+var summaryCmd = &cobra.Command{
+	Use:   "summary [YYYY-MM-DD]",
+	Short: "Summarize a journal entry in one paragraph",
+	Long: `Reads a journal entry and prints a one-paragraph summary of it. Without a
+date, today's entry is used. Summaries use the same engine and cache as
+suggestions.
+
+Examples:
+  logmd assist summary
+  logmd assist summary 2024-01-15`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSummaryCommand,
+}
+
+// runAssistCommand implements the core logic for the assist command.
+func runAssistCommand(cmd *cobra.Command, args []string) error {
+	engine, date, path, release, err := prepareEntry(cmd, args)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	suggestions, err := engine.Suggest(path)
+	if err != nil {
+		return fmt.Errorf("failed to generate suggestions: %w", err)
+	}
+
+	fmt.Printf("💡 Suggestions for %s:\n\n", date)
+	for i, suggestion := range suggestions {
+		fmt.Printf("%d. %s\n", i+1, suggestion)
+	}
+
+	return nil
+}
+
+// runSummaryCommand implements the core logic for the assist summary command.
+func runSummaryCommand(cmd *cobra.Command, args []string) error {
+	engine, date, path, release, err := prepareEntry(cmd, args)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	summary, err := engine.Summarize(path)
+	if err != nil {
+		return fmt.Errorf("failed to summarize entry: %w", err)
+	}
+
+	fmt.Printf("📝 Summary of %s:\n\n%s\n", date, summary)
+	return nil
+}
+
+// prepareEntry resolves the entry for an optional date argument (default
+// today), offers to create it if missing, and builds the engine to run on it.
+// The returned path is readable as plain text until release is called, which
+// matters for encrypted entries that are decrypted into a temp file.
+func prepareEntry(cmd *cobra.Command, args []string) (engine Engine, date, path string, release func(), err error) {
+	// Step 1: Resolve the entry date (today is filled in once the config
+	// says which timezone it's in)
+	if len(args) == 1 {
+		date = args[0]
+		if _, err := time.Parse(vault.DefaultDateFormat, date); err != nil {
+			return nil, "", "", nil, fmt.Errorf("%w: %s (expected YYYY-MM-DD)", vault.ErrInvalidDate, date)
+		}
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, "", "", nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+	if date == "" {
+		date = cfg.Today().Format(vault.DefaultDateFormat)
+	}
+
+	// Step 3: Open the vault, creating the journal directory if needed
+	v, err := cfg.OpenVault(true)
+	if err != nil {
+		return nil, "", "", nil, err
+	}
+	if err := cfg.UnlockVault(v); err != nil {
+		return nil, "", "", nil, err
+	}
+
+	// Step 4: Offer to create a missing entry
+	if !v.EntryExists(date) {
+		ok, err := prompt.Confirm(cmd.InOrStdin(), cmd.OutOrStdout(), fmt.Sprintf("Journal entry for %s does not exist. Create it?", date))
+		if err != nil {
+			return nil, "", "", nil, err
+		}
+		if !ok {
+			return nil, "", "", nil, fmt.Errorf("journal entry for %s does not exist", date)
+		}
+		if err := v.CreateEntry(date); err != nil {
+			return nil, "", "", nil, fmt.Errorf("failed to create entry %s: %w", date, err)
+		}
+		fmt.Printf("Created new journal entry: %s\n", date)
+	}
+
+	// Step 5: Build the engine, cached unless disabled. Cached results are
+	// stored as plain text, so encrypted journals are never cached.
+	engine, err = wrapWithCache(newEngine(cfg), noCache || cfg.Encrypt, refreshCache)
+	if err != nil {
+		return nil, "", "", nil, err
+	}
+
+	// Step 6: Make the entry readable by the engine
+	path, err = v.CheckoutEntry(date)
+	if err != nil {
+		return nil, "", "", nil, err
+	}
+	release = func() { v.CheckinEntry(date, path) }
+
+	return engine, date, path, release, nil
 }
 
 // Engine defines the interface for LLM-powered assistance features.
@@ -33,6 +176,9 @@ type Engine interface {
 	// Suggest generates writing suggestions based on the given file path.
 	// Returns a slice of suggestion strings or an error if generation fails.
 	Suggest(path string) ([]string, error)
+
+	// Summarize condenses the entry at the given file path into one paragraph.
+	Summarize(path string) (string, error)
 }
 
 // MockEngine provides a fake implementation for testing and development.
@@ -49,3 +195,43 @@ func (m *MockEngine) Suggest(path string) ([]string, error) {
 		"How did you solve problems you encountered?",
 	}, nil
 }
+
+// Summarize returns a canned summary for testing purposes.
+func (m *MockEngine) Summarize(path string) (string, error) {
+	return "You spent the day learning, worked through a few challenges, and made steady progress.", nil
+}
+
+// newEngine returns the OpenAI-compatible engine when an API key is
+// configured (llm_api_key or LOGMD_LLM_API_KEY), and the MockEngine otherwise
+// so assist keeps working offline.
+func newEngine(cfg *config.Config) Engine {
+	if cfg.LLMAPIKey == "" {
+		return &MockEngine{}
+	}
+	return NewOpenAIEngine(cfg.LLMAPIKey, cfg.LLMURL, cfg.LLMModel)
+}
+
+// wrapWithCache decorates engine with the on-disk suggestion cache unless
+// caching is disabled. The MockEngine is cheap and deterministic, so it is
+// never cached.
+func wrapWithCache(engine Engine, disabled, refresh bool) (Engine, error) {
+	if _, isMock := engine.(*MockEngine); isMock || disabled {
+		return engine, nil
+	}
+
+	dir, err := DefaultCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate cache directory: %w", err)
+	}
+
+	cached := NewCachingEngine(engine, dir, DefaultCacheTTL)
+	cached.Refresh = refresh
+	return cached, nil
+}
+
+func init() {
+	AssistCmd.PersistentFlags().BoolVar(&noCache, "no-cache", false, "do not read or write cached results")
+	AssistCmd.PersistentFlags().BoolVar(&refreshCache, "refresh", false, "regenerate results and update the cache")
+	AssistCmd.MarkFlagsMutuallyExclusive("no-cache", "refresh")
+	AssistCmd.AddCommand(summaryCmd)
+}
@@ -1,7 +1,13 @@
 package assist
 
 import (
+	"os"
+	"strings"
 	"testing"
+
+	"github.com/spf13/cobra"
+	"logmd/prompt"
+	"logmd/vault"
 )
 
 // TestMockEngine verifies that the mock engine interface implementation works.
@@ -34,6 +40,15 @@ func TestMockEngine(t *testing.T) {
 			t.Errorf("Suggestion %d is empty", i)
 		}
 	}
+
+	// Verify the canned summary is a single non-empty paragraph
+	summary, err := engine.Summarize("/path/to/test.md")
+	if err != nil {
+		t.Fatalf("Summarize() returned error: %v", err)
+	}
+	if summary == "" || strings.Contains(summary, "\n") {
+		t.Errorf("Expected a one-paragraph summary, got %q", summary)
+	}
 }
 
 // TestAssistCmdExists verifies that the assist command is properly configured.
@@ -42,8 +57,8 @@ func TestAssistCmdExists(t *testing.T) {
 		t.Fatal("AssistCmd should not be nil")
 	}
 
-	if AssistCmd.Use != "assist" {
-		t.Errorf("Expected Use='assist', got Use='%s'", AssistCmd.Use)
+	if AssistCmd.Name() != "assist" {
+		t.Errorf("Expected name 'assist', got '%s'", AssistCmd.Name())
 	}
 
 	if AssistCmd.Short == "" {
@@ -54,7 +69,84 @@ func TestAssistCmdExists(t *testing.T) {
 		t.Error("Long description should not be empty")
 	}
 
-	if AssistCmd.Run == nil {
-		t.Error("Run function should not be nil")
+	if AssistCmd.RunE == nil {
+		t.Error("RunE function should not be nil")
+	}
+}
+
+// TestRunAssistCommand verifies suggestions for existing and missing entries.
+func TestRunAssistCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-assist-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	originalKey := os.Getenv("LOGMD_LLM_API_KEY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		if originalKey != "" {
+			os.Setenv("LOGMD_LLM_API_KEY", originalKey)
+		}
+	}()
+
+	// Without an API key the deterministic MockEngine is used
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+	os.Unsetenv("LOGMD_LLM_API_KEY")
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+
+	withInput := func(input string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.SetIn(strings.NewReader(input))
+		return cmd
+	}
+
+	// Declining to create a missing entry is an error and writes nothing
+	if err := runAssistCommand(withInput("n\n"), []string{"2024-01-15"}); err == nil {
+		t.Error("Expected error when declining to create the entry")
+	}
+	if v.EntryExists("2024-01-15") {
+		t.Error("Entry should not be created when declined")
+	}
+
+	// Accepting creates it and then suggests
+	if err := runAssistCommand(withInput("y\n"), []string{"2024-01-15"}); err != nil {
+		t.Fatalf("runAssistCommand() failed: %v", err)
+	}
+	if !v.EntryExists("2024-01-15") {
+		t.Error("Expected entry to be created")
+	}
+
+	// --yes creates a missing entry without reading an answer
+	prompt.AssumeYes = true
+	defer func() { prompt.AssumeYes = false }()
+	if err := runAssistCommand(withInput(""), []string{"2024-01-16"}); err != nil {
+		t.Fatalf("runAssistCommand() with --yes failed: %v", err)
+	}
+	if !v.EntryExists("2024-01-16") {
+		t.Error("Expected --yes to create the entry")
+	}
+	prompt.AssumeYes = false
+
+	// Existing entries don't prompt
+	if err := runAssistCommand(withInput(""), []string{"2024-01-15"}); err != nil {
+		t.Errorf("runAssistCommand() on existing entry failed: %v", err)
+	}
+	if err := runSummaryCommand(withInput(""), []string{"2024-01-15"}); err != nil {
+		t.Errorf("runSummaryCommand() failed: %v", err)
+	}
+
+	if err := runAssistCommand(withInput(""), []string{"15-01-2024"}); err == nil {
+		t.Error("Expected error for invalid date")
 	}
 }
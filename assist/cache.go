@@ -0,0 +1,181 @@
+package assist
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultCacheTTL is how long cached suggestions stay valid.
+const DefaultCacheTTL = 7 * 24 * time.Hour
+
+// CachingEngine wraps another Engine and caches its suggestions on disk,
+// keyed by a hash of the entry content and the wrapped engine's cache key.
+// Editing an entry or switching model or provider changes the hash, so stale
+// suggestions are never returned for modified content or another model.
+// Learn: The decorator pattern adds behavior by wrapping a value that
+// satisfies the same interface.
+// See: https://go.dev/doc/effective_go#embedding
+type CachingEngine struct {
+	// Engine is the wrapped engine that produces suggestions on a cache miss
+	Engine Engine
+	// Key identifies what the wrapped engine generates with, such as its
+	// model and URL, so results from different setups are cached apart
+	Key string
+	// Dir is the directory holding cached results
+	Dir string
+	// TTL is the maximum age of a cached result before it is regenerated
+	TTL time.Duration
+	// Refresh ignores existing cache entries but still stores new results
+	Refresh bool
+
+	// now returns the current time (overridable in tests)
+	now func() time.Time
+}
+
+// cacheRecord is the on-disk format of a cached result.
+type cacheRecord struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Suggestions []string  `json:"suggestions,omitempty"`
+	Summary     string    `json:"summary,omitempty"`
+}
+
+// cacheKeyer is implemented by engines whose results depend on settings
+// beyond the entry content, such as the model they call.
+// Learn: Asserting an optional interface lets a wrapper use extra behavior
+// only some implementations provide.
+// See: https://go.dev/doc/effective_go#interface_conversions
+type cacheKeyer interface {
+	CacheKey() string
+}
+
+// NewCachingEngine wraps engine with an on-disk cache stored in dir, keyed
+// by engine's CacheKey when it has one.
+func NewCachingEngine(engine Engine, dir string, ttl time.Duration) *CachingEngine {
+	var key string
+	if keyer, ok := engine.(cacheKeyer); ok {
+		key = keyer.CacheKey()
+	}
+
+	return &CachingEngine{
+		Engine: engine,
+		Key:    key,
+		Dir:    dir,
+		TTL:    ttl,
+		now:    time.Now,
+	}
+}
+
+// DefaultCacheDir returns the directory used for cached suggestions,
+// under the user's cache directory (e.g. ~/.cache/logmd/assist).
+// See: https://pkg.go.dev/os#UserCacheDir
+func DefaultCacheDir() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "logmd", "assist"), nil
+}
+
+// Suggest returns cached suggestions for the entry at path when its content
+// hash matches a fresh cache record, otherwise it calls the wrapped engine
+// and stores the result. Cache write failures do not fail the request.
+func (c *CachingEngine) Suggest(path string) ([]string, error) {
+	cachePath, err := c.cachePath(path, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.Refresh {
+		if record, ok := c.load(cachePath); ok {
+			return record.Suggestions, nil
+		}
+	}
+
+	suggestions, err := c.Engine.Suggest(path)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = c.store(cachePath, cacheRecord{Suggestions: suggestions})
+	return suggestions, nil
+}
+
+// Summarize works like Suggest, caching summaries separately from suggestions.
+func (c *CachingEngine) Summarize(path string) (string, error) {
+	cachePath, err := c.cachePath(path, "-summary")
+	if err != nil {
+		return "", err
+	}
+
+	if !c.Refresh {
+		if record, ok := c.load(cachePath); ok {
+			return record.Summary, nil
+		}
+	}
+
+	summary, err := c.Engine.Summarize(path)
+	if err != nil {
+		return "", err
+	}
+
+	_ = c.store(cachePath, cacheRecord{Summary: summary})
+	return summary, nil
+}
+
+// cachePath returns the cache file for the entry at path, keyed by a hash of
+// the engine's key and the entry content plus a suffix distinguishing the
+// kind of result.
+func (c *CachingEngine) cachePath(path, suffix string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read entry %s: %w", path, err)
+	}
+
+	// The NUL byte keeps the key from running into the content
+	hash := sha256.New()
+	hash.Write([]byte(c.Key))
+	hash.Write([]byte{0})
+	hash.Write(content)
+	return filepath.Join(c.Dir, hex.EncodeToString(hash.Sum(nil))+suffix+".json"), nil
+}
+
+// load reads a cache record, reporting false if it is missing, unreadable or expired.
+func (c *CachingEngine) load(cachePath string) (cacheRecord, bool) {
+	var record cacheRecord
+
+	data, err := os.ReadFile(cachePath)
+	if err != nil {
+		return record, false
+	}
+
+	if err := json.Unmarshal(data, &record); err != nil {
+		return record, false
+	}
+
+	if c.TTL > 0 && c.now().Sub(record.CreatedAt) > c.TTL {
+		return record, false
+	}
+
+	return record, true
+}
+
+// store writes a cache record stamped with the current time, creating the
+// cache directory if needed.
+func (c *CachingEngine) store(cachePath string, record cacheRecord) error {
+	if err := os.MkdirAll(c.Dir, 0700); err != nil {
+		return err
+	}
+
+	record.CreatedAt = c.now()
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(cachePath, data, 0600)
+}
@@ -0,0 +1,180 @@
+package assist
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// countingEngine records how many times the engine was called.
+type countingEngine struct {
+	calls int
+}
+
+// Suggest returns a suggestion that includes the call count.
+func (c *countingEngine) Suggest(path string) ([]string, error) {
+	c.calls++
+	return []string{fmt.Sprintf("suggestion %d", c.calls)}, nil
+}
+
+// Summarize returns a summary that includes the call count.
+func (c *countingEngine) Summarize(path string) (string, error) {
+	c.calls++
+	return fmt.Sprintf("summary %d", c.calls), nil
+}
+
+// TestCachingEngine verifies cache hits, content invalidation, TTL and refresh.
+func TestCachingEngine(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-assist-cache-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	entryPath := filepath.Join(tmpDir, "2024-01-15.md")
+	if err := os.WriteFile(entryPath, []byte("# Entry\n\nFirst draft."), 0644); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	inner := &countingEngine{}
+	engine := NewCachingEngine(inner, filepath.Join(tmpDir, "cache"), time.Hour)
+	engine.now = func() time.Time { return now }
+
+	var _ Engine = engine
+
+	// First call misses, second call hits
+	if _, err := engine.Suggest(entryPath); err != nil {
+		t.Fatalf("Suggest() failed: %v", err)
+	}
+	if _, err := engine.Suggest(entryPath); err != nil {
+		t.Fatalf("Suggest() failed: %v", err)
+	}
+	if inner.calls != 1 {
+		t.Errorf("Expected 1 engine call after cache hit, got %d", inner.calls)
+	}
+
+	// Changing content changes the hash
+	if err := os.WriteFile(entryPath, []byte("# Entry\n\nSecond draft."), 0644); err != nil {
+		t.Fatalf("Failed to rewrite entry: %v", err)
+	}
+	if _, err := engine.Suggest(entryPath); err != nil {
+		t.Fatalf("Suggest() failed: %v", err)
+	}
+	if inner.calls != 2 {
+		t.Errorf("Expected engine call after content change, got %d calls", inner.calls)
+	}
+
+	// Expired records are regenerated
+	now = now.Add(2 * time.Hour)
+	if _, err := engine.Suggest(entryPath); err != nil {
+		t.Fatalf("Suggest() failed: %v", err)
+	}
+	if inner.calls != 3 {
+		t.Errorf("Expected engine call after TTL expiry, got %d calls", inner.calls)
+	}
+
+	// Refresh always calls through
+	engine.Refresh = true
+	if _, err := engine.Suggest(entryPath); err != nil {
+		t.Fatalf("Suggest() failed: %v", err)
+	}
+	if inner.calls != 4 {
+		t.Errorf("Expected engine call with Refresh, got %d calls", inner.calls)
+	}
+
+	// Missing entries are an error
+	if _, err := engine.Suggest(filepath.Join(tmpDir, "missing.md")); err == nil {
+		t.Error("Expected error for missing entry")
+	}
+
+	// Summaries are cached separately from suggestions for the same content
+	engine.Refresh = false
+	summary, err := engine.Summarize(entryPath)
+	if err != nil {
+		t.Fatalf("Summarize() failed: %v", err)
+	}
+	if inner.calls != 5 || summary != "summary 5" {
+		t.Errorf("Expected summary from the engine, got %q after %d calls", summary, inner.calls)
+	}
+	if cached, err := engine.Summarize(entryPath); err != nil || cached != summary {
+		t.Errorf("Expected cached summary %q, got %q (err %v)", summary, cached, err)
+	}
+	if inner.calls != 5 {
+		t.Errorf("Expected cached summary without an engine call, got %d calls", inner.calls)
+	}
+}
+
+// TestWrapWithCache verifies which engines get wrapped.
+func TestWrapWithCache(t *testing.T) {
+	mock := &MockEngine{}
+	engine, err := wrapWithCache(mock, false, false)
+	if err != nil {
+		t.Fatalf("wrapWithCache() failed: %v", err)
+	}
+	if engine != Engine(mock) {
+		t.Error("MockEngine should bypass caching")
+	}
+
+	inner := &countingEngine{}
+	engine, err = wrapWithCache(inner, true, false)
+	if err != nil {
+		t.Fatalf("wrapWithCache() failed: %v", err)
+	}
+	if engine != Engine(inner) {
+		t.Error("--no-cache should return the engine unwrapped")
+	}
+
+	engine, err = wrapWithCache(inner, false, true)
+	if err != nil {
+		t.Fatalf("wrapWithCache() failed: %v", err)
+	}
+	cached, ok := engine.(*CachingEngine)
+	if !ok {
+		t.Fatalf("Expected *CachingEngine, got %T", engine)
+	}
+	if !cached.Refresh {
+		t.Error("--refresh should set Refresh on the caching engine")
+	}
+}
+
+// TestCachingEngineKey verifies that engines with different models or URLs
+// don't share cached results.
+func TestCachingEngineKey(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-assist-cache-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	entryPath := filepath.Join(tmpDir, "2024-01-15.md")
+	if err := os.WriteFile(entryPath, []byte("# Entry\n\nFirst draft."), 0644); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	cacheDir := filepath.Join(tmpDir, "cache")
+	pathFor := func(engine *OpenAIEngine) string {
+		cached := NewCachingEngine(engine, cacheDir, time.Hour)
+		if cached.Key != engine.CacheKey() {
+			t.Errorf("Expected key %q, got %q", engine.CacheKey(), cached.Key)
+		}
+		path, err := cached.cachePath(entryPath, "")
+		if err != nil {
+			t.Fatalf("cachePath() failed: %v", err)
+		}
+		return path
+	}
+
+	base := pathFor(NewOpenAIEngine("key", "", ""))
+	if other := pathFor(NewOpenAIEngine("other-key", "", "")); other != base {
+		t.Error("Expected the API key not to change the cache path")
+	}
+	if other := pathFor(NewOpenAIEngine("key", "", "gpt-4o")); other == base {
+		t.Error("Expected another model to change the cache path")
+	}
+	if other := pathFor(NewOpenAIEngine("key", "http://localhost:11434/v1", "")); other == base {
+		t.Error("Expected another URL to change the cache path")
+	}
+}
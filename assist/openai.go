@@ -0,0 +1,195 @@
+package assist
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultLLMURL is the API base URL used when none is configured.
+const DefaultLLMURL = "https://api.openai.com/v1"
+
+// DefaultLLMModel is the chat model used when none is configured.
+const DefaultLLMModel = "gpt-4o-mini"
+
+// suggestPrompt instructs the model to answer in a format parseSuggestions understands.
+const suggestPrompt = `You are a thoughtful journaling assistant. Read the user's journal entry
+and reply with 3 to 5 short suggestions or reflective questions that would
+help them expand on it. Put each suggestion on its own line with no
+introduction or closing remarks.`
+
+// summaryPrompt asks for a single paragraph so the reply prints cleanly.
+const summaryPrompt = `You are a thoughtful journaling assistant. Summarize the user's journal
+entry in one short paragraph written in the second person. Reply with the
+summary only.`
+
+// OpenAIEngine generates suggestions with an OpenAI-compatible chat
+// completions API. Any server implementing POST {BaseURL}/chat/completions
+// with bearer authentication works, including local model servers.
+// See: https://platform.openai.com/docs/api-reference/chat/create
+type OpenAIEngine struct {
+	// APIKey is sent as a bearer token
+	APIKey string
+	// BaseURL is the API root, e.g. https://api.openai.com/v1
+	BaseURL string
+	// Model is the chat model name
+	Model string
+	// Client performs the HTTP requests
+	Client *http.Client
+}
+
+// NewOpenAIEngine creates an engine for the given key, filling in the default
+// URL and model when they are empty.
+func NewOpenAIEngine(apiKey, baseURL, model string) *OpenAIEngine {
+	if baseURL == "" {
+		baseURL = DefaultLLMURL
+	}
+	if model == "" {
+		model = DefaultLLMModel
+	}
+
+	return &OpenAIEngine{
+		APIKey:  apiKey,
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Model:   model,
+		Client:  &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+// CacheKey identifies the model and server results come from, so cached
+// results are not reused after llm_model or llm_url changes.
+func (e *OpenAIEngine) CacheKey() string {
+	return e.Model + "@" + e.BaseURL
+}
+
+// chatMessage is a single message in a chat completions request or response.
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// chatRequest is the body of a chat completions request.
+type chatRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+}
+
+// chatResponse holds the parts of a chat completions response we use.
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Suggest sends the entry at path to the chat completions endpoint and
+// returns one suggestion per non-empty line of the reply.
+func (e *OpenAIEngine) Suggest(path string) ([]string, error) {
+	reply, err := e.complete(suggestPrompt, path)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := parseSuggestions(reply)
+	if len(suggestions) == 0 {
+		return nil, fmt.Errorf("LLM response contained no suggestions")
+	}
+	return suggestions, nil
+}
+
+// Summarize sends the entry at path to the chat completions endpoint and
+// returns the reply as a single paragraph.
+func (e *OpenAIEngine) Summarize(path string) (string, error) {
+	reply, err := e.complete(summaryPrompt, path)
+	if err != nil {
+		return "", err
+	}
+
+	summary := strings.Join(strings.Fields(reply), " ")
+	if summary == "" {
+		return "", fmt.Errorf("LLM response contained no summary")
+	}
+	return summary, nil
+}
+
+// complete sends the entry at path as the user message after the given
+// system prompt and returns the text of the first choice.
+// Learn: Decoding into a struct ignores fields that aren't declared.
+// See: https://pkg.go.dev/encoding/json#Unmarshal
+func (e *OpenAIEngine) complete(prompt, path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read entry %s: %w", path, err)
+	}
+
+	body, err := json.Marshal(chatRequest{
+		Model: e.Model,
+		Messages: []chatMessage{
+			{Role: "system", Content: prompt},
+			{Role: "user", Content: string(content)},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.BaseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+e.APIKey)
+
+	resp, err := e.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to reach LLM API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read LLM response: %w", err)
+	}
+
+	var parsed chatResponse
+	decodeErr := json.Unmarshal(data, &parsed)
+
+	if resp.StatusCode != http.StatusOK {
+		if decodeErr == nil && parsed.Error != nil && parsed.Error.Message != "" {
+			return "", fmt.Errorf("LLM API returned %s: %s", resp.Status, parsed.Error.Message)
+		}
+		return "", fmt.Errorf("LLM API returned %s", resp.Status)
+	}
+	if decodeErr != nil {
+		return "", fmt.Errorf("failed to decode LLM response: %w", decodeErr)
+	}
+	if len(parsed.Choices) == 0 {
+		return "", fmt.Errorf("LLM response contained no choices")
+	}
+
+	return parsed.Choices[0].Message.Content, nil
+}
+
+// listMarkerPattern matches leading bullets or numbering such as "- ", "* " or "2. ".
+var listMarkerPattern = regexp.MustCompile(`^(?:[-*•]|\d+[.)])\s+`)
+
+// parseSuggestions splits a reply into suggestions, one per non-empty line,
+// dropping any list markers the model added.
+func parseSuggestions(reply string) []string {
+	var suggestions []string
+	for _, line := range strings.Split(reply, "\n") {
+		line = strings.TrimSpace(listMarkerPattern.ReplaceAllString(strings.TrimSpace(line), ""))
+		if line != "" {
+			suggestions = append(suggestions, line)
+		}
+	}
+	return suggestions
+}
@@ -0,0 +1,133 @@
+package assist
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"logmd/config"
+)
+
+// TestOpenAIEngineSuggest verifies the request format and reply parsing
+// against a fake chat completions server.
+func TestOpenAIEngineSuggest(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-assist-openai-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	entryPath := filepath.Join(tmpDir, "2024-01-15.md")
+	if err := os.WriteFile(entryPath, []byte("# Today\n\nShipped the release."), 0644); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-key" {
+			t.Errorf("Unexpected Authorization header %q", got)
+		}
+
+		var req chatRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+		if req.Model != "test-model" || len(req.Messages) != 2 || !strings.Contains(req.Messages[1].Content, "Shipped the release.") {
+			t.Errorf("Unexpected request: %+v", req)
+		}
+
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"1. What went well?\n\n- Who helped you?\n* What is next?"}}]}`))
+	}))
+	defer server.Close()
+
+	engine := NewOpenAIEngine("test-key", server.URL+"/v1/", "test-model")
+	suggestions, err := engine.Suggest(entryPath)
+	if err != nil {
+		t.Fatalf("Suggest() failed: %v", err)
+	}
+
+	expected := []string{"What went well?", "Who helped you?", "What is next?"}
+	if strings.Join(suggestions, "|") != strings.Join(expected, "|") {
+		t.Errorf("Expected %q, got %q", expected, suggestions)
+	}
+}
+
+// TestOpenAIEngineSummarize verifies multi-line replies become one paragraph.
+func TestOpenAIEngineSummarize(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-assist-openai-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	entryPath := filepath.Join(tmpDir, "2024-01-15.md")
+	if err := os.WriteFile(entryPath, []byte("# Today"), 0644); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req chatRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Messages[0].Content != summaryPrompt {
+			t.Errorf("Expected the summary prompt, got %+v (err %v)", req, err)
+		}
+		w.Write([]byte(`{"choices":[{"message":{"content":"  You shipped\nthe release.\n"}}]}`))
+	}))
+	defer server.Close()
+
+	summary, err := NewOpenAIEngine("key", server.URL, "").Summarize(entryPath)
+	if err != nil {
+		t.Fatalf("Summarize() failed: %v", err)
+	}
+	if summary != "You shipped the release." {
+		t.Errorf("Expected single-paragraph summary, got %q", summary)
+	}
+}
+
+// TestOpenAIEngineError verifies API errors are surfaced with their message.
+func TestOpenAIEngineError(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-assist-openai-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	entryPath := filepath.Join(tmpDir, "2024-01-15.md")
+	if err := os.WriteFile(entryPath, []byte("# Today"), 0644); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":{"message":"Incorrect API key provided"}}`))
+	}))
+	defer server.Close()
+
+	_, err = NewOpenAIEngine("bad-key", server.URL, "").Suggest(entryPath)
+	if err == nil || !strings.Contains(err.Error(), "Incorrect API key provided") {
+		t.Errorf("Expected API error message, got %v", err)
+	}
+}
+
+// TestNewEngine verifies the mock engine is used until an API key is set.
+func TestNewEngine(t *testing.T) {
+	var _ Engine = &OpenAIEngine{}
+
+	if _, ok := newEngine(&config.Config{}).(*MockEngine); !ok {
+		t.Error("Expected MockEngine without an API key")
+	}
+
+	engine, ok := newEngine(&config.Config{LLMAPIKey: "key"}).(*OpenAIEngine)
+	if !ok {
+		t.Fatal("Expected OpenAIEngine with an API key")
+	}
+	if engine.BaseURL != DefaultLLMURL || engine.Model != DefaultLLMModel {
+		t.Errorf("Expected default URL and model, got %s and %s", engine.BaseURL, engine.Model)
+	}
+}
@@ -0,0 +1,93 @@
+// Package clipboard copies text to the system clipboard for logmd.
+// It shells out to the platform's clipboard tool (pbcopy, wl-copy, xclip,
+// xsel or clip) instead of linking against native clipboard APIs.
+//
+// Learn: Shelling out to small, well-known tools keeps a CLI free of cgo.
+// See: https://pkg.go.dev/os/exec
+package clipboard
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrNoClipboard is returned when no supported clipboard tool is installed.
+// Learn: Sentinel errors let callers check for specific failures with errors.Is.
+// See: https://go.dev/blog/go1.13-errors
+var ErrNoClipboard = errors.New("no clipboard tool found (install pbcopy, wl-copy, xclip or xsel)")
+
+// ErrNoDisplay is returned when clipboard tools are installed but there is
+// no graphical session for them to reach, as over SSH or on a server.
+var ErrNoDisplay = errors.New("no clipboard available: no graphical session (WAYLAND_DISPLAY and DISPLAY are unset)")
+
+// Copy writes text to the system clipboard using the first available tool.
+// Tools that need a display server are skipped when none is set, so a
+// headless system gets ErrNoDisplay instead of the tool's own error.
+func Copy(text string) error {
+	found := false
+	for _, args := range candidates(runtime.GOOS) {
+		path, err := exec.LookPath(args[0])
+		if err != nil {
+			continue
+		}
+		found = true
+		if !hasDisplay(args[0]) {
+			continue
+		}
+
+		var stderr bytes.Buffer
+		cmd := exec.Command(path, args[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return fmt.Errorf("failed to run %s: %w: %s", args[0], err, msg)
+			}
+			return fmt.Errorf("failed to run %s: %w", args[0], err)
+		}
+		return nil
+	}
+
+	if found {
+		return ErrNoDisplay
+	}
+	return ErrNoClipboard
+}
+
+// hasDisplay reports whether the display server a clipboard tool talks to
+// is set in the environment. Tools that don't need one always have it.
+// Learn: wl-copy needs a Wayland compositor and xclip/xsel an X server.
+// See: https://wayland.freedesktop.org/docs/html/ch04.html
+func hasDisplay(tool string) bool {
+	switch tool {
+	case "wl-copy":
+		return os.Getenv("WAYLAND_DISPLAY") != ""
+	case "xclip", "xsel":
+		return os.Getenv("DISPLAY") != ""
+	default:
+		return true
+	}
+}
+
+// candidates returns the clipboard commands to try, in order, for an OS.
+// Learn: Returning data instead of acting on it keeps platform logic testable.
+func candidates(goos string) [][]string {
+	switch goos {
+	case "darwin":
+		return [][]string{{"pbcopy"}}
+	case "windows":
+		return [][]string{{"clip"}}
+	default:
+		// Prefer Wayland, then fall back to the common X11 tools
+		return [][]string{
+			{"wl-copy"},
+			{"xclip", "-selection", "clipboard"},
+			{"xsel", "--clipboard", "--input"},
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package clipboard
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// TestCandidates verifies the clipboard tools chosen for each platform.
+func TestCandidates(t *testing.T) {
+	testCases := []struct {
+		goos  string
+		first string
+		count int
+	}{
+		{goos: "darwin", first: "pbcopy", count: 1},
+		{goos: "windows", first: "clip", count: 1},
+		{goos: "linux", first: "wl-copy", count: 3},
+		{goos: "freebsd", first: "wl-copy", count: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.goos, func(t *testing.T) {
+			got := candidates(tc.goos)
+			if len(got) != tc.count {
+				t.Fatalf("Expected %d candidates, got %d", tc.count, len(got))
+			}
+			if got[0][0] != tc.first {
+				t.Errorf("Expected first candidate %q, got %q", tc.first, got[0][0])
+			}
+		})
+	}
+}
+
+// TestCopyWithoutTool verifies the error when no clipboard tool is on PATH.
+func TestCopyWithoutTool(t *testing.T) {
+	// Point PATH at an empty directory so no tool can be found
+	tmpDir, err := os.MkdirTemp("", "logmd-clipboard-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	originalPath := os.Getenv("PATH")
+	defer os.Setenv("PATH", originalPath)
+	os.Setenv("PATH", tmpDir)
+
+	err = Copy("hello")
+	if !errors.Is(err, ErrNoClipboard) {
+		t.Errorf("Expected ErrNoClipboard, got %v", err)
+	}
+}
+
+// TestCopyWithoutDisplay verifies the error when the only tools installed
+// need a display server that isn't set, as on a headless machine.
+func TestCopyWithoutDisplay(t *testing.T) {
+	if runtime.GOOS == "darwin" || runtime.GOOS == "windows" {
+		t.Skip("clipboard tools there don't need a display server")
+	}
+
+	tmpDir, err := os.MkdirTemp("", "logmd-clipboard-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// A stand-in xclip that would succeed if it were run
+	if err := os.WriteFile(filepath.Join(tmpDir, "xclip"), []byte("#!/bin/sh\nexit 0\n"), 0755); err != nil {
+		t.Fatalf("Failed to write fake xclip: %v", err)
+	}
+
+	for _, name := range []string{"PATH", "DISPLAY", "WAYLAND_DISPLAY"} {
+		original, ok := os.LookupEnv(name)
+		if ok {
+			defer os.Setenv(name, original)
+		} else {
+			defer os.Unsetenv(name)
+		}
+	}
+	os.Setenv("PATH", tmpDir)
+	os.Unsetenv("DISPLAY")
+	os.Unsetenv("WAYLAND_DISPLAY")
+
+	if err := Copy("hello"); !errors.Is(err, ErrNoDisplay) {
+		t.Errorf("Expected ErrNoDisplay, got %v", err)
+	}
+
+	// With a display set the tool is used
+	os.Setenv("DISPLAY", ":0")
+	if err := Copy("hello"); err != nil {
+		t.Errorf("Expected the copy to succeed with DISPLAY set, got %v", err)
+	}
+}
+
+// TestHasDisplay verifies which tools depend on which display variables.
+func TestHasDisplay(t *testing.T) {
+	for _, name := range []string{"DISPLAY", "WAYLAND_DISPLAY"} {
+		original, ok := os.LookupEnv(name)
+		if ok {
+			defer os.Setenv(name, original)
+		} else {
+			defer os.Unsetenv(name)
+		}
+	}
+	os.Unsetenv("DISPLAY")
+	os.Setenv("WAYLAND_DISPLAY", "wayland-0")
+
+	testCases := map[string]bool{"wl-copy": true, "xclip": false, "xsel": false, "pbcopy": true, "clip": true}
+	for tool, want := range testCases {
+		if got := hasDisplay(tool); got != want {
+			t.Errorf("hasDisplay(%q) = %v, want %v", tool, got, want)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// assetsPrune reports assets no entry references instead of listing an entry's images
+var assetsPrune bool
+
+// assetsCmd represents the assets command
+var assetsCmd = &cobra.Command{
+	Use:   "assets [YYYY-MM-DD]",
+	Short: "List the images an entry embeds, or find unused assets",
+	Long: `Images and other files embedded in entries are kept in the assets/
+directory at the root of the journal and linked relatively, e.g.
+![run](assets/run.jpg), or ![run](../../assets/run.jpg) with the nested
+layout.
+
+Given a date, lists the images the entry embeds and whether each file
+exists. Remote images are listed without being fetched.
+
+With --prune (and no date), reports the files in assets/ that no entry
+links to or embeds, so they can be cleaned up. Nothing is deleted.
+
+Examples:
+  logmd assets 2024-01-15
+  logmd assets --prune`,
+	Args: assetsArgs,
+	RunE: runAssetsCommand,
+}
+
+// assetsArgs requires a date, except with --prune which covers every entry.
+func assetsArgs(cmd *cobra.Command, args []string) error {
+	if assetsPrune {
+		return cobra.NoArgs(cmd, args)
+	}
+	return cobra.ExactArgs(1)(cmd, args)
+}
+
+// runAssetsCommand implements the core logic for the assets command.
+func runAssetsCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Validate the date
+	if !assetsPrune && !isValidDateFormat(args[0]) {
+		return invalidDateError(args[0])
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Open the vault
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Links are collected from the AST; nothing is rendered
+	renderer, err := markdown.NewRenderer(markdown.Options{Style: "notty"})
+	if err != nil {
+		return fmt.Errorf("failed to create markdown renderer: %w", err)
+	}
+
+	if assetsPrune {
+		return pruneAssets(v, renderer)
+	}
+	return listEntryAssets(v, renderer, args[0])
+}
+
+// listEntryAssets prints the images embedded by the entry for date with
+// whether each local file exists.
+func listEntryAssets(v *vault.Vault, renderer *markdown.Renderer, date string) error {
+	content, err := v.ReadEntry(date)
+	if err != nil {
+		return fmt.Errorf("failed to read entry: %w", err)
+	}
+
+	var images []markdown.Link
+	for _, link := range renderer.ExtractLinks(content) {
+		if link.Image {
+			images = append(images, link)
+		}
+	}
+	if len(images) == 0 {
+		fmt.Println(noEntryStyle.Render(fmt.Sprintf("No images in entry %s.", date)))
+		return nil
+	}
+
+	for _, image := range images {
+		status := linkOKStyle.Render("✅ found")
+		if local, exists := v.CheckLocalLink(date, image.Destination); !local {
+			status = calendarLabelStyle.Render("🌐 remote")
+		} else if !exists {
+			status = linkBrokenStyle.Render("❌ missing")
+		}
+		fmt.Printf("%s  %s\n", formatLink(image), status)
+	}
+	return nil
+}
+
+// pruneAssets prints the files under the assets directory that no entry
+// links to or embeds.
+func pruneAssets(v *vault.Vault, renderer *markdown.Renderer) error {
+	assets, err := v.Assets()
+	if err != nil {
+		return err
+	}
+	if len(assets) == 0 {
+		fmt.Println(noEntryStyle.Render(fmt.Sprintf("No assets in %s.", v.AssetsDir())))
+		return nil
+	}
+
+	referenced, err := referencedFiles(v, renderer)
+	if err != nil {
+		return err
+	}
+	unused := unusedAssets(assets, referenced)
+	if len(unused) == 0 {
+		fmt.Printf("✅ All %d assets are referenced by an entry\n", len(assets))
+		return nil
+	}
+
+	fmt.Printf("🧹 %d of %d assets are not referenced by any entry:\n", len(unused), len(assets))
+	for _, path := range unused {
+		rel, err := filepath.Rel(v.Directory, path)
+		if err != nil {
+			rel = path
+		}
+		fmt.Printf("  %s\n", filepath.ToSlash(rel))
+	}
+	return nil
+}
+
+// referencedFiles returns the set of local files, as cleaned absolute
+// paths, that any entry links to or embeds.
+func referencedFiles(v *vault.Vault, renderer *markdown.Renderer) (map[string]bool, error) {
+	filenames, err := v.ListEntries()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entries: %w", err)
+	}
+
+	referenced := make(map[string]bool)
+	for _, filename := range filenames {
+		date := strings.TrimSuffix(filename, ".md")
+		content, err := v.ReadEntry(date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read entry: %w", err)
+		}
+		for _, link := range renderer.ExtractLinks(content) {
+			if path, ok := v.ResolveLocalLink(date, link.Destination); ok {
+				referenced[path] = true
+			}
+		}
+	}
+	return referenced, nil
+}
+
+// unusedAssets returns the assets missing from referenced, keeping their order.
+func unusedAssets(assets []string, referenced map[string]bool) []string {
+	var unused []string
+	for _, path := range assets {
+		if !referenced[path] {
+			unused = append(unused, path)
+		}
+	}
+	return unused
+}
+
+func init() {
+	rootCmd.AddCommand(assetsCmd)
+	assetsCmd.Flags().BoolVar(&assetsPrune, "prune", false, "report assets that no entry references")
+}
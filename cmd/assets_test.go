@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// TestReferencedFiles tests collecting linked files across entries and
+// finding the assets none of them use.
+func TestReferencedFiles(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-assets-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.NewWithLayout(tmpDir, vault.LayoutNested)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	for date, content := range map[string]string{
+		"2024-01-15": "# Run\n\n![run](../../assets/run.jpg)\n",
+		"2024-02-01": "# Notes\n\n[slides](../../assets/talk%20slides.pdf) and ![remote](https://example.com/x.png)\n",
+	} {
+		if err := v.WriteEntry(date, []byte(content)); err != nil {
+			t.Fatalf("Failed to write entry %s: %v", date, err)
+		}
+	}
+	for _, name := range []string{"run.jpg", "talk slides.pdf", "old.png"} {
+		os.MkdirAll(v.AssetsDir(), 0700)
+		if err := os.WriteFile(filepath.Join(v.AssetsDir(), name), []byte("x"), 0644); err != nil {
+			t.Fatalf("Failed to write asset: %v", err)
+		}
+	}
+
+	renderer, err := markdown.NewRenderer(markdown.Options{Style: "notty"})
+	if err != nil {
+		t.Fatalf("Failed to create renderer: %v", err)
+	}
+	referenced, err := referencedFiles(v, renderer)
+	if err != nil {
+		t.Fatalf("referencedFiles() failed: %v", err)
+	}
+
+	assets, err := v.Assets()
+	if err != nil {
+		t.Fatalf("Assets() failed: %v", err)
+	}
+	expected := []string{filepath.Join(v.AssetsDir(), "old.png")}
+	if got := unusedAssets(assets, referenced); !reflect.DeepEqual(got, expected) {
+		t.Errorf("unusedAssets() = %v, expected %v", got, expected)
+	}
+}
+
+// TestRunAssetsCommand tests listing an entry's images and pruning.
+func TestRunAssetsCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-assets-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		assetsPrune = false
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-15", []byte("# Run\n\n![run](assets/run.jpg) ![gone](assets/gone.jpg)\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	// Pruning without an assets directory is not an error
+	assetsPrune = true
+	if err := runAssetsCommand(nil, []string{}); err != nil {
+		t.Errorf("runAssetsCommand() --prune without assets failed: %v", err)
+	}
+
+	os.MkdirAll(v.AssetsDir(), 0700)
+	os.WriteFile(filepath.Join(v.AssetsDir(), "run.jpg"), []byte("x"), 0644)
+	if err := runAssetsCommand(nil, []string{}); err != nil {
+		t.Errorf("runAssetsCommand() --prune failed: %v", err)
+	}
+	if err := assetsArgs(assetsCmd, []string{"2024-01-15"}); err == nil {
+		t.Error("Expected --prune to reject a date")
+	}
+
+	assetsPrune = false
+	if err := runAssetsCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Errorf("runAssetsCommand() failed: %v", err)
+	}
+	if err := assetsArgs(assetsCmd, []string{}); err == nil {
+		t.Error("Expected a date to be required without --prune")
+	}
+	if err := runAssetsCommand(nil, []string{"2024-01-16"}); !errors.Is(err, vault.ErrEntryNotFound) {
+		t.Errorf("Expected ErrEntryNotFound for a missing entry, got %v", err)
+	}
+	if err := runAssetsCommand(nil, []string{"jan"}); err == nil || !strings.Contains(err.Error(), "invalid date") {
+		t.Errorf("Expected an invalid date error, got %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"logmd/config"
+	"logmd/gitsync"
+	"logmd/vault"
+)
+
+// committer records saved entries in git; tests replace it with a fake
+var committer gitsync.Committer = gitsync.Git{}
+
+// commitEntry commits the entry for date when git_auto_commit is enabled.
+// Failures only print a warning: the entry is already saved, and a journal
+// outside a git repository shouldn't stop today, edit or note from working.
+func commitEntry(cfg *config.Config, v *vault.Vault, date string) {
+	if !cfg.GitAutoCommit {
+		return
+	}
+
+	err := committer.Commit(v.Directory, v.DatePath(date), "journal: "+date)
+	if errors.Is(err, gitsync.ErrNotRepository) {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: git_auto_commit is enabled but %s is not a git repository\n", v.Directory)
+	} else if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: failed to commit entry %s: %v\n", date, err)
+	}
+}
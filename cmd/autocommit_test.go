@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"logmd/config"
+	"logmd/gitsync"
+	"logmd/vault"
+)
+
+// fakeCommitter records commits instead of running git.
+type fakeCommitter struct {
+	calls []string
+	err   error
+}
+
+func (f *fakeCommitter) Commit(dir, path, message string) error {
+	f.calls = append(f.calls, filepath.Base(path)+": "+message)
+	return f.err
+}
+
+// useFakeCommitter swaps in a fake committer for the duration of a test.
+func useFakeCommitter(t *testing.T, err error) *fakeCommitter {
+	t.Helper()
+	fake := &fakeCommitter{err: err}
+	original := committer
+	committer = fake
+	t.Cleanup(func() { committer = original })
+	return fake
+}
+
+// TestCommitEntry tests that entries are only committed when enabled.
+func TestCommitEntry(t *testing.T) {
+	v := &vault.Vault{Directory: "/journal", Layout: vault.LayoutFlat}
+
+	fake := useFakeCommitter(t, nil)
+	commitEntry(&config.Config{GitAutoCommit: false}, v, "2024-01-15")
+	if len(fake.calls) != 0 {
+		t.Errorf("Expected no commit when disabled, got %v", fake.calls)
+	}
+
+	commitEntry(&config.Config{GitAutoCommit: true}, v, "2024-01-15")
+	if len(fake.calls) != 1 || fake.calls[0] != "2024-01-15.md: journal: 2024-01-15" {
+		t.Errorf("Expected one commit for the entry, got %v", fake.calls)
+	}
+
+	// Failures, including a journal outside git, only warn
+	for _, err := range []error{gitsync.ErrNotRepository, errors.New("git exploded")} {
+		fake = useFakeCommitter(t, err)
+		commitEntry(&config.Config{GitAutoCommit: true}, v, "2024-01-15")
+		if len(fake.calls) != 1 {
+			t.Errorf("Expected the commit to be attempted once, got %v", fake.calls)
+		}
+	}
+}
+
+// TestRunNoteCommandAutoCommit tests that note commits today's entry.
+func TestRunNoteCommandAutoCommit(t *testing.T) {
+	originalVars := saveEnvironment()
+	defer restoreEnvironment(originalVars)
+	clearLogmdEnvironment()
+
+	tmpDir, err := os.MkdirTemp("", "logmd-note-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	os.Setenv("HOME", tmpDir)
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+	os.Setenv("LOGMD_GIT_AUTO_COMMIT", "true")
+
+	fake := useFakeCommitter(t, nil)
+	if err := runNoteCommand(nil, []string{"committed thought"}); err != nil {
+		t.Fatalf("runNoteCommand() failed: %v", err)
+	}
+
+	today := time.Now().Format("2006-01-02")
+	if len(fake.calls) != 1 || fake.calls[0] != today+".md: journal: "+today {
+		t.Errorf("Expected today's entry to be committed, got %v", fake.calls)
+	}
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/vault"
+)
+
+// backlinksCmd represents the backlinks command
+// Learn: Backlinks are the reverse of links, found by scanning every other document.
+// See: https://en.wikipedia.org/wiki/Backlink
+var backlinksCmd = &cobra.Command{
+	Use:   "backlinks <YYYY-MM-DD>",
+	Short: "List entries that link to a day",
+	Long: `Scans every entry for [[YYYY-MM-DD]] wiki links to the given date and
+lists the entries that reference it, newest first, with each referencing
+line and its line number for context. References inside code are ignored.
+
+Backlinks are found whether or not wiki_links is enabled; the setting only
+changes how the references are rendered.
+
+Examples:
+  logmd backlinks 2024-01-15`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBacklinksCommand,
+}
+
+// runBacklinksCommand implements the core logic for the backlinks command.
+func runBacklinksCommand(cmd *cobra.Command, args []string) error {
+	date := args[0]
+
+	// Step 1: Validate date format
+	if !isValidDateFormat(date) {
+		return invalidDateError(date)
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Open the vault
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Find the referencing lines in one pass over the vault
+	matches, err := v.BacklinkLines(date)
+	if err != nil {
+		return fmt.Errorf("failed to find backlinks: %w", err)
+	}
+	if len(matches) == 0 {
+		fmt.Println(noEntryStyle.Render(fmt.Sprintf("No entries link to %s.", date)))
+		return nil
+	}
+
+	// Step 5: List them grouped by entry
+	fmt.Print(formatBacklinks(date, matches))
+	return nil
+}
+
+// formatBacklinks lists the entries in matches under a count, each date
+// followed by its referencing lines. matches must be grouped by date, as
+// BacklinkLines returns them.
+func formatBacklinks(date string, matches []vault.Match) string {
+	var b strings.Builder
+	entries := 0
+	for i, match := range matches {
+		if i == 0 || matches[i-1].Date != match.Date {
+			entries++
+		}
+	}
+	fmt.Fprintf(&b, "🔗 %s linked from %s\n", date, pluralEntries(entries))
+
+	for i, match := range matches {
+		if i == 0 || matches[i-1].Date != match.Date {
+			b.WriteString("\n" + dayHeaderStyle.Render(match.Date) + "\n")
+		}
+		lineNumber := calendarLabelStyle.Render(fmt.Sprintf("%4d", match.Line))
+		fmt.Fprintf(&b, "%s  %s\n", lineNumber, strings.TrimSpace(match.Text))
+	}
+	return b.String()
+}
+
+func init() {
+	rootCmd.AddCommand(backlinksCmd)
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"logmd/vault"
+)
+
+// TestFormatBacklinks tests grouping referencing lines under their entries.
+func TestFormatBacklinks(t *testing.T) {
+	originalProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.Ascii)
+	defer lipgloss.SetColorProfile(originalProfile)
+
+	matches := []vault.Match{
+		{Date: "2024-01-16", Line: 3, Text: "After [[2024-01-15]] and"},
+		{Date: "2024-01-16", Line: 12, Text: "  - back to [[2024-01-15]]"},
+		{Date: "2024-01-14", Line: 3, Text: "Planning for [[2024-01-15]]."},
+	}
+	expected := "🔗 2024-01-15 linked from 2 entries\n" +
+		"\n2024-01-16\n" +
+		"   3  After [[2024-01-15]] and\n" +
+		"  12  - back to [[2024-01-15]]\n" +
+		"\n2024-01-14\n" +
+		"   3  Planning for [[2024-01-15]].\n"
+	if got := formatBacklinks("2024-01-15", matches); got != expected {
+		t.Errorf("formatBacklinks() =\n%q\nexpected\n%q", got, expected)
+	}
+}
+
+// TestRunBacklinksCommand tests validating the date and scanning the vault.
+func TestRunBacklinksCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-backlinks-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-16", []byte("# Tuesday\n\nAfter [[2024-01-15]].\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	if err := runBacklinksCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Errorf("runBacklinksCommand() failed: %v", err)
+	}
+	if err := runBacklinksCommand(nil, []string{"2024-01-10"}); err != nil {
+		t.Errorf("runBacklinksCommand() without backlinks failed: %v", err)
+	}
+	if err := runBacklinksCommand(nil, []string{"01/15/2024"}); err == nil {
+		t.Error("Expected an error for an invalid date")
+	}
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/vault"
+)
+
+// Flag values for the backup command
+var (
+	// backupOut is the archive to write (empty uses a dated default name)
+	backupOut string
+	// backupFrom and backupTo bound the archived dates (inclusive, empty means open)
+	backupFrom string
+	backupTo   string
+)
+
+// backupCmd represents the backup command
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Archive the journal into a .tar.gz file",
+	Long: `Archives every markdown file in the journal directory into a gzip'd tar,
+keeping the directory structure. Files are copied as they are on disk, so
+encrypted entries stay encrypted and no passphrase is needed.
+
+Use --from and --to to back up only entries in a date range. Without --out
+the archive is named logmd-backup-YYYY-MM-DD.tar.gz in the current directory.
+
+Examples:
+  logmd backup
+  logmd backup --out journal-backup.tar.gz
+  logmd backup --from 2024-01-01 --to 2024-12-31 --out 2024.tar.gz
+  tar -tzf journal-backup.tar.gz`,
+	Args: cobra.NoArgs,
+	RunE: runBackupCommand,
+}
+
+// runBackupCommand implements the core logic for the backup command.
+func runBackupCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Create vault instance
+	v, err := newVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Write the archive, removing it again if anything fails
+	out := backupOut
+	if out == "" {
+		out = "logmd-backup-" + cfg.Now().Format(vault.DefaultDateFormat) + ".tar.gz"
+	}
+	file, err := os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create backup file: %w", err)
+	}
+
+	added, err := v.Backup(file, backupFrom, backupTo)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write backup file: %w", closeErr)
+	}
+	if err != nil {
+		os.Remove(out)
+		return err
+	}
+
+	fmt.Printf("✅ Backed up %d files to %s\n", added, out)
+	return nil
+}
+
+func init() {
+	backupCmd.Flags().StringVar(&backupOut, "out", "", "archive to write (default logmd-backup-YYYY-MM-DD.tar.gz)")
+	backupCmd.Flags().StringVar(&backupFrom, "from", "", "only back up entries on or after this date (YYYY-MM-DD)")
+	backupCmd.Flags().StringVar(&backupTo, "to", "", "only back up entries on or before this date (YYYY-MM-DD)")
+	rootCmd.AddCommand(backupCmd)
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestRunBackupCommand tests writing a backup and listing its contents.
+func TestRunBackupCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-backup-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	journalDir := filepath.Join(tmpDir, "journal")
+	v, err := vault.New(journalDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	for _, date := range []string{"2024-01-01", "2024-01-02", "2024-02-01"} {
+		if err := v.CreateEntry(date); err != nil {
+			t.Fatalf("Failed to create entry: %v", err)
+		}
+	}
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		backupOut, backupFrom, backupTo = "", "", ""
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", journalDir)
+
+	backupOut = filepath.Join(tmpDir, "journal-backup.tar.gz")
+	backupTo = "2024-01-31"
+	if err := runBackupCommand(nil, []string{}); err != nil {
+		t.Fatalf("runBackupCommand() failed: %v", err)
+	}
+
+	file, err := os.Open(backupOut)
+	if err != nil {
+		t.Fatalf("Failed to open backup: %v", err)
+	}
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatalf("Failed to open gzip stream: %v", err)
+	}
+
+	var names []string
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Failed to read tar entry: %v", err)
+		}
+		names = append(names, header.Name)
+	}
+
+	expected := []string{"2024-01-01.md", "2024-01-02.md"}
+	if !slices.Equal(names, expected) {
+		t.Errorf("Expected archive to contain %v, got %v", expected, names)
+	}
+
+	// A bad range fails without leaving a partial archive behind
+	backupOut = filepath.Join(tmpDir, "bad.tar.gz")
+	backupFrom = "not-a-date"
+	if err := runBackupCommand(nil, []string{}); err == nil {
+		t.Error("Expected error for an invalid --from date")
+	}
+	if _, err := os.Stat(backupOut); !os.IsNotExist(err) {
+		t.Error("Expected the failed archive to be removed")
+	}
+}
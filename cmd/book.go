@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"logmd/markdown"
+)
+
+// defaultBookTitle is the book's title when --title isn't given.
+const defaultBookTitle = "Journal"
+
+// bookCSS styles the book export for reading on screen and printing. Each
+// entry starts a new printed page, and the table of contents fits on its own.
+// Learn: @media print and break-before control how a browser paginates a PDF.
+// See: https://developer.mozilla.org/en-US/docs/Web/CSS/break-before
+const bookCSS = `body {
+  font-family: Georgia, "Times New Roman", serif;
+  line-height: 1.6;
+  color: #222;
+  max-width: 40em;
+  margin: 0 auto;
+  padding: 2em 1em;
+}
+h1.book-title { font-size: 2.5em; text-align: center; margin: 3em 0 0.25em; }
+p.book-range { text-align: center; color: #666; margin-bottom: 3em; }
+nav.toc ol { list-style: none; padding: 0; }
+nav.toc li { display: flex; gap: 1em; padding: 0.2em 0; border-bottom: 1px dotted #ccc; }
+nav.toc a { color: inherit; text-decoration: none; }
+nav.toc .toc-date { flex: none; width: 11em; color: #666; }
+article.entry { margin-top: 4em; }
+article.entry header.entry-date { color: #666; font-style: italic; border-bottom: 1px solid #ddd; margin-bottom: 1em; }
+pre, code { font-family: Menlo, Consolas, monospace; font-size: 0.9em; background: #f5f5f5; }
+pre { padding: 0.75em; overflow-x: auto; }
+blockquote { border-left: 3px solid #ccc; margin-left: 0; padding-left: 1em; color: #555; }
+img { max-width: 100%; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.25em 0.5em; }
+@media print {
+  body { max-width: none; padding: 0; }
+  nav.toc { break-after: page; }
+  article.entry { break-before: page; margin-top: 0; }
+  a { color: inherit; text-decoration: none; }
+  pre { white-space: pre-wrap; }
+}
+`
+
+// exportBook renders entries, which must be oldest first, into one styled
+// HTML document meant to be printed to PDF from a browser: a title page
+// and table of contents, then one <article id="YYYY-MM-DD"> per entry
+// that the contents link to.
+func exportBook(renderer *markdown.Renderer, entries []exportEntry, title string) (string, error) {
+	if title == "" {
+		title = defaultBookTitle
+	}
+
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(&b, "<title>%s</title>\n", html.EscapeString(title))
+	fmt.Fprintf(&b, "<style>\n%s</style>\n</head>\n<body>\n", bookCSS)
+
+	// Title page and table of contents
+	fmt.Fprintf(&b, "<h1 class=\"book-title\">%s</h1>\n", html.EscapeString(title))
+	if len(entries) > 0 {
+		fmt.Fprintf(&b, "<p class=\"book-range\">%s – %s</p>\n",
+			longDate(entries[0].Date), longDate(entries[len(entries)-1].Date))
+	}
+	b.WriteString("<nav class=\"toc\">\n<h2>Contents</h2>\n<ol>\n")
+	for _, entry := range entries {
+		fmt.Fprintf(&b, "<li><a class=\"toc-date\" href=\"#%s\">%s</a>", html.EscapeString(entry.Date), longDate(entry.Date))
+		if heading := bookHeading(entry); heading != "" {
+			fmt.Fprintf(&b, " <a href=\"#%s\">%s</a>", html.EscapeString(entry.Date), html.EscapeString(heading))
+		}
+		b.WriteString("</li>\n")
+	}
+	b.WriteString("</ol>\n</nav>\n")
+
+	// One article per entry
+	for _, entry := range entries {
+		rendered, err := renderer.RenderHTML([]byte(entry.Content))
+		if err != nil {
+			return "", fmt.Errorf("failed to render entry %s: %w", entry.Date, err)
+		}
+		fmt.Fprintf(&b, "<article class=\"entry\" id=\"%s\">\n", html.EscapeString(entry.Date))
+		fmt.Fprintf(&b, "<header class=\"entry-date\">%s</header>\n", longDate(entry.Date))
+		b.WriteString(rendered)
+		b.WriteString("</article>\n")
+	}
+
+	b.WriteString("</body>\n</html>\n")
+	return b.String(), nil
+}
+
+// bookHeading is the entry's title for the table of contents, or empty
+// when the entry has none beyond its date.
+func bookHeading(entry exportEntry) string {
+	heading := markdown.ExtractFirstHeading([]byte(entry.Content))
+	if heading == "(untitled)" || heading == entry.Date {
+		return ""
+	}
+	return heading
+}
+
+// longDate spells out a YYYY-MM-DD date for reading, e.g.
+// "Monday, January 15, 2024", ready to embed in HTML. Anything else is
+// escaped as it is.
+func longDate(date string) string {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return html.EscapeString(date)
+	}
+	return t.Format("Monday, January 2, 2006")
+}
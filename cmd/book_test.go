@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"logmd/markdown"
+)
+
+// TestExportBook tests the title page, table of contents and entry anchors.
+func TestExportBook(t *testing.T) {
+	renderer, err := markdown.NewRenderer(markdown.Options{Style: "notty"})
+	if err != nil {
+		t.Fatalf("Failed to create renderer: %v", err)
+	}
+
+	entries := []exportEntry{
+		{Date: "2024-01-14", Content: "# 2024-01-14\n\nQuiet day.\n"},
+		{Date: "2024-01-15", Content: "---\nmood: good\n---\n# Tom & Jerry\n\nWatched **cartoons**.\n"},
+	}
+	doc, err := exportBook(renderer, entries, "Winter <2024>")
+	if err != nil {
+		t.Fatalf("exportBook() failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"<title>Winter &lt;2024&gt;</title>",
+		"<style>",
+		"@media print",
+		`<h1 class="book-title">Winter &lt;2024&gt;</h1>`,
+		"Sunday, January 14, 2024 – Monday, January 15, 2024",
+		`<a class="toc-date" href="#2024-01-14">Sunday, January 14, 2024</a></li>`,
+		`<a href="#2024-01-15">Tom &amp; Jerry</a>`,
+		`<article class="entry" id="2024-01-15">`,
+		"<strong>cartoons</strong>",
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("Expected book to contain %q", want)
+		}
+	}
+	if strings.Contains(doc, "mood: good") {
+		t.Error("Expected front matter to be left out of the book")
+	}
+	if strings.Index(doc, `id="2024-01-14"`) > strings.Index(doc, `id="2024-01-15"`) {
+		t.Error("Expected entries in the order given, oldest first")
+	}
+
+	doc, err = exportBook(renderer, nil, "")
+	if err != nil {
+		t.Fatalf("exportBook() with no entries failed: %v", err)
+	}
+	if !strings.Contains(doc, "<title>Journal</title>") || strings.Contains(doc, `class="book-range"`) {
+		t.Errorf("Expected an empty book titled Journal, got:\n%s", doc)
+	}
+}
+
+// TestLongDate tests spelling out dates.
+func TestLongDate(t *testing.T) {
+	if got := longDate("2024-02-29"); got != "Thursday, February 29, 2024" {
+		t.Errorf("longDate(2024-02-29) = %q", got)
+	}
+	if got := longDate("<weird>"); got != "&lt;weird&gt;" {
+		t.Errorf("longDate(<weird>) = %q", got)
+	}
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"logmd/config"
+)
+
+// todayCellStyle underlines today's date in the month grid
+var todayCellStyle = lipgloss.NewStyle().Underline(true)
+
+// calendarCmd represents the calendar command
+// Learn: time.Date normalizes out-of-range values, so day 0 of next month is the last day of this one.
+// See: https://pkg.go.dev/time#Date
+var calendarCmd = &cobra.Command{
+	Use:   "calendar [YYYY-MM]",
+	Short: "Show a month grid highlighting days with entries",
+	Long: `Prints a calendar for the given month (default the current month) with
+weeks starting on Monday. Days that have a journal entry are highlighted,
+days without one are dimmed, and today is underlined.
+
+Examples:
+  logmd calendar
+  logmd calendar 2024-02`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runCalendarCommand,
+}
+
+// runCalendarCommand implements the core logic for the calendar command.
+func runCalendarCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Resolve the month to show
+	now := cfg.Today()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	if len(args) == 1 {
+		parsed, err := time.ParseInLocation("2006-01", args[0], now.Location())
+		if err != nil {
+			return fmt.Errorf("invalid month format: %s (expected YYYY-MM)", args[0])
+		}
+		month = parsed
+	}
+
+	// Step 3: Create vault instance
+	v, err := newVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Render the month
+	fmt.Println(renderMonthCalendar(month, v.EntryExists, now))
+	return nil
+}
+
+// renderMonthCalendar lays out a Monday-first grid for the month containing
+// month, styling each day by whether hasEntry reports an entry for it.
+func renderMonthCalendar(month time.Time, hasEntry func(date string) bool, today time.Time) string {
+	first := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	daysInMonth := time.Date(month.Year(), month.Month()+1, 0, 0, 0, 0, 0, month.Location()).Day()
+
+	var b strings.Builder
+	b.WriteString(streakStyle.Render(first.Format("January 2006")))
+	b.WriteString("\n")
+	b.WriteString(calendarLabelStyle.Render("Mo Tu We Th Fr Sa Su"))
+	b.WriteString("\n")
+
+	// Pad the first row up to the month's first weekday
+	column := (int(first.Weekday()) + 6) % 7
+	b.WriteString(strings.Repeat("   ", column))
+
+	entries := 0
+	for day := 1; day <= daysInMonth; day++ {
+		date := first.AddDate(0, 0, day-1)
+
+		cell := fmt.Sprintf("%2d", day)
+		if hasEntry(date.Format("2006-01-02")) {
+			cell = filledDayStyle.Bold(true).Render(cell)
+			entries++
+		} else {
+			cell = emptyDayStyle.Render(cell)
+		}
+		if date.Year() == today.Year() && date.YearDay() == today.YearDay() {
+			cell = todayCellStyle.Render(cell)
+		}
+		b.WriteString(cell)
+
+		column++
+		if column == 7 && day < daysInMonth {
+			b.WriteString("\n")
+			column = 0
+		} else if day < daysInMonth {
+			b.WriteString(" ")
+		}
+	}
+
+	b.WriteString("\n\n")
+	b.WriteString(calendarLabelStyle.Render(fmt.Sprintf("%d of %d days written", entries, daysInMonth)))
+
+	return b.String()
+}
+
+func init() {
+	rootCmd.AddCommand(calendarCmd)
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// TestRenderMonthCalendar tests the grid layout for a leap-year February.
+func TestRenderMonthCalendar(t *testing.T) {
+	month := time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local)
+	entries := map[string]bool{"2024-02-01": true, "2024-02-29": true}
+	hasEntry := func(date string) bool { return entries[date] }
+
+	output := ansi.Strip(renderMonthCalendar(month, hasEntry, time.Now()))
+	lines := strings.Split(output, "\n")
+
+	expected := []string{
+		"February 2024",
+		"Mo Tu We Th Fr Sa Su",
+		"          1  2  3  4", // 1 February 2024 is a Thursday
+		" 5  6  7  8  9 10 11",
+		"12 13 14 15 16 17 18",
+		"19 20 21 22 23 24 25",
+		"26 27 28 29",
+		"",
+		"2 of 29 days written",
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d lines, got %d:\n%s", len(expected), len(lines), output)
+	}
+	for i, want := range expected {
+		if lines[i] != want {
+			t.Errorf("Line %d: expected %q, got %q", i, want, lines[i])
+		}
+	}
+}
+
+// TestRunCalendarCommand tests month argument handling.
+func TestRunCalendarCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-calendar-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	if err := runCalendarCommand(nil, []string{}); err != nil {
+		t.Errorf("runCalendarCommand() failed: %v", err)
+	}
+	if err := runCalendarCommand(nil, []string{"2024-02"}); err != nil {
+		t.Errorf("runCalendarCommand() with a month failed: %v", err)
+	}
+	if err := runCalendarCommand(nil, []string{"2024-2-01"}); err == nil {
+		t.Error("Expected error for invalid month")
+	}
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/vault"
+)
+
+// catFrom and catTo bound the printed dates (inclusive, empty means open)
+var (
+	catFrom string
+	catTo   string
+)
+
+// catCmd represents the cat command
+// Learn: Scriptable commands should print stable, line-oriented output.
+// See: https://clig.dev/#output
+var catCmd = &cobra.Command{
+	Use:   "cat [YYYY-MM-DD]",
+	Short: "Print the raw markdown of entries",
+	Long: `Prints the markdown source of an entry to stdout exactly as stored, with
+no rendering, front matter stripping or header, for piping into other
+tools. Encrypted entries are printed decrypted.
+
+With --from and/or --to instead of a date, prints every entry in that
+range, oldest first, one after another. An entry that doesn't end in a
+newline gets one so it can't run into the next entry's heading.
+
+Examples:
+  logmd cat 2024-01-15
+  logmd cat 2024-01-15 | wc -w
+  logmd cat --from 2024-01-01 --to 2024-01-07 > week.md`,
+	Args: catArgs,
+	RunE: runCatCommand,
+}
+
+// catArgs requires a date unless --from or --to selects a range instead.
+func catArgs(cmd *cobra.Command, args []string) error {
+	if catFrom != "" || catTo != "" {
+		return cobra.NoArgs(cmd, args)
+	}
+	return cobra.ExactArgs(1)(cmd, args)
+}
+
+// runCatCommand implements the core logic for the cat command.
+func runCatCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Validate the date or range bounds
+	for _, date := range append(slices.Clone(args), catFrom, catTo) {
+		if date != "" && !isValidDateFormat(date) {
+			return invalidDateError(date)
+		}
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Open the vault
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Resolve the dates to print
+	dates := args
+	if len(args) == 0 {
+		if dates, err = catRangeDates(v, catFrom, catTo); err != nil {
+			return err
+		}
+	}
+
+	// Step 5: Print the entries as stored
+	return catEntries(os.Stdout, v, dates)
+}
+
+// catRangeDates returns the dates of the entries between from and to,
+// oldest first, so concatenating them reads in order.
+func catRangeDates(v *vault.Vault, from, to string) ([]string, error) {
+	filenames, err := v.ListEntriesInRange(from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entries: %w", err)
+	}
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no entries between %s and %s", orOpen(from), orOpen(to))
+	}
+
+	dates := make([]string, 0, len(filenames))
+	for _, filename := range filenames {
+		dates = append(dates, strings.TrimSuffix(filename, ".md"))
+	}
+	slices.Reverse(dates)
+	return dates, nil
+}
+
+// orOpen describes an empty range bound.
+func orOpen(bound string) string {
+	if bound == "" {
+		return "(open)"
+	}
+	return bound
+}
+
+// catEntries writes the content of each entry to w, stopping at the first
+// entry that can't be read. A single entry is written exactly as stored;
+// when there are several, each is ended with a newline if it lacks one.
+func catEntries(w io.Writer, v *vault.Vault, dates []string) error {
+	for _, date := range dates {
+		content, err := v.ReadEntry(date)
+		if err != nil {
+			return fmt.Errorf("failed to read entry: %w", err)
+		}
+		if len(dates) > 1 && len(content) > 0 && !bytes.HasSuffix(content, []byte("\n")) {
+			content = append(content, '\n')
+		}
+		if _, err := w.Write(content); err != nil {
+			return fmt.Errorf("failed to write entry %s: %w", date, err)
+		}
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(catCmd)
+	catCmd.Flags().StringVar(&catFrom, "from", "", "print entries on or after this date (YYYY-MM-DD) instead of one date")
+	catCmd.Flags().StringVar(&catTo, "to", "", "print entries on or before this date (YYYY-MM-DD) instead of one date")
+}
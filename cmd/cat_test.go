@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestCatEntries tests printing entries byte for byte, front matter included.
+func TestCatEntries(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-cat-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	entries := map[string]string{
+		"2024-01-14": "---\nmood: calm\n---\n# Sunday\n\n**Rest**   day\n",
+		"2024-01-15": "# Monday\r\nNo trailing newline",
+		"2024-01-20": "# Saturday\n",
+	}
+	for date, content := range entries {
+		if err := v.WriteEntry(date, []byte(content)); err != nil {
+			t.Fatalf("Failed to write entry %s: %v", date, err)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := catEntries(&out, v, []string{"2024-01-14"}); err != nil {
+		t.Fatalf("catEntries() failed: %v", err)
+	}
+	if out.String() != entries["2024-01-14"] {
+		t.Errorf("Expected the exact file content, got %q", out.String())
+	}
+
+	// A range is printed oldest first, each entry ending in a newline
+	dates, err := catRangeDates(v, "2024-01-14", "2024-01-20")
+	if err != nil {
+		t.Fatalf("catRangeDates() failed: %v", err)
+	}
+	out.Reset()
+	if err := catEntries(&out, v, dates); err != nil {
+		t.Fatalf("catEntries() failed: %v", err)
+	}
+	if expected := entries["2024-01-14"] + entries["2024-01-15"] + "\n" + entries["2024-01-20"]; out.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, out.String())
+	}
+
+	if dates, err := catRangeDates(v, "2024-01-15", ""); err != nil || strings.Join(dates, " ") != "2024-01-15 2024-01-20" {
+		t.Errorf("Expected an open range to reach the newest entry, got %v, %v", dates, err)
+	}
+	if _, err := catRangeDates(v, "2024-02-01", "2024-02-29"); err == nil || !strings.Contains(err.Error(), "no entries between") {
+		t.Errorf("Expected an error for an empty range, got %v", err)
+	}
+	if _, err := catRangeDates(v, "2024-01-20", "2024-01-14"); err == nil {
+		t.Error("Expected an error for a reversed range")
+	}
+	if err := catEntries(&out, v, []string{"2024-01-16"}); !errors.Is(err, vault.ErrEntryNotFound) {
+		t.Errorf("Expected ErrEntryNotFound, got %v", err)
+	}
+}
+
+// TestRunCatCommand tests argument handling for the cat command.
+func TestRunCatCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-cat-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		catFrom, catTo = "", ""
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-15", []byte("# Monday\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	if err := runCatCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Errorf("runCatCommand() failed: %v", err)
+	}
+	if err := runCatCommand(nil, []string{"2024-13-01"}); err == nil || !strings.Contains(err.Error(), "invalid date") {
+		t.Errorf("Expected an invalid date error, got %v", err)
+	}
+	if err := runCatCommand(nil, []string{"2024-01-16"}); !errors.Is(err, vault.ErrEntryNotFound) {
+		t.Errorf("Expected ErrEntryNotFound, got %v", err)
+	}
+	if err := catArgs(catCmd, []string{}); err == nil {
+		t.Error("Expected a date to be required without a range")
+	}
+
+	catFrom = "2024-1-1"
+	if err := runCatCommand(nil, []string{}); err == nil || !strings.Contains(err.Error(), "invalid date") {
+		t.Errorf("Expected an invalid --from error, got %v", err)
+	}
+
+	catFrom = "2024-01-01"
+	if err := catArgs(catCmd, []string{"2024-01-15"}); err == nil {
+		t.Error("Expected a range to reject a date")
+	}
+	if err := runCatCommand(nil, []string{}); err != nil {
+		t.Errorf("runCatCommand() --from failed: %v", err)
+	}
+}
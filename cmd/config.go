@@ -3,7 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
-	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"logmd/config"
@@ -24,11 +24,98 @@ troubleshoot any configuration issues.
 
 Configuration precedence (highest to lowest):
 1. Environment variables (LOGMD_*)
-2. Configuration file (~/.logmdconfig)  
-3. Default values`,
+2. Configuration file ($XDG_CONFIG_HOME/logmd/config.toml,
+   ~/.config/logmd/config.toml, or ~/.logmdconfig, first found wins)
+3. Default values
+
+Use 'logmd init' for a guided first-time setup,
+'logmd config init' to write a config file with the current values,
+'logmd config set <key> <value>' to change a single setting,
+'logmd config validate' to check the file for typos and bad values, and
+'logmd config profiles' to list named journal directories.`,
 	RunE: runConfigCommand,
 }
 
+// configInitForce allows config init to overwrite an existing file
+var configInitForce bool
+
+// configInitCmd represents the config init command
+// Learn: Subcommands are attached to a parent with AddCommand, just like top-level commands.
+// See: https://github.com/spf13/cobra/blob/main/site/content/user_guide.md#create-additional-commands
+var configInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Write a commented config file with the current settings",
+	Long: `Writes the config file containing the current effective settings, each
+with a comment explaining it. The file in use is overwritten only with
+--force; when there is none, ~/.logmdconfig is created.
+
+Examples:
+  logmd config init
+  logmd config init --force`,
+	Args: cobra.NoArgs,
+	RunE: runConfigInitCommand,
+}
+
+// configSetCmd represents the config set command
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Change a single setting in the config file",
+	Long: `Updates one key in the config file in use, creating ~/.logmdconfig if
+there is none. Other keys in the file are kept as they are.
+
+Valid keys: directory, editor, editor_args, editor_wait, preview_lines,
+preview_from, rendered_preview, page_size, style, code_theme, wiki_links,
+word_wrap, word_goal, layout, date_format, timezone, day_start_hour,
+git_auto_commit, use_trash, default_command, encrypt, front_matter,
+front_matter_fields
+
+Examples:
+  logmd config set editor code
+  logmd config set editor_args '--wait --goto {file}:3'
+  logmd config set preview_lines 8
+  logmd config set timezone America/New_York
+  logmd config set code_theme monokai
+  logmd config set default_command timeline
+  logmd config set front_matter_fields "date, mood, tags"`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConfigSetCommand,
+}
+
+// configValidateCmd represents the config validate command
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the config file for unknown keys and invalid values",
+	Long: `Parses the config file in use and reports unknown keys (such as the
+typo "previewlines"), values of the wrong type and values out of range.
+Settings are otherwise loaded leniently, so a misspelled key is silently
+ignored. Exits with status 4, like an unloadable config, when any problem
+is found.
+
+Examples:
+  logmd config validate`,
+	Args: cobra.NoArgs,
+	RunE: runConfigValidateCommand,
+}
+
+// configProfilesCmd represents the config profiles command
+var configProfilesCmd = &cobra.Command{
+	Use:   "profiles",
+	Short: "List the configured journal profiles",
+	Long: `Lists the named journal directories from the [profiles] table of the
+config file and marks the active one. Select a profile with --profile
+<name> or LOGMD_PROFILE; without one, the directory setting is used.
+
+  [profiles]
+  work = "/path/to/work-journal"
+  personal = "/path/to/personal-journal"
+
+Examples:
+  logmd config profiles
+  logmd --profile work today`,
+	Args: cobra.NoArgs,
+	RunE: runConfigProfilesCommand,
+}
+
 // runConfigCommand implements the core logic for the config command.
 // Learn: Separating command logic into functions makes testing and maintenance easier.
 func runConfigCommand(cmd *cobra.Command, args []string) error {
@@ -48,9 +135,11 @@ func runConfigCommand(cmd *cobra.Command, args []string) error {
 	if configPath != "" {
 		fmt.Printf("📄 Config File: %s\n", configPath)
 	} else {
-		homeDir, _ := os.UserHomeDir()
-		expectedPath := filepath.Join(homeDir, ".logmdconfig")
-		fmt.Printf("📄 Config File: %s (not found)\n", expectedPath)
+		fmt.Println("📄 Config File: not found, searched:")
+		searchPaths, _ := config.SearchPaths()
+		for _, path := range searchPaths {
+			fmt.Printf("   • %s\n", path)
+		}
 	}
 	fmt.Println()
 
@@ -58,9 +147,54 @@ func runConfigCommand(cmd *cobra.Command, args []string) error {
 	fmt.Println("⚙️  Current Settings:")
 	fmt.Println()
 
-	displaySetting("Directory", cfg.Directory, getSettingSource("LOGMD_DIRECTORY", configPath != ""))
+	directorySource := getSettingSource("LOGMD_DIRECTORY", configPath != "")
+	if cfg.Profile != "" {
+		directorySource = fmt.Sprintf("👤 Profile (%s)", cfg.Profile)
+	}
+	displaySetting("Directory", cfg.Directory, directorySource)
 	displaySetting("Editor", cfg.Editor, getSettingSource("LOGMD_EDITOR", configPath != ""))
+	editorArgs := cfg.EditorArgs
+	if editorArgs == "" {
+		editorArgs = config.FilePlaceholder
+	}
+	displaySetting("Editor Args", editorArgs, getSettingSource("LOGMD_EDITOR_ARGS", configPath != ""))
+	displaySetting("Editor Wait", fmt.Sprintf("%t", cfg.EditorWait), getSettingSource("LOGMD_EDITOR_WAIT", configPath != ""))
 	displaySetting("Preview Lines", fmt.Sprintf("%d", cfg.PreviewLines), getSettingSource("LOGMD_PREVIEW_LINES", configPath != ""))
+	displaySetting("Preview From", cfg.PreviewFrom, getSettingSource("LOGMD_PREVIEW_FROM", configPath != ""))
+	displaySetting("Rendered Preview", fmt.Sprintf("%t", cfg.RenderedPreview), getSettingSource("LOGMD_RENDERED_PREVIEW", configPath != ""))
+	pageSize := fmt.Sprintf("%d", cfg.PageSize)
+	if cfg.PageSize == 0 {
+		pageSize = "(screenful)"
+	}
+	displaySetting("Page Size", pageSize, getSettingSource("LOGMD_PAGE_SIZE", configPath != ""))
+	displaySetting("Style", cfg.Style, getSettingSource("LOGMD_STYLE", configPath != ""))
+	codeTheme := cfg.CodeTheme
+	if codeTheme == "" {
+		codeTheme = "(style default)"
+	}
+	displaySetting("Code Theme", codeTheme, getSettingSource("LOGMD_CODE_THEME", configPath != ""))
+	displaySetting("Wiki Links", fmt.Sprintf("%t", cfg.WikiLinks), getSettingSource("LOGMD_WIKI_LINKS", configPath != ""))
+	displaySetting("Word Wrap", fmt.Sprintf("%d", cfg.WordWrap), getSettingSource("LOGMD_WORD_WRAP", configPath != ""))
+	displaySetting("Word Goal", fmt.Sprintf("%d", cfg.WordGoal), getSettingSource("LOGMD_WORD_GOAL", configPath != ""))
+	displaySetting("Layout", cfg.Layout, getSettingSource("LOGMD_LAYOUT", configPath != ""))
+	displaySetting("Date Format", cfg.DateFormat, getSettingSource("LOGMD_DATE_FORMAT", configPath != ""))
+	displaySetting("Timezone", cfg.Location().String(), getSettingSource("LOGMD_TIMEZONE", configPath != ""))
+	displaySetting("Day Start Hour", fmt.Sprintf("%d", cfg.DayStartHour), getSettingSource("LOGMD_DAY_START_HOUR", configPath != ""))
+	displaySetting("Encrypt", fmt.Sprintf("%t", cfg.Encrypt), getSettingSource("LOGMD_ENCRYPT", configPath != ""))
+	displaySetting("Front Matter", fmt.Sprintf("%t", cfg.FrontMatter), getSettingSource("LOGMD_FRONT_MATTER", configPath != ""))
+	displaySetting("Front Matter Fields", strings.Join(cfg.FrontMatterFields, ", "), getSettingSource("LOGMD_FRONT_MATTER_FIELDS", configPath != ""))
+	displaySetting("Git Auto-Commit", fmt.Sprintf("%t", cfg.GitAutoCommit), getSettingSource("LOGMD_GIT_AUTO_COMMIT", configPath != ""))
+	defaultCommand := cfg.DefaultCommand
+	if defaultCommand == "" {
+		defaultCommand = "(help)"
+	}
+	displaySetting("Default Command", defaultCommand, getSettingSource("LOGMD_DEFAULT_COMMAND", configPath != ""))
+	displaySetting("Use Trash", fmt.Sprintf("%t", cfg.UseTrash), getSettingSource("LOGMD_USE_TRASH", configPath != ""))
+	displaySetting("LLM API Key", maskSecret(cfg.LLMAPIKey), getSettingSource("LOGMD_LLM_API_KEY", configPath != ""))
+	displaySetting("LLM URL", cfg.LLMURL, getSettingSource("LOGMD_LLM_URL", configPath != ""))
+	if cfg.Profile != "" {
+		displaySetting("Profile", cfg.Profile, profileSource())
+	}
 
 	fmt.Println()
 
@@ -69,13 +203,125 @@ func runConfigCommand(cmd *cobra.Command, args []string) error {
 
 	// Show usage instructions
 	fmt.Println("💡 Tips:")
-	fmt.Printf("   • Create config file: echo 'directory = \"%s\"' > ~/.logmdconfig\n", cfg.Directory)
+	fmt.Println("   • Create config file: logmd config init")
+	fmt.Println("   • Change a setting: logmd config set preview_lines 8")
 	fmt.Println("   • Set environment variable: export LOGMD_DIRECTORY=/path/to/journal")
 	fmt.Println("   • Override editor: export LOGMD_EDITOR=code")
 
 	return nil
 }
 
+// runConfigInitCommand writes the current configuration to the config file.
+func runConfigInitCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Load the effective configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Refuse to clobber an existing file
+	path, err := config.WritePath()
+	if err != nil {
+		return fmt.Errorf("failed to locate config file: %w", err)
+	}
+	if _, err := os.Stat(path); err == nil && !configInitForce {
+		return fmt.Errorf("config file %s already exists (use --force to overwrite)", path)
+	}
+
+	// Step 3: Write the commented file
+	if err := os.WriteFile(path, []byte(config.Template(cfg)), 0644); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	fmt.Printf("✅ Wrote config file: %s\n", path)
+	return nil
+}
+
+// runConfigSetCommand updates a single key in the config file.
+func runConfigSetCommand(cmd *cobra.Command, args []string) error {
+	key, value := args[0], args[1]
+
+	path, err := config.WritePath()
+	if err != nil {
+		return fmt.Errorf("failed to locate config file: %w", err)
+	}
+
+	if err := config.Set(path, key, value); err != nil {
+		return fmt.Errorf("failed to set %s: %w", key, err)
+	}
+
+	fmt.Printf("✅ Set %s = %s in %s\n", key, value, path)
+	return nil
+}
+
+// runConfigValidateCommand reports the problems in the config file in use.
+func runConfigValidateCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Find the config file
+	path := config.GetConfigPath()
+	if path == "" {
+		fmt.Println("📄 No config file found; defaults are in use")
+		return nil
+	}
+
+	// Step 2: Check it
+	problems, err := config.Validate(path)
+	if err != nil {
+		return fmt.Errorf("failed to validate %s: %w", path, err)
+	}
+
+	// Step 3: Report each problem with a way to fix it
+	if len(problems) == 0 {
+		fmt.Printf("✅ %s is valid\n", path)
+		return nil
+	}
+	fmt.Printf("📄 %s\n\n", path)
+	for _, problem := range problems {
+		fmt.Printf("   ❌ %s: %s\n", problem.Key, problem.Message)
+		fmt.Printf("      💡 %s\n", problem.Suggestion)
+	}
+	fmt.Println()
+
+	return fmt.Errorf("%w: found %d problem(s) in %s", config.ErrInvalidConfig, len(problems), path)
+}
+
+// runConfigProfilesCommand lists the configured profiles.
+func runConfigProfilesCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	names := cfg.ProfileNames()
+	if len(names) == 0 {
+		fmt.Println("👤 No profiles configured. Add a [profiles] table to the config file:")
+		fmt.Println()
+		fmt.Println("   [profiles]")
+		fmt.Println("   work = \"/path/to/work-journal\"")
+		return nil
+	}
+
+	fmt.Println("👤 Profiles:")
+	for _, name := range names {
+		marker := " "
+		if name == cfg.Profile {
+			marker = "*"
+		}
+		fmt.Printf(" %s %-15s %s\n", marker, name, cfg.Profiles[name])
+	}
+	if cfg.Profile == "" {
+		fmt.Printf("\nNo profile active; using %s\n", cfg.Directory)
+	}
+	return nil
+}
+
+// profileSource describes where the active profile was selected.
+func profileSource() string {
+	if profileName != "" {
+		return "🚩 Command-line flag (--profile)"
+	}
+	return getSettingSource("LOGMD_PROFILE", true)
+}
+
 // displaySetting shows a configuration setting with its value and source.
 // Learn: Helper functions improve code readability and maintainability.
 func displaySetting(name, value, source string) {
@@ -94,7 +340,7 @@ func getSettingSource(envVar string, hasConfigFile bool) string {
 
 	// Check if we have a config file
 	if hasConfigFile {
-		return "📄 Configuration file (~/.logmdconfig)"
+		return "📄 Configuration file"
 	}
 
 	// Must be default value
@@ -103,7 +349,7 @@ func getSettingSource(envVar string, hasConfigFile bool) string {
 
 // showEnvironmentVariables displays any set logmd environment variables.
 func showEnvironmentVariables() {
-	envVars := []string{"LOGMD_DIRECTORY", "LOGMD_EDITOR", "LOGMD_PREVIEW_LINES", "EDITOR"}
+	envVars := []string{"LOGMD_DIRECTORY", "LOGMD_EDITOR", "LOGMD_EDITOR_ARGS", "LOGMD_EDITOR_WAIT", "LOGMD_PREVIEW_LINES", "LOGMD_PREVIEW_FROM", "LOGMD_RENDERED_PREVIEW", "LOGMD_PAGE_SIZE", "LOGMD_STYLE", "LOGMD_CODE_THEME", "LOGMD_WIKI_LINKS", "LOGMD_WORD_WRAP", "LOGMD_WORD_GOAL", "LOGMD_LAYOUT", "LOGMD_DATE_FORMAT", "LOGMD_TIMEZONE", "LOGMD_DAY_START_HOUR", "LOGMD_GIT_AUTO_COMMIT", "LOGMD_USE_TRASH", "LOGMD_DEFAULT_COMMAND", "LOGMD_ENCRYPT", "LOGMD_FRONT_MATTER", "LOGMD_FRONT_MATTER_FIELDS", "LOGMD_LLM_URL", "LOGMD_LLM_MODEL", "LOGMD_PROFILE", "EDITOR"}
 	hasEnvVars := false
 
 	for _, envVar := range envVars {
@@ -121,6 +367,14 @@ func showEnvironmentVariables() {
 	}
 }
 
+// maskSecret hides a secret value, showing only whether it is set.
+func maskSecret(value string) string {
+	if value == "" {
+		return "(not set)"
+	}
+	return "(set)"
+}
+
 // repeatString repeats a string n times.
 // Learn: Helper functions for string manipulation are common in CLI tools.
 func repeatString(s string, n int) string {
@@ -132,5 +386,10 @@ func repeatString(s string, n int) string {
 }
 
 func init() {
+	configInitCmd.Flags().BoolVar(&configInitForce, "force", false, "overwrite an existing config file")
+	configCmd.AddCommand(configInitCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configValidateCmd)
+	configCmd.AddCommand(configProfilesCmd)
 	rootCmd.AddCommand(configCmd)
 }
@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"logmd/config"
 )
 
 // TestRunConfigCommand tests the config command with default settings.
@@ -126,6 +128,151 @@ preview_lines = 5`
 	}
 }
 
+// TestRunConfigInitCommand tests writing the config file and the --force guard.
+func TestRunConfigInitCommand(t *testing.T) {
+	originalVars := saveEnvironment()
+	defer restoreEnvironment(originalVars)
+	defer func() { configInitForce = false }()
+
+	clearLogmdEnvironment()
+
+	tmpDir, err := os.MkdirTemp("", "logmd-config-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	os.Setenv("HOME", tmpDir)
+	os.Setenv("LOGMD_EDITOR", "nano")
+
+	if err := runConfigInitCommand(nil, []string{}); err != nil {
+		t.Fatalf("runConfigInitCommand() failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, ".logmdconfig"))
+	if err != nil {
+		t.Fatalf("Expected config file to be written: %v", err)
+	}
+	if !strings.Contains(string(content), `editor = "nano"`) {
+		t.Errorf("Expected effective editor in config file, got:\n%s", content)
+	}
+
+	// A second run must not overwrite without --force
+	if err := runConfigInitCommand(nil, []string{}); err == nil {
+		t.Error("Expected error when config file already exists")
+	}
+
+	configInitForce = true
+	if err := runConfigInitCommand(nil, []string{}); err != nil {
+		t.Errorf("runConfigInitCommand() with --force failed: %v", err)
+	}
+
+	// config set updates the file that init wrote
+	if err := runConfigSetCommand(nil, []string{"preview_lines", "3"}); err != nil {
+		t.Fatalf("runConfigSetCommand() failed: %v", err)
+	}
+	if err := runConfigSetCommand(nil, []string{"preview_lines", "-3"}); err == nil {
+		t.Error("Expected error for non-positive preview_lines")
+	}
+}
+
+// TestRunConfigValidateCommand tests that problems in the config file fail
+// the command.
+func TestRunConfigValidateCommand(t *testing.T) {
+	originalVars := saveEnvironment()
+	defer restoreEnvironment(originalVars)
+
+	clearLogmdEnvironment()
+
+	tmpDir, err := os.MkdirTemp("", "logmd-config-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	os.Setenv("HOME", tmpDir)
+
+	// No config file is nothing to complain about
+	if err := runConfigValidateCommand(nil, []string{}); err != nil {
+		t.Errorf("runConfigValidateCommand() without a config file failed: %v", err)
+	}
+
+	configPath := filepath.Join(tmpDir, ".logmdconfig")
+	if err := os.WriteFile(configPath, []byte("editor = \"code\"\npreview_lines = 7\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	if err := runConfigValidateCommand(nil, []string{}); err != nil {
+		t.Errorf("runConfigValidateCommand() with a valid file failed: %v", err)
+	}
+
+	if err := os.WriteFile(configPath, []byte("previewlines = 7\nlayout = \"tree\"\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	err = runConfigValidateCommand(nil, []string{})
+	if err == nil || !strings.Contains(err.Error(), "2 problem(s)") {
+		t.Errorf("Expected 2 problems to be reported, got %v", err)
+	}
+	if code := exitCode(err); code != exitConfig {
+		t.Errorf("Expected exit code %d for an invalid config, got %d", exitConfig, code)
+	}
+}
+
+// TestRunConfigProfilesCommand tests listing profiles and selecting one
+// with --profile.
+func TestRunConfigProfilesCommand(t *testing.T) {
+	originalVars := saveEnvironment()
+	defer restoreEnvironment(originalVars)
+	defer func() {
+		profileName = ""
+		config.SetProfile("")
+	}()
+
+	clearLogmdEnvironment()
+
+	tmpDir, err := os.MkdirTemp("", "logmd-config-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	os.Setenv("HOME", tmpDir)
+
+	// Listing works before any profile is configured
+	if err := runConfigProfilesCommand(nil, []string{}); err != nil {
+		t.Errorf("runConfigProfilesCommand() without profiles failed: %v", err)
+	}
+
+	workDir := filepath.Join(tmpDir, "work")
+	content := "directory = \"" + filepath.Join(tmpDir, "journal") + "\"\n\n[profiles]\nwork = \"" + workDir + "\"\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".logmdconfig"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	// --profile is applied before the command loads its config
+	profileName = "work"
+	applyGlobalFlags(rootCmd, nil)
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("config.Load() with --profile failed: %v", err)
+	}
+	if cfg.Directory != workDir {
+		t.Errorf("Expected the work profile directory %s, got %s", workDir, cfg.Directory)
+	}
+	if err := runConfigProfilesCommand(nil, []string{}); err != nil {
+		t.Errorf("runConfigProfilesCommand() failed: %v", err)
+	}
+	if err := runConfigCommand(nil, []string{}); err != nil {
+		t.Errorf("runConfigCommand() with a profile failed: %v", err)
+	}
+
+	// An unknown profile fails every command that loads the config
+	profileName = "play"
+	applyGlobalFlags(rootCmd, nil)
+	if err := runListCommand(nil, []string{}); err == nil || !strings.Contains(err.Error(), "unknown profile") {
+		t.Errorf("Expected an unknown profile error, got %v", err)
+	}
+}
+
 // TestGetSettingSource tests the setting source detection function.
 func TestGetSettingSource(t *testing.T) {
 	// Save original environment
@@ -151,7 +298,7 @@ func TestGetSettingSource(t *testing.T) {
 			envVar:         "LOGMD_DIRECTORY",
 			envValue:       "",
 			hasConfigFile:  true,
-			expectedSource: "📄 Configuration file (~/.logmdconfig)",
+			expectedSource: "📄 Configuration file",
 		},
 		{
 			name:           "DefaultValue",
@@ -264,8 +411,9 @@ func TestConfigCommandRegistration(t *testing.T) {
 func saveEnvironment() map[string]string {
 	saved := make(map[string]string)
 	envVars := []string{
-		"LOGMD_DIRECTORY", "LOGMD_EDITOR", "LOGMD_PREVIEW_LINES",
-		"EDITOR", "HOME",
+		"LOGMD_DIRECTORY", "LOGMD_EDITOR", "LOGMD_EDITOR_ARGS", "LOGMD_EDITOR_WAIT", "LOGMD_PREVIEW_LINES", "LOGMD_PREVIEW_FROM", "LOGMD_RENDERED_PREVIEW", "LOGMD_PAGE_SIZE",
+		"LOGMD_STYLE", "LOGMD_CODE_THEME", "LOGMD_WORD_WRAP", "LOGMD_WORD_GOAL", "LOGMD_LAYOUT", "LOGMD_DATE_FORMAT", "LOGMD_TIMEZONE", "LOGMD_DAY_START_HOUR", "LOGMD_LLM_API_KEY", "LOGMD_LLM_URL",
+		"LOGMD_LLM_MODEL", "LOGMD_GIT_AUTO_COMMIT", "LOGMD_USE_TRASH", "LOGMD_WIKI_LINKS", "LOGMD_DEFAULT_COMMAND", "LOGMD_ENCRYPT", "LOGMD_FRONT_MATTER", "LOGMD_FRONT_MATTER_FIELDS", "LOGMD_PROFILE", "LOGMD_PASSPHRASE", "EDITOR", "HOME", "XDG_CONFIG_HOME",
 	}
 
 	for _, envVar := range envVars {
@@ -292,7 +440,9 @@ func restoreEnvironment(saved map[string]string) {
 // clearLogmdEnvironment clears all logmd-related environment variables.
 func clearLogmdEnvironment() {
 	envVars := []string{
-		"LOGMD_DIRECTORY", "LOGMD_EDITOR", "LOGMD_PREVIEW_LINES",
+		"LOGMD_DIRECTORY", "LOGMD_EDITOR", "LOGMD_EDITOR_ARGS", "LOGMD_EDITOR_WAIT", "LOGMD_PREVIEW_LINES", "LOGMD_PREVIEW_FROM", "LOGMD_RENDERED_PREVIEW", "LOGMD_PAGE_SIZE",
+		"LOGMD_STYLE", "LOGMD_CODE_THEME", "LOGMD_WORD_WRAP", "LOGMD_WORD_GOAL", "LOGMD_LAYOUT", "LOGMD_DATE_FORMAT", "LOGMD_TIMEZONE", "LOGMD_DAY_START_HOUR", "LOGMD_LLM_API_KEY", "LOGMD_LLM_URL",
+		"LOGMD_LLM_MODEL", "LOGMD_GIT_AUTO_COMMIT", "LOGMD_USE_TRASH", "LOGMD_WIKI_LINKS", "LOGMD_DEFAULT_COMMAND", "LOGMD_ENCRYPT", "LOGMD_FRONT_MATTER", "LOGMD_FRONT_MATTER_FIELDS", "LOGMD_PROFILE", "LOGMD_PASSPHRASE", "XDG_CONFIG_HOME",
 	}
 
 	for _, envVar := range envVars {
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"logmd/config"
+	"logmd/vault"
+)
+
+// closestDateCount is how many existing dates are offered for a miss.
+const closestDateCount = 5
+
+// dateSeparatorPattern matches the separators typed between date parts.
+var dateSeparatorPattern = regexp.MustCompile(`[-/._\s]+`)
+
+// normalizeDate rewrites common date spellings as YYYY-MM-DD: other
+// separators ("2024/01/15", "2024.01.15"), unpadded parts ("2024-1-5") and
+// the compact "20240115". Anything else is returned trimmed but unchanged,
+// so validation still rejects it.
+func normalizeDate(input string) string {
+	s := strings.TrimSpace(input)
+	if len(s) == 8 && isDigits(s) {
+		return s[:4] + "-" + s[4:6] + "-" + s[6:]
+	}
+
+	parts := dateSeparatorPattern.Split(s, -1)
+	if len(parts) != 3 || len(parts[0]) != 4 {
+		return s
+	}
+	for i := 1; i < 3; i++ {
+		if len(parts[i]) == 1 && isDigits(parts[i]) {
+			parts[i] = "0" + parts[i]
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// isDigits reports whether s is non-empty and all ASCII digits.
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// closestDates returns up to n of dates nearest to input. A valid input
+// date is compared by days apart; anything else by edit distance, which
+// catches typos such as a transposed digit. Ties keep the order of dates.
+func closestDates(input string, dates []string, n int) []string {
+	distance := func(date string) int { return config.EditDistance(input, date) }
+	if target, err := time.Parse(vault.DefaultDateFormat, input); err == nil {
+		distance = func(date string) int {
+			day, err := time.Parse(vault.DefaultDateFormat, date)
+			if err != nil {
+				return math.MaxInt
+			}
+			days := int(day.Sub(target).Hours() / 24)
+			if days < 0 {
+				days = -days
+			}
+			return days
+		}
+	}
+
+	closest := append([]string(nil), dates...)
+	sort.SliceStable(closest, func(i, j int) bool {
+		return distance(closest[i]) < distance(closest[j])
+	})
+	if len(closest) > n {
+		closest = closest[:n]
+	}
+	return closest
+}
+
+// pickClosestDate lists the existing entries closest to input on stderr
+// and, when interactive, reads a choice from in. It returns the picked date,
+// or miss when there is nothing to offer or no choice is made.
+func pickClosestDate(v vault.Store, input string, miss error, in io.Reader, interactive bool) (string, error) {
+	filenames, err := v.ListEntries()
+	if err != nil {
+		return "", fmt.Errorf("failed to list entries: %w", err)
+	}
+	dates := make([]string, len(filenames))
+	for i, filename := range filenames {
+		dates[i] = strings.TrimSuffix(filename, ".md")
+	}
+
+	closest := closestDates(input, dates, closestDateCount)
+	if len(closest) == 0 {
+		return "", miss
+	}
+
+	fmt.Fprintf(os.Stderr, "No entry for %s. Closest entries:\n", input)
+	for i, date := range closest {
+		fmt.Fprintf(os.Stderr, "  %d) %s\n", i+1, date)
+	}
+	if !interactive {
+		return "", miss
+	}
+
+	fmt.Fprintf(os.Stderr, "View which? [1-%d, Enter to cancel] ", len(closest))
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+	choice, err := strconv.Atoi(strings.TrimSpace(answer))
+	if err != nil || choice < 1 || choice > len(closest) {
+		return "", miss
+	}
+	return closest[choice-1], nil
+}
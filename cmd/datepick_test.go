@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestNormalizeDate tests common date spellings are rewritten as YYYY-MM-DD.
+func TestNormalizeDate(t *testing.T) {
+	testCases := map[string]string{
+		"2024-01-15":   "2024-01-15",
+		"2024/01/15":   "2024-01-15",
+		"2024.1.5":     "2024-01-05",
+		" 2024_01_15 ": "2024-01-15",
+		"2024 1 15":    "2024-01-15",
+		"20240115":     "2024-01-15",
+		"24-01-15":     "24-01-15",
+		"15/01/2024":   "15/01/2024",
+		"not-a-date":   "not-a-date",
+	}
+
+	for input, expected := range testCases {
+		if got := normalizeDate(input); got != expected {
+			t.Errorf("normalizeDate(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+}
+
+// TestClosestDates tests ordering by days apart or by edit distance.
+func TestClosestDates(t *testing.T) {
+	dates := []string{"2024-03-01", "2024-01-20", "2024-01-14", "2024-01-10", "2023-12-31"}
+
+	got := closestDates("2024-01-15", dates, 3)
+	if expected := []string{"2024-01-14", "2024-01-20", "2024-01-10"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v by days apart, got %v", expected, got)
+	}
+
+	// Month 13 isn't a date, so the transposed-looking typo wins on edits
+	got = closestDates("2024-13-01", dates, 1)
+	if expected := []string{"2024-03-01"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v by edit distance, got %v", expected, got)
+	}
+
+	if got := closestDates("2024-01-15", nil, 5); len(got) != 0 {
+		t.Errorf("Expected no suggestions without entries, got %v", got)
+	}
+}
+
+// TestPickClosestDate tests choosing a suggestion and cancelling.
+func TestPickClosestDate(t *testing.T) {
+	v := vault.NewMemory()
+	miss := errors.New("miss")
+
+	// An empty vault has nothing to offer
+	if _, err := pickClosestDate(v, "2024-01-15", miss, strings.NewReader("1\n"), true); err != miss {
+		t.Errorf("Expected the miss error for an empty vault, got %v", err)
+	}
+
+	for _, date := range []string{"2024-01-10", "2024-01-16"} {
+		if err := v.CreateEntry(date); err != nil {
+			t.Fatalf("Failed to create entry: %v", err)
+		}
+	}
+
+	testCases := []struct {
+		answer      string
+		interactive bool
+		expected    string
+	}{
+		{"1\n", true, "2024-01-16"},
+		{"2\n", true, "2024-01-10"},
+		{"\n", true, ""},
+		{"3\n", true, ""},
+		{"1\n", false, ""},
+	}
+	for _, tc := range testCases {
+		date, err := pickClosestDate(v, "2024-01-15", miss, strings.NewReader(tc.answer), tc.interactive)
+		if tc.expected == "" && err != miss {
+			t.Errorf("answer %q (interactive %t): expected the miss error, got %q, %v", tc.answer, tc.interactive, date, err)
+		}
+		if tc.expected != "" && (err != nil || date != tc.expected) {
+			t.Errorf("answer %q: expected %s, got %q, %v", tc.answer, tc.expected, date, err)
+		}
+	}
+}
+
+// TestRunViewCommandLenientDates tests normalized dates and misses outside --strict.
+func TestRunViewCommandLenientDates(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-datepick-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		viewRaw = false
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+	viewRaw = true
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.CreateEntry("2024-01-15"); err != nil {
+		t.Fatalf("Failed to create entry: %v", err)
+	}
+
+	if err := runViewCommand(nil, []string{"2024/1/15"}); err != nil {
+		t.Errorf("Expected 2024/1/15 to view 2024-01-15, got %v", err)
+	}
+
+	// Tests don't run in a terminal, so misses list suggestions and fail
+	if err := runViewCommand(nil, []string{"2024-01-16"}); !errors.Is(err, vault.ErrEntryNotFound) {
+		t.Errorf("Expected ErrEntryNotFound for a missing entry, got %v", err)
+	}
+	err = runViewCommand(nil, []string{"2024-13-15"})
+	if err == nil || !strings.Contains(err.Error(), "invalid date format") {
+		t.Errorf("Expected an invalid date format error, got %v", err)
+	}
+}
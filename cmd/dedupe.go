@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/vault"
+)
+
+// dedupeShow holds the --show flag value
+var dedupeShow bool
+
+// dedupeCmd represents the dedupe command
+var dedupeCmd = &cobra.Command{
+	Use:   "dedupe",
+	Short: "Report entries that share identical content",
+	Long: `Finds journal entries with byte-for-byte identical content, such as the
+same text pasted into two days, and lists them in groups. Entries that are
+empty or still only contain the new-entry template are grouped together so
+stale days stand out.
+
+Nothing is changed or deleted; use 'logmd edit' to fix entries by hand.
+
+Examples:
+  logmd dedupe
+  logmd dedupe --show`,
+	Args: cobra.NoArgs,
+	RunE: runDedupeCommand,
+}
+
+// runDedupeCommand implements the core logic for the dedupe command.
+func runDedupeCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Create vault instance
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Group entries by content
+	groups, err := v.Duplicates()
+	if err != nil {
+		return fmt.Errorf("failed to compare entries: %w", err)
+	}
+
+	if len(groups) == 0 {
+		fmt.Fprintln(os.Stderr, "No duplicate entries found")
+		return nil
+	}
+	for i, group := range groups {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Print(formatDuplicateGroup(group, dedupeShow))
+	}
+
+	return nil
+}
+
+// formatDuplicateGroup renders a group as a heading followed by its dates,
+// plus the shared content indented underneath when show is set.
+func formatDuplicateGroup(group vault.DuplicateGroup, show bool) string {
+	var b strings.Builder
+	if group.Blank {
+		fmt.Fprintf(&b, "📭 %d empty or template-only entries:\n", len(group.Dates))
+	} else {
+		fmt.Fprintf(&b, "🔁 %d entries with identical content (sha256 %s):\n", len(group.Dates), group.Hash[:12])
+	}
+	for _, date := range group.Dates {
+		fmt.Fprintf(&b, "  %s\n", date)
+	}
+
+	if show && !group.Blank {
+		b.WriteString("\n")
+		for _, line := range strings.Split(strings.TrimRight(string(group.Content), "\n"), "\n") {
+			fmt.Fprintf(&b, "    │ %s\n", line)
+		}
+	}
+	return b.String()
+}
+
+func init() {
+	dedupeCmd.Flags().BoolVar(&dedupeShow, "show", false, "print the shared content once per group")
+	rootCmd.AddCommand(dedupeCmd)
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestFormatDuplicateGroup tests the group output with and without --show.
+func TestFormatDuplicateGroup(t *testing.T) {
+	group := vault.DuplicateGroup{
+		Hash:    "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		Dates:   []string{"2024-01-12", "2024-01-10"},
+		Content: []byte("# Standup\n\n- shipped\n"),
+	}
+
+	expected := "🔁 2 entries with identical content (sha256 0123456789ab):\n  2024-01-12\n  2024-01-10\n"
+	if got := formatDuplicateGroup(group, false); got != expected {
+		t.Errorf("Expected:\n%q\nGot:\n%q", expected, got)
+	}
+
+	shown := formatDuplicateGroup(group, true)
+	if !strings.HasSuffix(shown, "\n    │ # Standup\n    │ \n    │ - shipped\n") {
+		t.Errorf("Expected shared content indented once, got:\n%s", shown)
+	}
+
+	blank := vault.DuplicateGroup{Blank: true, Dates: []string{"2024-01-13"}}
+	if got := formatDuplicateGroup(blank, true); got != "📭 1 empty or template-only entries:\n  2024-01-13\n" {
+		t.Errorf("Unexpected blank group output: %q", got)
+	}
+}
+
+// TestRunDedupeCommand tests the command against a real vault.
+func TestRunDedupeCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-dedupe-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		dedupeShow = false
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := runDedupeCommand(nil, []string{}); err != nil {
+		t.Errorf("runDedupeCommand() on an empty vault failed: %v", err)
+	}
+
+	for _, date := range []string{"2024-01-10", "2024-01-12"} {
+		if err := v.WriteEntry(date, []byte("same\n")); err != nil {
+			t.Fatalf("Failed to write entry: %v", err)
+		}
+	}
+	dedupeShow = true
+	if err := runDedupeCommand(nil, []string{}); err != nil {
+		t.Errorf("runDedupeCommand() failed: %v", err)
+	}
+
+	// Only reports: both entries are still there
+	for _, date := range []string{"2024-01-10", "2024-01-12"} {
+		if !v.EntryExists(date) {
+			t.Errorf("Expected entry %s to be kept", date)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/prompt"
+)
+
+// deleteCmd represents the delete command
+var deleteCmd = &cobra.Command{
+	Use:   "delete <YYYY-MM-DD>",
+	Short: "Delete a journal entry",
+	Long: `Deletes the entry for a date. With use_trash enabled (the default) the
+file is moved into .trash/ in the journal directory, where
+'logmd trash restore <date>' can bring it back until the trash is emptied.
+With use_trash = false the file is removed for good, after confirming
+unless --yes is given. Add --dry-run to print the move or removal instead.
+
+Examples:
+  logmd delete 2024-01-15
+  logmd delete 2024-01-15 --yes
+  logmd delete 2024-01-15 --dry-run
+  logmd trash restore 2024-01-15`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDeleteCommand,
+}
+
+// runDeleteCommand implements the core logic for the delete command.
+func runDeleteCommand(cmd *cobra.Command, args []string) error {
+	date := args[0]
+
+	// Step 1: Validate date format
+	if !isValidDateFormat(date) {
+		return invalidDateError(date)
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Open the vault; the passphrase isn't needed to move files
+	v, err := newVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Print the plan instead when dry running
+	if dryRun {
+		plan, err := v.PlanDelete(date)
+		if err != nil {
+			return err
+		}
+		printDryRun(plan)
+		return nil
+	}
+
+	// Step 5: Confirm first when there will be no trash to restore from
+	if v.DeletePermanently && v.EntryExists(date) {
+		ok, err := prompt.Confirm(os.Stdin, os.Stderr, fmt.Sprintf("Permanently delete %s?", date))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled; nothing was deleted.")
+			return nil
+		}
+	}
+
+	// Step 6: Delete the entry
+	if err := v.DeleteEntry(date); err != nil {
+		return err
+	}
+
+	if v.DeletePermanently {
+		fmt.Printf("🗑️  Deleted %s permanently\n", date)
+	} else {
+		fmt.Printf("🗑️  Moved %s to the trash (undo with 'logmd trash restore %s')\n", date, date)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(deleteCmd)
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"logmd/prompt"
+	"logmd/vault"
+)
+
+// TestRunDeleteCommand tests deleting into the trash and for good, and that
+// --dry-run leaves the entry and the trash alone.
+func TestRunDeleteCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-delete-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	originalTrash, hadTrash := os.LookupEnv("LOGMD_USE_TRASH")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		if hadTrash {
+			os.Setenv("LOGMD_USE_TRASH", originalTrash)
+		} else {
+			os.Unsetenv("LOGMD_USE_TRASH")
+		}
+		dryRun = false
+		prompt.AssumeYes = false
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+	os.Unsetenv("LOGMD_USE_TRASH")
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	for _, date := range []string{"2024-01-15", "2024-01-16"} {
+		if err := v.WriteEntry(date, []byte("# "+date+"\n")); err != nil {
+			t.Fatalf("Failed to write entry: %v", err)
+		}
+	}
+
+	if err := runDeleteCommand(nil, []string{"01/15/2024"}); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+	if err := runDeleteCommand(nil, []string{"2024-02-01"}); !errors.Is(err, vault.ErrEntryNotFound) {
+		t.Errorf("Expected ErrEntryNotFound for a missing entry, got %v", err)
+	}
+
+	// A dry run neither moves the entry nor creates the trash
+	dryRun = true
+	prompt.AssumeYes = true
+	if err := runDeleteCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Fatalf("runDeleteCommand() --dry-run failed: %v", err)
+	}
+	if !v.EntryExists("2024-01-15") {
+		t.Error("Expected --dry-run not to delete the entry")
+	}
+	if _, err := os.Stat(v.TrashDir()); !os.IsNotExist(err) {
+		t.Errorf("Expected --dry-run not to create the trash, got %v", err)
+	}
+	dryRun = false
+
+	if err := runDeleteCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Fatalf("runDeleteCommand() failed: %v", err)
+	}
+	if items, err := v.Trash(); err != nil || len(items) != 1 || v.EntryExists("2024-01-15") {
+		t.Errorf("Expected the entry in the trash, got %v, %v", items, err)
+	}
+
+	// Without the trash a dry run still keeps the file
+	os.Setenv("LOGMD_USE_TRASH", "false")
+	dryRun = true
+	if err := runDeleteCommand(nil, []string{"2024-01-16"}); err != nil {
+		t.Fatalf("runDeleteCommand() --dry-run without trash failed: %v", err)
+	}
+	if !v.EntryExists("2024-01-16") {
+		t.Error("Expected --dry-run not to remove the entry")
+	}
+	dryRun = false
+
+	if err := runDeleteCommand(nil, []string{"2024-01-16"}); err != nil {
+		t.Fatalf("runDeleteCommand() without trash failed: %v", err)
+	}
+	if items, err := v.Trash(); err != nil || len(items) != 1 || v.EntryExists("2024-01-16") {
+		t.Errorf("Expected the entry removed for good, got %v, %v", items, err)
+	}
+
+	// Emptying the trash honours --dry-run too
+	dryRun = true
+	if err := runTrashEmptyCommand(nil, []string{}); err != nil {
+		t.Fatalf("runTrashEmptyCommand() --dry-run failed: %v", err)
+	}
+	if items, err := v.Trash(); err != nil || len(items) != 1 {
+		t.Errorf("Expected --dry-run to keep the trash, got %v, %v", items, err)
+	}
+}
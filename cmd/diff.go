@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"logmd/config"
+)
+
+// diffContext is how many unchanged lines surround each change, as in diff -u.
+const diffContext = 3
+
+// Styles for diff output
+var (
+	diffAddStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#10B981"))
+
+	diffRemoveStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#EF4444"))
+
+	diffHunkStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#7C3AED"))
+)
+
+// diffCmd represents the diff command
+// Learn: A unified diff shows changed lines with a few lines of context around them.
+// See: https://www.gnu.org/software/diffutils/manual/html_node/Unified-Format.html
+var diffCmd = &cobra.Command{
+	Use:   "diff <date1> <date2>",
+	Short: "Show a unified diff between two entries",
+	Long: `Prints the line-by-line differences between the entries for two dates
+as a unified diff: lines only in the first entry start with "-", lines only
+in the second with "+". Useful for seeing how a recurring template or a
+train of thought changed from one day to another.
+
+Colors follow --no-color and NO_COLOR.
+
+Examples:
+  logmd diff 2024-01-15 2024-01-22`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDiffCommand,
+}
+
+// runDiffCommand implements the core logic for the diff command.
+func runDiffCommand(cmd *cobra.Command, args []string) error {
+	from, to := args[0], args[1]
+
+	// Step 1: Validate both dates
+	for _, date := range []string{from, to} {
+		if !isValidDateFormat(date) {
+			return invalidDateError(date)
+		}
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Open the vault
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Read both entries
+	var contents [2][]string
+	for i, date := range []string{from, to} {
+		content, err := v.ReadEntry(date)
+		if err != nil {
+			return fmt.Errorf("failed to read entry: %w", err)
+		}
+		contents[i] = splitLines(string(content))
+	}
+
+	// Step 5: Print the differences
+	if !writeUnifiedDiff(os.Stdout, from, to, diffLines(contents[0], contents[1])) {
+		fmt.Printf("✅ Entries %s and %s are identical\n", from, to)
+	}
+	return nil
+}
+
+// splitLines splits content into lines without a trailing empty line for
+// the final newline.
+func splitLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+}
+
+// diffOp is one line of a diff: kept (' '), removed ('-') or added ('+').
+type diffOp struct {
+	kind byte
+	text string
+}
+
+// diffLines returns the edit script turning a into b, keeping the longest
+// common subsequence of lines and removing or adding the rest.
+// Learn: The LCS table is filled from the end so the script is read forwards.
+// See: https://en.wikipedia.org/wiki/Longest_common_subsequence
+func diffLines(a, b []string) []diffOp {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
+}
+
+// writeUnifiedDiff writes ops to w as a unified diff between the entries
+// named from and to, grouping changes into hunks with diffContext lines of
+// context. It reports whether there was any change to write.
+func writeUnifiedDiff(w io.Writer, from, to string, ops []diffOp) bool {
+	// Line numbers in each entry before every op
+	aLine := make([]int, len(ops)+1)
+	bLine := make([]int, len(ops)+1)
+	var changes []int
+	for k, op := range ops {
+		aLine[k+1], bLine[k+1] = aLine[k], bLine[k]
+		if op.kind != '+' {
+			aLine[k+1]++
+		}
+		if op.kind != '-' {
+			bLine[k+1]++
+		}
+		if op.kind != ' ' {
+			changes = append(changes, k)
+		}
+	}
+	if len(changes) == 0 {
+		return false
+	}
+
+	fmt.Fprintln(w, diffRemoveStyle.Render("--- "+from))
+	fmt.Fprintln(w, diffAddStyle.Render("+++ "+to))
+
+	for i := 0; i < len(changes); {
+		// Extend the hunk while the next change is close enough to share context
+		j := i
+		for j+1 < len(changes) && changes[j+1]-changes[j] <= 2*diffContext+1 {
+			j++
+		}
+		start := max(0, changes[i]-diffContext)
+		end := min(len(ops), changes[j]+1+diffContext)
+
+		fmt.Fprintln(w, diffHunkStyle.Render(fmt.Sprintf("@@ -%s +%s @@",
+			hunkRange(aLine[start], aLine[end]), hunkRange(bLine[start], bLine[end]))))
+		for _, op := range ops[start:end] {
+			line := string(op.kind) + op.text
+			switch op.kind {
+			case '-':
+				line = diffRemoveStyle.Render(line)
+			case '+':
+				line = diffAddStyle.Render(line)
+			}
+			fmt.Fprintln(w, line)
+		}
+
+		i = j + 1
+	}
+	return true
+}
+
+// hunkRange formats the lines after before up to and including through as
+// a hunk range such as "4,7". An empty range names the line before it.
+func hunkRange(before, through int) string {
+	count := through - before
+	if count == 0 {
+		return fmt.Sprintf("%d,0", before)
+	}
+	return fmt.Sprintf("%d,%d", before+1, count)
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+}
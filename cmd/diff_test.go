@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"logmd/vault"
+)
+
+// TestWriteUnifiedDiff tests hunk grouping and line numbers.
+func TestWriteUnifiedDiff(t *testing.T) {
+	originalProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.Ascii)
+	defer lipgloss.SetColorProfile(originalProfile)
+
+	testCases := []struct {
+		name     string
+		a        string
+		b        string
+		expected string
+	}{
+		{
+			name:     "Identical",
+			a:        "# 2024-01-15\n\nSame\n",
+			b:        "# 2024-01-15\n\nSame\n",
+			expected: "",
+		},
+		{
+			name:     "ChangedLine",
+			a:        "# Day\n\nmood: ok\nwork\n",
+			b:        "# Day\n\nmood: great\nwork\n",
+			expected: "--- a\n+++ b\n@@ -1,4 +1,4 @@\n # Day\n \n-mood: ok\n+mood: great\n work\n",
+		},
+		{
+			name: "SeparateHunks",
+			a:    "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			b:    "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n",
+			expected: `--- a
++++ b
+@@ -1,4 +1,4 @@
+-1
++one
+ 2
+ 3
+ 4
+@@ -10,3 +10,4 @@
+ 10
+ 11
+ 12
++13
+`,
+		},
+		{
+			name: "FromEmpty",
+			a:    "",
+			b:    "new\n",
+			expected: `--- a
++++ b
+@@ -0,0 +1,1 @@
++new
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			changed := writeUnifiedDiff(&out, "a", "b", diffLines(splitLines(tc.a), splitLines(tc.b)))
+			if changed != (tc.expected != "") {
+				t.Errorf("Expected changed=%t, got %t", tc.expected != "", changed)
+			}
+			if out.String() != tc.expected {
+				t.Errorf("Expected:\n%s\ngot:\n%s", tc.expected, out.String())
+			}
+		})
+	}
+}
+
+// TestRunDiffCommand tests diffing entries and reporting missing ones.
+func TestRunDiffCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-diff-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	for date, content := range map[string]string{
+		"2024-01-15": "# Standup\n\n- shipped login\n",
+		"2024-01-16": "# Standup\n\n- fixed login\n",
+	} {
+		if err := v.WriteEntry(date, []byte(content)); err != nil {
+			t.Fatalf("Failed to write entry %s: %v", date, err)
+		}
+	}
+
+	if err := runDiffCommand(nil, []string{"2024-01-15", "2024-01-16"}); err != nil {
+		t.Errorf("runDiffCommand() failed: %v", err)
+	}
+	if err := runDiffCommand(nil, []string{"2024-01-15", "2024-01-15"}); err != nil {
+		t.Errorf("runDiffCommand() on the same entry failed: %v", err)
+	}
+
+	err = runDiffCommand(nil, []string{"2024-01-15", "2024-01-17"})
+	if !errors.Is(err, vault.ErrEntryNotFound) {
+		t.Errorf("Expected ErrEntryNotFound for a missing entry, got %v", err)
+	}
+	if err := runDiffCommand(nil, []string{"2024-01-15", "yesterday"}); err == nil || !strings.Contains(err.Error(), "invalid date") {
+		t.Errorf("Expected an invalid date error, got %v", err)
+	}
+}
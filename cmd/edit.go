@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+)
+
+// editCmd represents the edit command
+// Learn: Commands that share behavior can reuse helpers from sibling files in the package.
+// See: https://go.dev/doc/effective_go#package-names
+var editCmd = &cobra.Command{
+	Use:   "edit <YYYY-MM-DD>",
+	Short: "Open the journal entry for a specific date for editing",
+	Long: `Opens the journal entry for the given date in your preferred editor.
+If the entry doesn't exist, it will be created with the same simple template
+used by 'logmd today'. Past and future dates are both allowed, so you can
+fill in a missed day or plan ahead.
+
+Examples:
+  logmd edit 2024-01-15
+  logmd edit 2030-01-01`,
+	Args: cobra.ExactArgs(1),
+	RunE: runEditCommand,
+}
+
+// runEditCommand implements the core logic for the edit command.
+func runEditCommand(cmd *cobra.Command, args []string) error {
+	dateStr := args[0]
+
+	// Step 1: Validate date format
+	if !isValidDateFormat(dateStr) {
+		return invalidDateError(dateStr)
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Create vault instance
+	v, err := createVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Create the entry if it doesn't exist
+	if !v.EntryExists(dateStr) {
+		if err := v.CreateEntry(dateStr); err != nil {
+			return fmt.Errorf("failed to create entry %s: %w", dateStr, err)
+		}
+		fmt.Printf("Created new journal entry: %s\n", dateStr)
+	} else {
+		fmt.Printf("Opening existing journal entry: %s\n", dateStr)
+	}
+
+	// Step 5: Launch editor, then commit if git_auto_commit is enabled
+	entryPath := v.DatePath(dateStr)
+	editor, editorArgs := editorFor(cfg, "")
+	if err := editEntry(editor, editorArgs, v, dateStr); err != nil {
+		return fmt.Errorf("failed to launch editor: %w", err)
+	}
+	commitEntry(cfg, v, dateStr)
+
+	fmt.Printf("Journal entry saved: %s\n", entryPath)
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(editCmd)
+}
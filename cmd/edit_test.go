@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestRunEditCommand tests editing past and future dates.
+func TestRunEditCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-edit-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalEditor := os.Getenv("LOGMD_EDITOR")
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalEditor != "" {
+			os.Setenv("LOGMD_EDITOR", originalEditor)
+		} else {
+			os.Unsetenv("LOGMD_EDITOR")
+		}
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_EDITOR", "true")
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+
+	for _, date := range []string{"2020-02-29", "2099-12-31"} {
+		t.Run(date, func(t *testing.T) {
+			if err := runEditCommand(nil, []string{date}); err != nil {
+				t.Fatalf("runEditCommand() failed: %v", err)
+			}
+
+			content, err := v.ReadEntry(date)
+			if err != nil {
+				t.Fatalf("Failed to read created entry: %v", err)
+			}
+
+			expectedContent := "# " + date + "\n\n"
+			if string(content) != expectedContent {
+				t.Errorf("Expected content %q, got %q", expectedContent, string(content))
+			}
+		})
+	}
+
+	// Existing entries are opened unchanged
+	existing := "# Existing\n\nKeep me."
+	if err := v.WriteEntry("2024-01-15", []byte(existing)); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+	if err := runEditCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Fatalf("runEditCommand() on existing entry failed: %v", err)
+	}
+	content, _ := v.ReadEntry("2024-01-15")
+	if string(content) != existing {
+		t.Errorf("Existing entry should be unchanged, got %q", string(content))
+	}
+}
+
+// TestRunEditCommandWithInvalidDate tests date validation.
+func TestRunEditCommandWithInvalidDate(t *testing.T) {
+	err := runEditCommand(nil, []string{"2024-13-01"})
+	if err == nil {
+		t.Fatal("Expected error for invalid date, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "invalid date format") {
+		t.Errorf("Expected 'invalid date format' in error, got: %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+)
+
+// encryptCmd represents the encrypt command
+var encryptCmd = &cobra.Command{
+	Use:   "encrypt",
+	Short: "Encrypt existing plain-text entries with your passphrase",
+	Long: `Encrypts every journal entry that is still stored as plain text, using
+AES-GCM with a key derived from your passphrase. Entries that are already
+encrypted are left alone, but they must open with the same passphrase.
+
+The passphrase is read from LOGMD_PASSPHRASE, or typed at a prompt: twice
+while no entry is encrypted yet, and otherwise checked against them.
+It is never stored: if you lose it, your encrypted entries cannot be read.
+
+Encryption must be enabled first so new entries are encrypted too:
+  logmd config set encrypt true
+  logmd encrypt`,
+	Args: cobra.NoArgs,
+	RunE: runEncryptCommand,
+}
+
+// runEncryptCommand implements the core logic for the encrypt command.
+func runEncryptCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Load configuration and require encrypt to be enabled
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	if !cfg.Encrypt {
+		return fmt.Errorf("encryption is not enabled (run 'logmd config set encrypt true' first)")
+	}
+
+	// Step 2: Get the passphrase, confirming it when nothing is encrypted yet
+	v, err := newVault(cfg)
+	if err != nil {
+		return err
+	}
+	if err := cfg.UnlockVault(v); err != nil {
+		return err
+	}
+
+	// Step 3: Encrypt the plain entries
+	encrypted, err := v.EncryptEntries()
+	if err != nil {
+		return fmt.Errorf("failed to encrypt entries (%d encrypted): %w", encrypted, err)
+	}
+
+	fmt.Printf("🔒 Encrypted %d entries\n", encrypted)
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(encryptCmd)
+}
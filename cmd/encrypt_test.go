@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestRunEncryptCommand tests encrypting a plain vault and reading it back.
+func TestRunEncryptCommand(t *testing.T) {
+	originalVars := saveEnvironment()
+	defer restoreEnvironment(originalVars)
+	clearLogmdEnvironment()
+
+	tmpDir, err := os.MkdirTemp("", "logmd-encrypt-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	os.Setenv("HOME", tmpDir)
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+	os.Setenv("LOGMD_PASSPHRASE", "correct horse")
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-15", []byte("# Secret\n\nThe password is swordfish.\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	// Encryption has to be enabled first
+	if err := runEncryptCommand(nil, []string{}); err == nil {
+		t.Error("Expected error when encrypt is not enabled")
+	}
+
+	os.Setenv("LOGMD_ENCRYPT", "true")
+	if err := runEncryptCommand(nil, []string{}); err != nil {
+		t.Fatalf("runEncryptCommand() failed: %v", err)
+	}
+
+	raw, err := os.ReadFile(v.DatePath("2024-01-15"))
+	if err != nil {
+		t.Fatalf("Failed to read entry file: %v", err)
+	}
+	if !vault.IsEncrypted(raw) || strings.Contains(string(raw), "swordfish") {
+		t.Error("Expected the entry to be encrypted on disk")
+	}
+
+	// Content commands decrypt with the passphrase
+	if err := runViewCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Errorf("runViewCommand() failed: %v", err)
+	}
+	if err := runNoteCommand(nil, []string{"another secret"}); err != nil {
+		t.Errorf("runNoteCommand() failed: %v", err)
+	}
+
+	// A wrong passphrase is refused
+	os.Setenv("LOGMD_PASSPHRASE", "wrong")
+	if err := runViewCommand(nil, []string{"2024-01-15"}); err == nil {
+		t.Error("Expected runViewCommand() to fail with the wrong passphrase")
+	}
+	if err := runEncryptCommand(nil, []string{}); err == nil {
+		t.Error("Expected runEncryptCommand() to refuse a different passphrase")
+	}
+
+	// Nothing is written with a mistyped passphrase
+	if err := runNoteCommand(nil, []string{"written with a typo"}); err == nil {
+		t.Error("Expected runNoteCommand() to refuse the wrong passphrase")
+	}
+	os.Setenv("LOGMD_PASSPHRASE", "correct horse")
+	v.Passphrase = "correct horse"
+	filenames, err := v.ListEntries()
+	if err != nil {
+		t.Fatalf("ListEntries() failed: %v", err)
+	}
+	for _, filename := range filenames {
+		if _, err := v.ReadEntry(strings.TrimSuffix(filename, ".md")); err != nil {
+			t.Errorf("Expected %s to open with the real passphrase: %v", filename, err)
+		}
+	}
+}
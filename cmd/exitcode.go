@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/vault"
+)
+
+// Exit codes, so scripts can tell kinds of failure apart. They are listed
+// in the root command's help; keep the two in step.
+const (
+	// exitOK means the command succeeded
+	exitOK = 0
+	// exitError is any failure without a more specific code
+	exitError = 1
+	// exitUsage means the command line was wrong: an unknown command or
+	// flag, the wrong number of arguments, or a date that isn't YYYY-MM-DD
+	exitUsage = 2
+	// exitNotFound means a requested entry doesn't exist
+	exitNotFound = 3
+	// exitConfig means the configuration couldn't be loaded or names a
+	// journal directory that doesn't exist
+	exitConfig = 4
+)
+
+// usageError marks an error as a mistake on the command line.
+type usageError struct {
+	err error
+}
+
+func (e *usageError) Error() string {
+	return e.err.Error()
+}
+
+func (e *usageError) Unwrap() error {
+	return e.err
+}
+
+// markUsage makes sure argument and flag errors from every command are
+// usageErrors, since cobra reports them as plain errors.
+var markUsage sync.Once
+
+// markUsageErrors wraps the Args validator of cmd and each of its
+// subcommands so their errors are usageErrors, and does the same for flag
+// parsing errors, which subcommands inherit from cmd. A cmd without an Args
+// validator gets unknownCommand, so a mistyped subcommand is caught there
+// too rather than by cobra's own check.
+// Learn: Cobra looks up FlagErrorFunc on the parent when a command has none.
+// See: https://pkg.go.dev/github.com/spf13/cobra#Command.SetFlagErrorFunc
+func markUsageErrors(cmd *cobra.Command) {
+	cmd.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		return &usageError{err: err}
+	})
+	if cmd.Args == nil {
+		cmd.Args = unknownCommand
+	}
+
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		if validate := c.Args; validate != nil {
+			c.Args = func(c *cobra.Command, args []string) error {
+				if err := validate(c, args); err != nil {
+					return &usageError{err: err}
+				}
+				return nil
+			}
+		}
+		for _, sub := range c.Commands() {
+			walk(sub)
+		}
+	}
+	walk(cmd)
+}
+
+// unknownCommand rejects any argument to a command that only takes
+// subcommands, suggesting close command names as cobra does.
+// See: https://pkg.go.dev/github.com/spf13/cobra#NoArgs
+func unknownCommand(c *cobra.Command, args []string) error {
+	if err := cobra.NoArgs(c, args); err != nil {
+		if c.DisableSuggestions {
+			return err
+		}
+		// Cobra applies this default itself only in its own check
+		if c.SuggestionsMinimumDistance <= 0 {
+			c.SuggestionsMinimumDistance = 2
+		}
+		suggestions := c.SuggestionsFor(args[0])
+		if len(suggestions) == 0 {
+			return err
+		}
+		return fmt.Errorf("%w\n\nDid you mean this?\n\t%s", err, strings.Join(suggestions, "\n\t"))
+	}
+	return nil
+}
+
+// exitCode returns the exit code for the error a command returned.
+func exitCode(err error) int {
+	var usage *usageError
+	switch {
+	case err == nil:
+		return exitOK
+	// Packages outside cmd report mistyped dates with the sentinel
+	case errors.As(err, &usage), errors.Is(err, vault.ErrInvalidDate):
+		return exitUsage
+	case errors.Is(err, vault.ErrEntryNotFound):
+		return exitNotFound
+	case errors.Is(err, config.ErrInvalidConfig), errors.Is(err, vault.ErrNoDirectory):
+		return exitConfig
+	default:
+		return exitError
+	}
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"logmd/config"
+	"logmd/vault"
+)
+
+// TestExitCode tests mapping errors to exit codes.
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"Success", nil, exitOK},
+		{"Other", errors.New("disk full"), exitError},
+		{"Usage", &usageError{err: errors.New("accepts 1 arg(s), received 0")}, exitUsage},
+		{"InvalidDate", fmt.Errorf("%w: 2024-13-01 (expected YYYY-MM-DD)", vault.ErrInvalidDate), exitUsage},
+		{"NotFound", fmt.Errorf("journal entry for 2024-01-15: %w", vault.ErrEntryNotFound), exitNotFound},
+		{"Config", fmt.Errorf("failed to load configuration: %w", config.ErrInvalidConfig), exitConfig},
+		{"NoDirectory", fmt.Errorf("%w (check the directory setting)", vault.ErrNoDirectory), exitConfig},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := exitCode(tc.err); got != tc.expected {
+				t.Errorf("exitCode(%v) = %d, expected %d", tc.err, got, tc.expected)
+			}
+		})
+	}
+}
+
+// TestExecuteExitCodes tests the exit codes of whole command lines.
+func TestExecuteExitCodes(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-exitcode-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-15", []byte("# 2024-01-15\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(&out)
+	defer func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		rootCmd.SetArgs(nil)
+	}()
+
+	tests := []struct {
+		args     []string
+		expected int
+	}{
+		{[]string{"view", "--raw", "2024-01-15"}, exitOK},
+		{[]string{"view", "2024-01-16"}, exitNotFound},
+		{[]string{"bogus"}, exitUsage},
+		{[]string{"view", "bad"}, exitUsage},
+		{[]string{"view", "--strict", "01/15/2024"}, exitUsage},
+		{[]string{"delete", "2024-13-01"}, exitUsage},
+		{[]string{"assist", "not-a-date"}, exitUsage},
+		{[]string{"assist", "summary", "not-a-date"}, exitUsage},
+		{[]string{"delete"}, exitUsage},
+		{[]string{"list", "--no-such-flag"}, exitUsage},
+	}
+
+	for _, tc := range tests {
+		rootCmd.SetArgs(tc.args)
+		if got := execute(); got != tc.expected {
+			t.Errorf("logmd %v exited with %d, expected %d\n%s", tc.args, got, tc.expected, out.String())
+		}
+		out.Reset()
+	}
+	viewRaw = false
+	viewStrict = false
+
+	// A mistyped command still gets suggestions
+	rootCmd.SetArgs([]string{"lst"})
+	if got := execute(); got != exitUsage || !strings.Contains(out.String(), "Did you mean this?\n\tcat\n\tlint\n\tlist") {
+		t.Errorf("Expected exit code %d with suggestions, got %d\n%s", exitUsage, got, out.String())
+	}
+	out.Reset()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir+"/missing")
+	rootCmd.SetArgs([]string{"list"})
+	if got := execute(); got != exitConfig {
+		t.Errorf("Expected exit code %d for a missing journal directory, got %d", exitConfig, got)
+	}
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+)
+
+// Flag values for the export command
+var (
+	// exportFormat selects the bundle format: md, html, book or json
+	exportFormat string
+	// exportOut is the output file (empty writes to stdout)
+	exportOut string
+	// exportFrom and exportTo bound the exported dates (inclusive, empty means open)
+	exportFrom string
+	exportTo   string
+	// exportTitle names the document in the html and book formats
+	exportTitle string
+)
+
+// exportEntry is a single entry in a JSON export.
+type exportEntry struct {
+	Date    string `json:"date"`
+	Content string `json:"content"`
+}
+
+// exportCmd represents the export command
+// Learn: Writing to an io.Writer-agnostic buffer lets one command target files or stdout.
+// See: https://pkg.go.dev/os#WriteFile
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Bundle journal entries into a single file",
+	Long: `Exports journal entries, oldest first, into one file for backup or sharing.
+
+Formats:
+  md    entries concatenated with a dated separator between them
+  html  each entry rendered to HTML inside a minimal standalone document
+  book  a styled HTML book with a title page and a table of contents
+        linking to each date, one entry per page when printed to PDF
+  json  an array of {"date", "content"} objects with the raw markdown
+
+Use --from and --to to limit the export to a date range and --title to name
+the html or book document. Without --out the bundle is written to stdout.
+
+Examples:
+  logmd export --format md --out journal.md
+  logmd export --format html --out journal.html --from 2024-01-01
+  logmd export --format book --out journal.html --title "2024"
+  logmd export --format json --from 2024-01-01 --to 2024-01-31 | jq length`,
+	Args: cobra.NoArgs,
+	RunE: runExportCommand,
+}
+
+// runExportCommand implements the core logic for the export command.
+func runExportCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Validate the format before touching the vault
+	switch exportFormat {
+	case "md", "html", "book", "json":
+	default:
+		return fmt.Errorf("invalid --format value: %s (expected md, html, book or json)", exportFormat)
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Create vault instance
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Read the selected entries, oldest first
+	filenames, err := v.ListEntriesInRange(exportFrom, exportTo)
+	if err != nil {
+		return fmt.Errorf("failed to list entries: %w", err)
+	}
+	slices.Reverse(filenames)
+
+	entries := make([]exportEntry, 0, len(filenames))
+	for _, filename := range filenames {
+		date := strings.TrimSuffix(filename, ".md")
+		content, err := v.ReadEntry(date)
+		if err != nil {
+			return fmt.Errorf("failed to read entry %s: %w", date, err)
+		}
+		entries = append(entries, exportEntry{Date: date, Content: string(content)})
+	}
+
+	// Step 5: Build the bundle
+	var bundle []byte
+	switch exportFormat {
+	case "md":
+		bundle = []byte(exportMarkdown(entries))
+	case "html", "book":
+		renderer, err := markdown.NewRenderer(markdown.Options{Style: cfg.Style, WikiLinks: cfg.WikiLinks})
+		if err != nil {
+			return fmt.Errorf("failed to create markdown renderer: %w", err)
+		}
+		var document string
+		if exportFormat == "book" {
+			document, err = exportBook(renderer, entries, exportTitle)
+		} else {
+			document, err = exportHTML(renderer, entries, exportTitle)
+		}
+		if err != nil {
+			return err
+		}
+		bundle = []byte(document)
+	case "json":
+		if bundle, err = json.MarshalIndent(entries, "", "  "); err != nil {
+			return fmt.Errorf("failed to encode entries: %w", err)
+		}
+		bundle = append(bundle, '\n')
+	}
+
+	// Step 6: Write it out
+	if exportOut == "" {
+		_, err := os.Stdout.Write(bundle)
+		return err
+	}
+	if err := os.WriteFile(exportOut, bundle, 0644); err != nil {
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+
+	fmt.Printf("✅ Exported %d entries to %s\n", len(entries), exportOut)
+	return nil
+}
+
+// exportMarkdown concatenates entries, each preceded by a separator naming its date.
+func exportMarkdown(entries []exportEntry) string {
+	var b strings.Builder
+	for i, entry := range entries {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "<!-- %s -->\n\n", entry.Date)
+		b.WriteString(strings.TrimRight(entry.Content, "\n"))
+		b.WriteString("\n\n---\n")
+	}
+	return b.String()
+}
+
+// exportHTML renders each entry to HTML and wraps them in a minimal document,
+// one <article> per entry. An empty title keeps "logmd journal".
+func exportHTML(renderer *markdown.Renderer, entries []exportEntry, title string) (string, error) {
+	if title == "" {
+		title = "logmd journal"
+	}
+
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(&b, "<title>%s</title>\n</head>\n<body>\n", html.EscapeString(title))
+
+	for _, entry := range entries {
+		rendered, err := renderer.RenderHTML([]byte(entry.Content))
+		if err != nil {
+			return "", fmt.Errorf("failed to render entry %s: %w", entry.Date, err)
+		}
+		fmt.Fprintf(&b, "<article id=\"%s\">\n", html.EscapeString(entry.Date))
+		b.WriteString(rendered)
+		b.WriteString("</article>\n")
+	}
+
+	b.WriteString("</body>\n</html>\n")
+	return b.String(), nil
+}
+
+func init() {
+	exportCmd.Flags().StringVar(&exportFormat, "format", "md", "bundle format: md, html, book or json")
+	exportCmd.Flags().StringVar(&exportOut, "out", "", "write the bundle to this file instead of stdout")
+	exportCmd.Flags().StringVar(&exportFrom, "from", "", "only export entries on or after this date (YYYY-MM-DD)")
+	exportCmd.Flags().StringVar(&exportTo, "to", "", "only export entries on or before this date (YYYY-MM-DD)")
+	exportCmd.Flags().StringVar(&exportTitle, "title", "", "document title for the html and book formats")
+	rootCmd.AddCommand(exportCmd)
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestRunExportCommand tests each export format and the date range flags.
+func TestRunExportCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-export-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	for date, content := range map[string]string{
+		"2024-01-01": "# New Year\n\n- [x] **resolutions**\n",
+		"2024-01-02": "# Second\n\nBack to work.\n",
+		"2024-02-01": "# February\n",
+	} {
+		if err := v.WriteEntry(date, []byte(content)); err != nil {
+			t.Fatalf("Failed to write entry: %v", err)
+		}
+	}
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		exportFormat, exportOut, exportFrom, exportTo, exportTitle = "md", "", "", "", ""
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	export := func(format string) string {
+		t.Helper()
+		exportFormat = format
+		exportOut = filepath.Join(tmpDir, "export."+format)
+		if err := runExportCommand(nil, []string{}); err != nil {
+			t.Fatalf("runExportCommand() with --format %s failed: %v", format, err)
+		}
+		content, err := os.ReadFile(exportOut)
+		if err != nil {
+			t.Fatalf("Failed to read export file: %v", err)
+		}
+		return string(content)
+	}
+
+	exportTo = "2024-01-31"
+
+	// Markdown keeps entries oldest first with dated separators
+	md := export("md")
+	first, second := strings.Index(md, "<!-- 2024-01-01 -->"), strings.Index(md, "<!-- 2024-01-02 -->")
+	if first < 0 || second < first {
+		t.Errorf("Expected dated separators oldest first, got:\n%s", md)
+	}
+	if strings.Contains(md, "February") {
+		t.Error("Expected --to to exclude later entries")
+	}
+
+	// HTML renders markdown inside a standalone document
+	doc := export("html")
+	for _, want := range []string{"<!DOCTYPE html>", `<article id="2024-01-01">`, "<strong>resolutions</strong>", `type="checkbox"`} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("Expected HTML export to contain %q", want)
+		}
+	}
+
+	// The book has a linked table of contents, oldest entry first
+	exportTitle = "January"
+	book := export("book")
+	for _, want := range []string{"<title>January</title>", `href="#2024-01-01"`, `<article class="entry" id="2024-01-02">`} {
+		if !strings.Contains(book, want) {
+			t.Errorf("Expected book export to contain %q", want)
+		}
+	}
+	if strings.Index(book, `id="2024-01-01"`) > strings.Index(book, `id="2024-01-02"`) || strings.Contains(book, "February") {
+		t.Error("Expected the book to hold January's entries oldest first")
+	}
+
+	// JSON is an array of date/content objects
+	var entries []exportEntry
+	if err := json.Unmarshal([]byte(export("json")), &entries); err != nil {
+		t.Fatalf("Failed to parse JSON export: %v", err)
+	}
+	if len(entries) != 2 || entries[0].Date != "2024-01-01" || !strings.HasPrefix(entries[1].Content, "# Second") {
+		t.Errorf("Unexpected JSON export: %+v", entries)
+	}
+
+	exportFormat = "pdf"
+	if err := runExportCommand(nil, []string{}); err == nil {
+		t.Error("Expected error for unknown format")
+	}
+}
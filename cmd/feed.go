@@ -0,0 +1,230 @@
+package cmd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// Flag values for the feed command
+var (
+	// feedOut is the output file (empty writes to stdout)
+	feedOut string
+	// feedBaseURL is the site the journal is published at
+	feedBaseURL string
+	// feedLimit caps how many entries the feed holds
+	feedLimit int
+	// feedTitle names the feed
+	feedTitle string
+)
+
+// atomFeed is an Atom 1.0 feed document.
+// Learn: encoding/xml escapes text and attributes, so rendered HTML is safe to embed.
+// See: https://www.rfc-editor.org/rfc/rfc4287
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+// atomLink is an Atom <link>.
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+// atomAuthor is an Atom <author>, which a feed must have.
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// atomEntry is one journal entry in the feed.
+type atomEntry struct {
+	Title     string      `xml:"title"`
+	ID        string      `xml:"id"`
+	Link      atomLink    `xml:"link"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Content   atomContent `xml:"content"`
+}
+
+// atomContent is an entry's body as escaped HTML.
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// feedCmd represents the feed command
+var feedCmd = &cobra.Command{
+	Use:   "feed",
+	Short: "Publish recent entries as an Atom feed",
+	Long: `Writes an Atom feed of the most recent entries, newest first, for
+publishing a journal on your own site. Each entry is titled by its first
+heading, carries its content rendered to HTML and is dated by its day;
+its updated time is when the file was last saved.
+
+--base-url is where the journal is published. Each entry links to
+<base-url>/<YYYY-MM-DD>, which also serves as its unique id.
+
+Examples:
+  logmd feed --base-url https://example.com/journal --out feed.xml
+  logmd feed --base-url https://example.com --limit 5 --title "Field notes"`,
+	Args: cobra.NoArgs,
+	RunE: runFeedCommand,
+}
+
+// runFeedCommand implements the core logic for the feed command.
+func runFeedCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Validate flags before touching the vault
+	baseURL, err := parseBaseURL(feedBaseURL)
+	if err != nil {
+		return err
+	}
+	if feedLimit <= 0 {
+		return fmt.Errorf("invalid --limit value: %d (must be positive)", feedLimit)
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Open the vault
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Build the feed from the newest entries
+	renderer, err := markdown.NewRenderer(markdown.Options{Style: "notty", WikiLinks: cfg.WikiLinks})
+	if err != nil {
+		return fmt.Errorf("failed to create markdown renderer: %w", err)
+	}
+	feed, err := buildFeed(v, renderer, baseURL, feedTitle, feedLimit, time.Now())
+	if err != nil {
+		return err
+	}
+
+	// Step 5: Write it out
+	if feedOut == "" {
+		_, err := os.Stdout.Write(feed)
+		return err
+	}
+	if err := os.WriteFile(feedOut, feed, 0644); err != nil {
+		return fmt.Errorf("failed to write feed: %w", err)
+	}
+
+	fmt.Printf("✅ Wrote feed to %s\n", feedOut)
+	return nil
+}
+
+// parseBaseURL checks that raw is an absolute http or https URL and
+// returns it without a trailing slash, ready to append paths to.
+func parseBaseURL(raw string) (string, error) {
+	if raw == "" {
+		return "", fmt.Errorf("--base-url is required, e.g. --base-url https://example.com/journal")
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid --base-url value: %s (expected an http or https URL)", raw)
+	}
+	return strings.TrimRight(raw, "/"), nil
+}
+
+// buildFeed renders the newest limit entries of v into an Atom document
+// linking to baseURL. Entry days are midnight in the vault's timezone; now
+// is the feed's updated time when there are no entries.
+func buildFeed(v *vault.Vault, renderer *markdown.Renderer, baseURL, title string, limit int, now time.Time) ([]byte, error) {
+	infos, err := v.ListEntriesInfo()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entries: %w", err)
+	}
+	if len(infos) > limit {
+		infos = infos[:limit]
+	}
+
+	location := v.Location
+	if location == nil {
+		location = time.Local
+	}
+	if title == "" {
+		title = "logmd journal"
+	}
+
+	feed := atomFeed{
+		Title:   title,
+		ID:      baseURL + "/",
+		Updated: now.Format(time.RFC3339),
+		Link:    atomLink{Href: baseURL + "/"},
+		Author:  atomAuthor{Name: title},
+	}
+
+	var latest time.Time
+	for _, info := range infos {
+		content, err := v.ReadEntry(info.Date)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read entry %s: %w", info.Date, err)
+		}
+		rendered, err := renderer.RenderHTML(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render entry %s: %w", info.Date, err)
+		}
+		published, err := time.ParseInLocation("2006-01-02", info.Date, location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid entry date %s: %w", info.Date, err)
+		}
+
+		// An entry saved before its day, such as a plan, was updated when published
+		updated := info.ModTime
+		if updated.Before(published) {
+			updated = published
+		}
+		if updated.After(latest) {
+			latest = updated
+		}
+
+		entryTitle := markdown.ExtractFirstHeading(content)
+		if entryTitle == "(untitled)" {
+			entryTitle = info.Date
+		}
+		link := baseURL + "/" + info.Date
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:     entryTitle,
+			ID:        link,
+			Link:      atomLink{Href: link, Rel: "alternate"},
+			Published: published.Format(time.RFC3339),
+			Updated:   updated.Format(time.RFC3339),
+			Content:   atomContent{Type: "html", Body: rendered},
+		})
+	}
+	if !latest.IsZero() {
+		feed.Updated = latest.Format(time.RFC3339)
+	}
+
+	body, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode feed: %w", err)
+	}
+	return append([]byte(xml.Header), append(body, '\n')...), nil
+}
+
+func init() {
+	rootCmd.AddCommand(feedCmd)
+	feedCmd.Flags().StringVar(&feedOut, "out", "", "write the feed to this file instead of stdout")
+	feedCmd.Flags().StringVar(&feedBaseURL, "base-url", "", "URL the journal is published at (required)")
+	feedCmd.Flags().IntVar(&feedLimit, "limit", 20, "number of most recent entries to include")
+	feedCmd.Flags().StringVar(&feedTitle, "title", "", "feed title (default \"logmd journal\")")
+}
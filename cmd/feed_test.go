@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// TestBuildFeed tests entry order, limits, titles, dates and escaping.
+func TestBuildFeed(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-feed-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	v.Location = time.UTC
+	for date, content := range map[string]string{
+		"2024-01-13": "# Oldest\n",
+		"2024-01-14": "Just text, no heading.\n",
+		"2024-01-15": "# Tom & Jerry\n\nWatched <b>cartoons</b> & **more**.\n",
+	} {
+		if err := v.WriteEntry(date, []byte(content)); err != nil {
+			t.Fatalf("Failed to write entry: %v", err)
+		}
+	}
+	saved := time.Date(2024, 1, 15, 21, 30, 0, 0, time.UTC)
+	os.Chtimes(v.DatePath("2024-01-15"), saved, saved)
+	os.Chtimes(v.DatePath("2024-01-14"), saved, saved)
+
+	renderer, err := markdown.NewRenderer(markdown.Options{Style: "notty"})
+	if err != nil {
+		t.Fatalf("Failed to create renderer: %v", err)
+	}
+	data, err := buildFeed(v, renderer, "https://example.com/journal", "Notes <&>", 2, time.Now())
+	if err != nil {
+		t.Fatalf("buildFeed() failed: %v", err)
+	}
+
+	// The document is well-formed XML that round-trips the escaped content
+	var feed atomFeed
+	if err := xml.Unmarshal(data, &feed); err != nil {
+		t.Fatalf("Feed is not valid XML: %v\n%s", err, data)
+	}
+	if feed.Title != "Notes <&>" || feed.Updated != "2024-01-15T21:30:00Z" {
+		t.Errorf("Unexpected feed header: title %q updated %q", feed.Title, feed.Updated)
+	}
+	if len(feed.Entries) != 2 {
+		t.Fatalf("Expected 2 entries with --limit 2, got %d", len(feed.Entries))
+	}
+
+	first := feed.Entries[0]
+	if first.Title != "Tom & Jerry" || first.ID != "https://example.com/journal/2024-01-15" {
+		t.Errorf("Unexpected first entry: %+v", first)
+	}
+	if first.Published != "2024-01-15T00:00:00Z" || first.Updated != "2024-01-15T21:30:00Z" {
+		t.Errorf("Unexpected dates: published %s updated %s", first.Published, first.Updated)
+	}
+	if first.Content.Type != "html" || !strings.Contains(first.Content.Body, "<strong>more</strong>") {
+		t.Errorf("Expected rendered HTML content, got %+v", first.Content)
+	}
+	if feed.Entries[1].Title != "2024-01-14" {
+		t.Errorf("Expected an untitled entry to use its date, got %q", feed.Entries[1].Title)
+	}
+
+	// Markup in the content is escaped, not embedded as XML
+	if !strings.Contains(string(data), "&lt;strong&gt;more&lt;/strong&gt;") {
+		t.Errorf("Expected escaped HTML in the feed:\n%s", data)
+	}
+}
+
+// TestParseBaseURL tests validating and normalizing --base-url.
+func TestParseBaseURL(t *testing.T) {
+	if got, err := parseBaseURL("https://example.com/journal/"); err != nil || got != "https://example.com/journal" {
+		t.Errorf("parseBaseURL() = %q, %v", got, err)
+	}
+	for _, raw := range []string{"", "example.com", "ftp://example.com", "https://"} {
+		if _, err := parseBaseURL(raw); err == nil {
+			t.Errorf("Expected an error for %q", raw)
+		}
+	}
+}
+
+// TestRunFeedCommand tests writing the feed file.
+func TestRunFeedCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-feed-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		feedOut, feedBaseURL, feedLimit, feedTitle = "", "", 20, ""
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-15", []byte("# Monday\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	feedLimit = 0
+	feedBaseURL = "https://example.com"
+	if err := runFeedCommand(nil, nil); err == nil {
+		t.Error("Expected an error for --limit 0")
+	}
+
+	feedLimit = 20
+	feedOut = filepath.Join(tmpDir, "feed.xml")
+	if err := runFeedCommand(nil, nil); err != nil {
+		t.Fatalf("runFeedCommand() failed: %v", err)
+	}
+	data, err := os.ReadFile(feedOut)
+	if err != nil {
+		t.Fatalf("Failed to read feed: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "<?xml") || !strings.Contains(string(data), "<title>Monday</title>") {
+		t.Errorf("Unexpected feed:\n%s", data)
+	}
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+)
+
+// grepIgnoreCase holds the --ignore-case flag value
+var grepIgnoreCase bool
+
+// grepCmd represents the grep command
+// Learn: Go's regexp package uses RE2 syntax, which guarantees linear-time matching.
+// See: https://github.com/google/re2/wiki/Syntax
+var grepCmd = &cobra.Command{
+	Use:   "grep <pattern>",
+	Short: "Search journal entries with a regular expression",
+	Long: `Searches every journal entry line by line for a regular expression and
+prints each matching line as date:line:text, newest entry first.
+
+Patterns use Go's RE2 syntax. Use --ignore-case (-i) for case-insensitive
+matching.
+
+Examples:
+  logmd grep 'meeting|call'
+  logmd grep -i '^## todo'
+  logmd grep '\d+ km'`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGrepCommand,
+}
+
+// runGrepCommand implements the core logic for the grep command.
+func runGrepCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Compile the pattern before touching the filesystem
+	pattern, err := compileGrepPattern(args[0], grepIgnoreCase)
+	if err != nil {
+		return err
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Create vault instance
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Search and print matches
+	matches, err := v.Grep(pattern)
+	if err != nil {
+		return fmt.Errorf("failed to search entries: %w", err)
+	}
+
+	if len(matches) == 0 {
+		fmt.Fprintln(os.Stderr, "No matches found")
+		return nil
+	}
+	for _, match := range matches {
+		fmt.Printf("%s:%d:%s\n", match.Date, match.Line, match.Text)
+	}
+
+	return nil
+}
+
+// compileGrepPattern compiles a user-supplied pattern, optionally case-insensitive.
+func compileGrepPattern(pattern string, ignoreCase bool) (*regexp.Regexp, error) {
+	if ignoreCase {
+		pattern = "(?i)" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression: %w", err)
+	}
+	return re, nil
+}
+
+func init() {
+	grepCmd.Flags().BoolVarP(&grepIgnoreCase, "ignore-case", "i", false, "match case-insensitively")
+	rootCmd.AddCommand(grepCmd)
+}
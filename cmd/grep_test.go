@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestCompileGrepPattern tests case handling and invalid patterns.
+func TestCompileGrepPattern(t *testing.T) {
+	re, err := compileGrepPattern("todo", true)
+	if err != nil {
+		t.Fatalf("compileGrepPattern() failed: %v", err)
+	}
+	if !re.MatchString("## TODO list") {
+		t.Error("Expected --ignore-case pattern to match different case")
+	}
+
+	re, err = compileGrepPattern("todo", false)
+	if err != nil {
+		t.Fatalf("compileGrepPattern() failed: %v", err)
+	}
+	if re.MatchString("TODO") {
+		t.Error("Expected case-sensitive match by default")
+	}
+}
+
+// TestRunGrepCommandInvalidPattern tests that bad patterns fail before the vault is used.
+func TestRunGrepCommandInvalidPattern(t *testing.T) {
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	// A vault here would fail to initialize, so reaching it would change the error
+	os.Setenv("LOGMD_DIRECTORY", "/dev/null/journal")
+
+	err := runGrepCommand(nil, []string{"(unclosed"})
+	if err == nil {
+		t.Fatal("Expected error for invalid regular expression")
+	}
+	if !strings.HasPrefix(err.Error(), "invalid regular expression") {
+		t.Errorf("Expected a regular expression error, got %q", err.Error())
+	}
+}
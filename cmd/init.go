@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"logmd/config"
+	"logmd/prompt"
+)
+
+// initForce allows init to overwrite an existing config file
+var initForce bool
+
+// initCmd represents the init command
+// Learn: A first-run wizard turns settings users don't know about into questions.
+// See: https://clig.dev/#interactivity
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Set up logmd with a few questions",
+	Long: `Asks where to keep your journal, which editor to write in and how many
+lines timeline previews show, then writes a config file with those answers
+and creates the journal directory. Press enter to accept the suggestion
+in brackets.
+
+When not run from a terminal, the suggestions are used without asking and
+the config is written silently. An existing config file is only replaced
+after confirming, or with --force or --yes when there is no terminal to
+ask on; use 'logmd config set' to change one setting. With --profile the
+directory answer is saved as that profile's directory.
+
+Examples:
+  logmd init
+  logmd init --force`,
+	Args: cobra.NoArgs,
+	RunE: runInitCommand,
+}
+
+// runInitCommand implements the core logic for the init command.
+func runInitCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Start from the effective settings (defaults and LOGMD_* variables)
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Only clobber an existing file when told to, asking if possible
+	path, err := config.WritePath()
+	if err != nil {
+		return fmt.Errorf("failed to locate config file: %w", err)
+	}
+	interactive := term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+	if _, err := os.Stat(path); err == nil && !initForce {
+		if !interactive && !prompt.AssumeYes {
+			return fmt.Errorf("config file %s already exists (use --force to overwrite, or 'logmd config set' to change one setting)", path)
+		}
+		ok, err := prompt.Confirm(os.Stdin, os.Stdout, fmt.Sprintf("Config file %s already exists. Overwrite it?", path))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled; the config file was kept.")
+			return nil
+		}
+	}
+
+	// Step 3: Ask the questions when a person is there to answer them
+	if interactive {
+		fmt.Println("👋 Welcome to logmd! Press enter to keep the suggestion in brackets.")
+		fmt.Println()
+		if err := askSettings(os.Stdin, os.Stdout, cfg); err != nil {
+			return err
+		}
+	}
+
+	// Step 4: Create the journal directory and write the config file
+	if err := os.MkdirAll(cfg.Directory, 0700); err != nil {
+		return fmt.Errorf("failed to create journal directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(config.Template(cfg)), 0644); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	if interactive {
+		fmt.Println()
+		fmt.Printf("✅ Wrote config file: %s\n", path)
+		fmt.Printf("📁 Journal directory: %s\n", cfg.Directory)
+		fmt.Println("Run 'logmd today' to write your first entry.")
+	}
+	return nil
+}
+
+// askSettings prompts on w for the journal directory, editor and preview
+// lines, reading answers from r into cfg. An empty answer keeps the current
+// value, and an invalid preview count is asked again. With a profile active
+// the directory asked for is the profile's.
+func askSettings(r io.Reader, w io.Writer, cfg *config.Config) error {
+	scanner := bufio.NewScanner(r)
+	ask := func(question, suggestion string) (string, error) {
+		fmt.Fprintf(w, "%s [%s]: ", question, suggestion)
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return "", fmt.Errorf("failed to read answer: %w", err)
+			}
+			return "", io.ErrUnexpectedEOF
+		}
+		if answer := strings.TrimSpace(scanner.Text()); answer != "" {
+			return answer, nil
+		}
+		return suggestion, nil
+	}
+
+	question := "📁 Where should your journal live?"
+	if cfg.Profile != "" {
+		question = fmt.Sprintf("📁 Where should the %s profile's journal live?", cfg.Profile)
+	}
+	directory, err := ask(question, cfg.Directory)
+	if err != nil {
+		return err
+	}
+	if directory, err = expandDirectory(directory); err != nil {
+		return err
+	}
+	cfg.SetDirectory(directory)
+
+	if cfg.Editor, err = ask("✏️  Which editor do you write in?", cfg.Editor); err != nil {
+		return err
+	}
+
+	for {
+		answer, err := ask("👀 How many lines should timeline previews show?", strconv.Itoa(cfg.PreviewLines))
+		if err != nil {
+			return err
+		}
+		if n, err := strconv.Atoi(answer); err == nil && n > 0 {
+			cfg.PreviewLines = n
+			return nil
+		}
+		fmt.Fprintf(w, "Please enter a positive number, not %q.\n", answer)
+	}
+}
+
+// expandDirectory turns a typed directory into an absolute path, expanding
+// a leading ~ to the home directory the way a shell would.
+// Learn: The shell expands ~, so programs reading it from a prompt must do it themselves.
+// See: https://pkg.go.dev/os#UserHomeDir
+func expandDirectory(directory string) (string, error) {
+	if directory == "~" || strings.HasPrefix(directory, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find home directory: %w", err)
+		}
+		directory = filepath.Join(home, strings.TrimPrefix(directory, "~"))
+	}
+
+	abs, err := filepath.Abs(directory)
+	if err != nil {
+		return "", fmt.Errorf("invalid directory %s: %w", directory, err)
+	}
+	return abs, nil
+}
+
+func init() {
+	rootCmd.AddCommand(initCmd)
+	initCmd.Flags().BoolVar(&initForce, "force", false, "overwrite an existing config file")
+}
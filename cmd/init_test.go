@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"logmd/config"
+	"logmd/prompt"
+)
+
+// TestAskSettings tests reading the wizard's answers, keeping suggestions
+// for empty answers and asking again for a bad preview count.
+func TestAskSettings(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Fatalf("Failed to find home directory: %v", err)
+	}
+
+	cfg := &config.Config{Directory: "/journal", Editor: "vim", PreviewLines: 5}
+	var out bytes.Buffer
+	answers := "~/notes/journal\n\nthree\n0\n8\n"
+	if err := askSettings(strings.NewReader(answers), &out, cfg); err != nil {
+		t.Fatalf("askSettings() failed: %v", err)
+	}
+
+	if expected := filepath.Join(home, "notes", "journal"); cfg.Directory != expected {
+		t.Errorf("Expected directory %s, got %s", expected, cfg.Directory)
+	}
+	if cfg.Editor != "vim" {
+		t.Errorf("Expected the suggested editor to be kept, got %s", cfg.Editor)
+	}
+	if cfg.PreviewLines != 8 {
+		t.Errorf("Expected 8 preview lines, got %d", cfg.PreviewLines)
+	}
+	if !strings.Contains(out.String(), "[vim]") || strings.Count(out.String(), "positive number") != 2 {
+		t.Errorf("Unexpected prompts:\n%s", out.String())
+	}
+
+	// With a profile active the answer becomes the profile's directory
+	cfg = &config.Config{Directory: "/journal/work", Editor: "vim", PreviewLines: 5, Profile: "work", Profiles: map[string]string{"work": "/journal/work"}}
+	out.Reset()
+	if err := askSettings(strings.NewReader("/journal/office\n\n\n"), &out, cfg); err != nil {
+		t.Fatalf("askSettings() with a profile failed: %v", err)
+	}
+	if cfg.Profiles["work"] != "/journal/office" || cfg.Directory != "/journal/office" {
+		t.Errorf("Expected the work profile moved to /journal/office, got %v (directory %s)", cfg.Profiles, cfg.Directory)
+	}
+	if !strings.Contains(out.String(), "work profile") {
+		t.Errorf("Expected the question to name the profile, got:\n%s", out.String())
+	}
+
+	// Running out of input stops the wizard
+	if err := askSettings(strings.NewReader("/journal\n"), io.Discard, cfg); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Expected io.ErrUnexpectedEOF, got %v", err)
+	}
+}
+
+// TestExpandDirectory tests expanding ~ and relative directories.
+func TestExpandDirectory(t *testing.T) {
+	home, _ := os.UserHomeDir()
+	wd, _ := os.Getwd()
+
+	testCases := map[string]string{
+		"~":            home,
+		"~/journal":    filepath.Join(home, "journal"),
+		"/srv/journal": "/srv/journal",
+		"journal":      filepath.Join(wd, "journal"),
+		"~other/notes": filepath.Join(wd, "~other", "notes"),
+	}
+	for input, expected := range testCases {
+		got, err := expandDirectory(input)
+		if err != nil || got != expected {
+			t.Errorf("expandDirectory(%q) = %s, %v, expected %s", input, got, err, expected)
+		}
+	}
+}
+
+// TestRunInitCommand tests writing defaults without a terminal and
+// refusing to overwrite an existing config.
+func TestRunInitCommand(t *testing.T) {
+	originalVars := saveEnvironment()
+	defer restoreEnvironment(originalVars)
+	defer func() { initForce = false }()
+
+	clearLogmdEnvironment()
+
+	tmpDir, err := os.MkdirTemp("", "logmd-init-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	os.Setenv("HOME", tmpDir)
+	os.Setenv("EDITOR", "nano")
+
+	if err := runInitCommand(nil, []string{}); err != nil {
+		t.Fatalf("runInitCommand() failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, ".logmdconfig"))
+	if err != nil {
+		t.Fatalf("Expected config file to be written: %v", err)
+	}
+	if !strings.Contains(string(content), `editor = "nano"`) || !strings.Contains(string(content), "preview_lines = 5") {
+		t.Errorf("Expected default settings in config file, got:\n%s", content)
+	}
+	if info, err := os.Stat(filepath.Join(tmpDir, "logmd")); err != nil || !info.IsDir() {
+		t.Errorf("Expected the journal directory to be created: %v", err)
+	}
+
+	if err := runInitCommand(nil, []string{}); err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Errorf("Expected an error for an existing config file, got %v", err)
+	}
+
+	prompt.AssumeYes = true
+	if err := runInitCommand(nil, []string{}); err != nil {
+		t.Errorf("runInitCommand() with --yes failed: %v", err)
+	}
+	prompt.AssumeYes = false
+
+	initForce = true
+	if err := runInitCommand(nil, []string{}); err != nil {
+		t.Errorf("runInitCommand() with --force failed: %v", err)
+	}
+}
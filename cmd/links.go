@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// Flag values for the links command
+var (
+	// linksCheck validates each link instead of only listing it
+	linksCheck bool
+	// linksTimeout bounds each web request made by --check
+	linksTimeout time.Duration
+)
+
+// Styles for link check results
+var (
+	linkOKStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#10B981"))
+
+	linkBrokenStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#EF4444"))
+)
+
+// linksCmd represents the links command
+var linksCmd = &cobra.Command{
+	Use:   "links <YYYY-MM-DD>",
+	Short: "List the links in an entry",
+	Long: `Lists every link, image and bare URL in an entry with its text, in the
+order they appear. Links inside code are left out.
+
+With --check each link is validated: relative file links must point at an
+existing file (resolved from the entry's directory, as in 'logmd lint'),
+and http and https links must answer with a 2xx status within --timeout.
+The network is only used with --check. With wiki_links enabled,
+[[YYYY-MM-DD]] references are listed too and must name an existing entry.
+Other links, such as #anchors and mailto:, are listed as not checked. The command exits with a non-zero
+status when a link is broken.
+
+Examples:
+  logmd links 2024-01-15
+  logmd links 2024-01-15 --check
+  logmd links 2024-01-15 --check --timeout 2s`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runLinksCommand,
+}
+
+// runLinksCommand implements the core logic for the links command.
+func runLinksCommand(cmd *cobra.Command, args []string) error {
+	date := args[0]
+
+	// Step 1: Validate date format and flags
+	if !isValidDateFormat(date) {
+		return invalidDateError(date)
+	}
+	if linksCheck && linksTimeout <= 0 {
+		return fmt.Errorf("invalid --timeout value: %s (must be positive)", linksTimeout)
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Open the vault
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Read the entry and collect its links
+	content, err := v.ReadEntry(date)
+	if err != nil {
+		return fmt.Errorf("failed to read entry: %w", err)
+	}
+	renderer, err := markdown.NewRenderer(markdown.Options{Style: "notty", WikiLinks: cfg.WikiLinks})
+	if err != nil {
+		return fmt.Errorf("failed to create markdown renderer: %w", err)
+	}
+	links := renderer.ExtractLinks(content)
+	if len(links) == 0 {
+		fmt.Println(noEntryStyle.Render(fmt.Sprintf("No links in entry %s.", date)))
+		return nil
+	}
+
+	// Step 5: List the links, checking each one when asked
+	client := &http.Client{Timeout: linksTimeout}
+	broken := 0
+	for _, link := range links {
+		line := formatLink(link)
+		if linksCheck {
+			result := checkLink(client, v, date, link)
+			if result.broken {
+				broken++
+			}
+			line += "  " + result.String()
+		}
+		fmt.Println(line)
+	}
+
+	if broken > 0 {
+		return fmt.Errorf("found %d broken link(s) in %s", broken, date)
+	}
+	return nil
+}
+
+// formatLink describes a link as "text → destination", marking images.
+// Wiki links are shown as written.
+func formatLink(link markdown.Link) string {
+	if link.Wiki {
+		return "[[" + link.Destination + "]]"
+	}
+	line := link.Destination
+	if link.Text != "" && link.Text != link.Destination {
+		line = fmt.Sprintf("%s → %s", link.Text, link.Destination)
+	}
+	if link.Image {
+		line = "🖼️  " + line
+	}
+	return line
+}
+
+// linkResult is the outcome of checking one link destination.
+type linkResult struct {
+	// checked is false for destinations --check doesn't validate
+	checked bool
+	// broken is true when a checked link doesn't resolve
+	broken bool
+	// detail explains the outcome, e.g. "200 OK" or "file not found"
+	detail string
+}
+
+// String formats the result as a colored status for the links listing.
+func (r linkResult) String() string {
+	switch {
+	case !r.checked:
+		return calendarLabelStyle.Render("⏭️  " + r.detail)
+	case r.broken:
+		return linkBrokenStyle.Render("❌ " + r.detail)
+	default:
+		return linkOKStyle.Render("✅ " + r.detail)
+	}
+}
+
+// checkLink validates link as an entry in v for wiki links, a local file
+// relative to the entry for date, or a web URL fetched with client.
+func checkLink(client *http.Client, v *vault.Vault, date string, link markdown.Link) linkResult {
+	if link.Wiki {
+		if !v.EntryExists(link.Destination) {
+			return linkResult{checked: true, broken: true, detail: "entry not found"}
+		}
+		return linkResult{checked: true, detail: "entry exists"}
+	}
+
+	destination := link.Destination
+	lower := strings.ToLower(destination)
+	if strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") {
+		return checkURL(client, destination)
+	}
+
+	local, exists := v.CheckLocalLink(date, destination)
+	switch {
+	case !local:
+		return linkResult{detail: "not checked"}
+	case !exists:
+		return linkResult{checked: true, broken: true, detail: "file not found"}
+	default:
+		return linkResult{checked: true, detail: "file exists"}
+	}
+}
+
+// checkURL requests url with HEAD, retrying with GET for servers that
+// don't allow HEAD, and treats any 2xx status as working. Redirects are
+// followed by the client.
+// Learn: http.Client.Timeout covers the whole request, including reading the body.
+// See: https://pkg.go.dev/net/http#Client
+func checkURL(client *http.Client, url string) linkResult {
+	resp, err := client.Head(url)
+	if err == nil && (resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusNotImplemented) {
+		resp.Body.Close()
+		resp, err = client.Get(url)
+	}
+	if err != nil {
+		return linkResult{checked: true, broken: true, detail: fmt.Sprintf("request failed: %v", err)}
+	}
+	defer resp.Body.Close()
+
+	detail := resp.Status
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return linkResult{checked: true, broken: true, detail: detail}
+	}
+	return linkResult{checked: true, detail: detail}
+}
+
+func init() {
+	rootCmd.AddCommand(linksCmd)
+	linksCmd.Flags().BoolVar(&linksCheck, "check", false, "check that file links exist and web links return 2xx")
+	linksCmd.Flags().DurationVar(&linksTimeout, "timeout", 5*time.Second, "with --check, how long to wait for each web link")
+}
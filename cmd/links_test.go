@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// TestFormatLink tests describing links, images and bare URLs.
+func TestFormatLink(t *testing.T) {
+	tests := []struct {
+		link     markdown.Link
+		expected string
+	}{
+		{markdown.Link{Destination: "2024-01-14.md", Text: "yesterday"}, "yesterday → 2024-01-14.md"},
+		{markdown.Link{Destination: "https://go.dev", Text: "https://go.dev"}, "https://go.dev"},
+		{markdown.Link{Destination: "cat.jpg", Text: "cat", Image: true}, "🖼️  cat → cat.jpg"},
+		{markdown.Link{Destination: "cat.jpg", Image: true}, "🖼️  cat.jpg"},
+		{markdown.Link{Destination: "2024-01-14", Text: "2024-01-14", Wiki: true}, "[[2024-01-14]]"},
+	}
+
+	for _, tt := range tests {
+		if got := formatLink(tt.link); got != tt.expected {
+			t.Errorf("formatLink(%+v) = %q, expected %q", tt.link, got, tt.expected)
+		}
+	}
+}
+
+// TestCheckLink tests checking local files, web links, wiki links and other targets.
+func TestCheckLink(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-links-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	os.WriteFile(filepath.Join(tmpDir, "notes.md"), []byte("notes"), 0644)
+	if err := v.WriteEntry("2024-01-14", []byte("# Sunday\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/get-only":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Timeout: 50 * time.Millisecond}
+	testCases := []struct {
+		destination string
+		wiki        bool
+		checked     bool
+		broken      bool
+	}{
+		{"notes.md", false, true, false},
+		{"missing.md", false, true, true},
+		{server.URL + "/ok", false, true, false},
+		{server.URL + "/get-only", false, true, false},
+		{server.URL + "/gone", false, true, true},
+		{server.URL + "/slow", false, true, true},
+		{"#morning", false, false, false},
+		{"mailto:me@example.com", false, false, false},
+		{"2024-01-14", true, true, false},
+		{"2024-01-13", true, true, true},
+	}
+	for _, tc := range testCases {
+		result := checkLink(client, v, "2024-01-15", markdown.Link{Destination: tc.destination, Wiki: tc.wiki})
+		if result.checked != tc.checked || result.broken != tc.broken {
+			t.Errorf("checkLink(%q) = %+v, expected checked=%t broken=%t", tc.destination, result, tc.checked, tc.broken)
+		}
+	}
+}
+
+// TestRunLinksCommand tests listing links and failing on broken ones.
+func TestRunLinksCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-links-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		linksCheck = false
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-15", []byte("# Monday\n\nSee [Sunday](2024-01-14.md) and [top](#monday).\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	// Listing never checks, so the missing file isn't an error
+	if err := runLinksCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Errorf("runLinksCommand() failed: %v", err)
+	}
+
+	linksCheck = true
+	err = runLinksCommand(nil, []string{"2024-01-15"})
+	if err == nil || !strings.Contains(err.Error(), "found 1 broken link(s)") {
+		t.Errorf("Expected one broken link, got %v", err)
+	}
+
+	if err := v.WriteEntry("2024-01-14", []byte("# Sunday\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+	if err := runLinksCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Errorf("Expected no broken links once the target exists, got %v", err)
+	}
+	if err := runLinksCommand(nil, []string{"2024-01-14"}); err != nil {
+		t.Errorf("runLinksCommand() on an entry without links failed: %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/vault"
+)
+
+// lintCmd represents the lint command
+var lintCmd = &cobra.Command{
+	Use:   "lint [YYYY-MM-DD]",
+	Short: "Check entries for common problems",
+	Long: `Checks journal entries for common problems and prints one line per finding
+as date:line: rule: message. Without a date every entry is checked.
+
+Rules:
+  heading              the entry doesn't start with a "# " heading
+  heading-date         the heading names a different date than the file
+  trailing-whitespace  a line ends with spaces or tabs
+  broken-link          a relative link or image points at a missing file
+
+The command exits with a non-zero status when anything is found, so it can
+run as a pre-commit hook.
+
+Examples:
+  logmd lint
+  logmd lint 2024-01-15`,
+	Args:         cobra.MaximumNArgs(1),
+	SilenceUsage: true,
+	RunE:         runLintCommand,
+}
+
+// runLintCommand implements the core logic for the lint command.
+func runLintCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Validate the optional date
+	if len(args) == 1 && !isValidDateFormat(args[0]) {
+		return invalidDateError(args[0])
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Create vault instance
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Lint one entry or all of them
+	var findings []vault.Finding
+	if len(args) == 1 {
+		findings, err = v.Lint(args[0])
+	} else {
+		findings, err = v.LintAll()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lint entries: %w", err)
+	}
+
+	// Step 5: Report, failing when anything was found
+	for _, finding := range findings {
+		fmt.Println(finding)
+	}
+	if len(findings) > 0 {
+		return fmt.Errorf("found %d problem(s)", len(findings))
+	}
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestRunLintCommand tests that findings make the command fail.
+func TestRunLintCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-lint-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.CreateEntry("2024-01-15"); err != nil {
+		t.Fatalf("Failed to create entry: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-16", []byte("# 2024-01-15\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	if err := runLintCommand(nil, []string{"15-01-2024"}); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+	if err := runLintCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Errorf("Expected a clean entry to pass, got %v", err)
+	}
+	if err := runLintCommand(nil, []string{"2024-01-16"}); err == nil {
+		t.Error("Expected a mismatched heading to fail")
+	}
+	if err := runLintCommand(nil, []string{}); err == nil {
+		t.Error("Expected linting all entries to fail")
+	}
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// Flag values for the list command
+var (
+	// listJSON emits entries as a JSON array instead of text lines
+	listJSON bool
+	// listLimit restricts output to the N most recent entries (0 means all)
+	listLimit int
+	// listFrom and listTo bound the listed dates (inclusive, empty means open)
+	listFrom string
+	listTo   string
+)
+
+// listCmd represents the list command
+// Learn: Scriptable commands should print stable, line-oriented output.
+// See: https://clig.dev/#output
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List journal entries as plain text",
+	Long: `Prints one line per journal entry, newest first, with the date, title
+and file size. Unlike the timeline, the output is plain text suitable for
+scripts and pipes.
+
+Examples:
+  logmd list
+  logmd list --limit 7
+  logmd list --from 2024-01-01 --to 2024-01-31
+  logmd list --json | jq '.[].date'`,
+	Args: cobra.NoArgs,
+	RunE: runListCommand,
+}
+
+// runListCommand implements the core logic for the list command.
+func runListCommand(cmd *cobra.Command, args []string) error {
+	if listLimit < 0 {
+		return fmt.Errorf("invalid --limit value: %d (must not be negative)", listLimit)
+	}
+
+	// Step 1: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Create vault instance
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Collect entry metadata (already sorted newest first)
+	filenames, err := v.ListEntriesInRange(listFrom, listTo)
+	if err != nil {
+		return fmt.Errorf("failed to list entries: %w", err)
+	}
+
+	entries := make([]vault.EntryInfo, 0, len(filenames))
+	for _, filename := range filenames {
+		entries = append(entries, v.GetEntryInfo(strings.TrimSuffix(filename, ".md")))
+	}
+
+	if listLimit > 0 && len(entries) > listLimit {
+		entries = entries[:listLimit]
+	}
+
+	// Step 4: Print in the requested format
+	if listJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(entries)
+	}
+
+	for _, entry := range entries {
+		title := "(untitled)"
+		if content, err := v.ReadEntry(entry.Date); err == nil {
+			title = markdown.ExtractFirstHeading(content)
+		}
+		fmt.Printf("%s  %-40s %8s\n", entry.Date, title, formatSize(entry.Size))
+	}
+
+	return nil
+}
+
+// formatSize formats a byte count in a compact human-readable form.
+// Learn: Integer division and float formatting combine for readable units.
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	if size < unit*unit {
+		return fmt.Sprintf("%.1f KB", float64(size)/unit)
+	}
+	return fmt.Sprintf("%.1f MB", float64(size)/(unit*unit))
+}
+
+func init() {
+	listCmd.Flags().BoolVar(&listJSON, "json", false, "output entries as JSON")
+	listCmd.Flags().IntVar(&listLimit, "limit", 0, "show only the N most recent entries")
+	listCmd.Flags().StringVar(&listFrom, "from", "", "only list entries on or after this date (YYYY-MM-DD)")
+	listCmd.Flags().StringVar(&listTo, "to", "", "only list entries on or before this date (YYYY-MM-DD)")
+	rootCmd.AddCommand(listCmd)
+}
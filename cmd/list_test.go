@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestRunListCommand tests text and JSON listing with a limit.
+func TestRunListCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-list-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+
+	for _, date := range []string{"2024-01-01", "2024-01-02", "2024-01-03"} {
+		if err := v.WriteEntry(date, []byte("# Entry "+date)); err != nil {
+			t.Fatalf("Failed to write test entry %s: %v", date, err)
+		}
+	}
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		listJSON = false
+		listLimit = 0
+		listFrom = ""
+		listTo = ""
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	if err := runListCommand(nil, []string{}); err != nil {
+		t.Fatalf("runListCommand() failed: %v", err)
+	}
+
+	listJSON = true
+	listLimit = 2
+	if err := runListCommand(nil, []string{}); err != nil {
+		t.Fatalf("runListCommand() with --json failed: %v", err)
+	}
+
+	listLimit = 0
+	listFrom = "2024-01-02"
+	listTo = "2024-01-03"
+	if err := runListCommand(nil, []string{}); err != nil {
+		t.Fatalf("runListCommand() with --from/--to failed: %v", err)
+	}
+
+	listTo = "not-a-date"
+	if err := runListCommand(nil, []string{}); err == nil {
+		t.Error("Expected error for invalid --to date")
+	}
+
+	listLimit = -1
+	if err := runListCommand(nil, []string{}); err == nil {
+		t.Error("Expected error for negative --limit")
+	}
+
+	// A mistyped directory is reported, not created
+	listLimit = 0
+	listTo = ""
+	missing := filepath.Join(tmpDir, "typo")
+	os.Setenv("LOGMD_DIRECTORY", missing)
+	if err := runListCommand(nil, []string{}); !errors.Is(err, vault.ErrNoDirectory) {
+		t.Errorf("Expected a missing directory error, got %v", err)
+	}
+	if _, err := os.Stat(missing); !os.IsNotExist(err) {
+		t.Error("list should not create the journal directory")
+	}
+}
+
+// TestFormatSize tests human-readable size formatting.
+func TestFormatSize(t *testing.T) {
+	testCases := []struct {
+		size     int64
+		expected string
+	}{
+		{0, "0 B"},
+		{512, "512 B"},
+		{1024, "1.0 KB"},
+		{1536, "1.5 KB"},
+		{5 * 1024 * 1024, "5.0 MB"},
+	}
+
+	for _, tc := range testCases {
+		if got := formatSize(tc.size); got != tc.expected {
+			t.Errorf("formatSize(%d) = %q, expected %q", tc.size, got, tc.expected)
+		}
+	}
+}
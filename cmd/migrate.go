@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/vault"
+)
+
+// migrateTo holds the --to flag value
+var migrateTo string
+
+// migrateCmd represents the migrate command
+var migrateCmd = &cobra.Command{
+	Use:   "migrate --to <flat|nested>",
+	Short: "Move entries between the flat and nested directory layouts",
+	Long: `Moves every journal entry from the currently configured layout into the
+requested one:
+
+  flat    journal/2024-01-15.md
+  nested  journal/2024/01/2024-01-15.md
+
+Entries are renamed in place and never overwritten. Afterwards the layout
+is saved in your config file so logmd looks for entries in the new place.
+Add --dry-run to print every mkdir, mv and rmdir it would run instead.
+
+Examples:
+  logmd migrate --to nested --dry-run
+  logmd migrate --to nested
+  logmd migrate --to flat`,
+	Args: cobra.NoArgs,
+	RunE: runMigrateCommand,
+}
+
+// runMigrateCommand implements the core logic for the migrate command.
+func runMigrateCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Validate the target layout
+	target, err := vault.ParseLayout(migrateTo)
+	if err != nil || migrateTo == "" {
+		return fmt.Errorf("invalid --to value: %q (expected flat or nested)", migrateTo)
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Open the vault in its current layout
+	v, err := newVault(cfg)
+	if err != nil {
+		return err
+	}
+	if v.Layout == target {
+		fmt.Printf("Entries already use the %s layout\n", target)
+		return nil
+	}
+
+	// Step 4: Print the plan instead when dry running
+	if dryRun {
+		plan, err := v.PlanMigration(target)
+		if err != nil {
+			return fmt.Errorf("failed to plan migration: %w", err)
+		}
+		printDryRun(plan)
+		return nil
+	}
+
+	// Step 5: Move the entries
+	moved, err := v.MigrateTo(target)
+	if err != nil {
+		return fmt.Errorf("failed to migrate entries (%d moved): %w", moved, err)
+	}
+
+	fmt.Printf("✅ Moved %d entries to the %s layout\n", moved, target)
+
+	// Step 6: Save the layout so later commands look in the new place
+	path, err := config.WritePath()
+	if err == nil {
+		err = config.Set(path, "layout", string(target))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save layout (run 'logmd config set layout %s'): %w", target, err)
+	}
+	fmt.Printf("✅ Set layout = %s in %s\n", target, path)
+	if env := os.Getenv("LOGMD_LAYOUT"); env != "" && env != string(target) {
+		fmt.Fprintf(os.Stderr, "⚠️  LOGMD_LAYOUT=%s overrides the config file; unset it to use the %s layout\n", env, target)
+	}
+	return nil
+}
+
+func init() {
+	migrateCmd.Flags().StringVar(&migrateTo, "to", "", "target layout: flat or nested")
+	migrateCmd.MarkFlagRequired("to")
+	rootCmd.AddCommand(migrateCmd)
+}
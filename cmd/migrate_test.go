@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"logmd/config"
+	"logmd/vault"
+)
+
+// TestRunMigrateCommand tests moving a flat vault to the nested layout.
+func TestRunMigrateCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-migrate-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.CreateEntry("2024-01-15"); err != nil {
+		t.Fatalf("Failed to create entry: %v", err)
+	}
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	originalLayout := os.Getenv("LOGMD_LAYOUT")
+	originalHome := os.Getenv("HOME")
+	originalXDG, hadXDG := os.LookupEnv("XDG_CONFIG_HOME")
+	defer func() {
+		os.Setenv("HOME", originalHome)
+		if hadXDG {
+			os.Setenv("XDG_CONFIG_HOME", originalXDG)
+		}
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		if originalLayout != "" {
+			os.Setenv("LOGMD_LAYOUT", originalLayout)
+		} else {
+			os.Unsetenv("LOGMD_LAYOUT")
+		}
+		migrateTo = ""
+		dryRun = false
+	}()
+
+	// The migrated layout is saved in a config file under HOME
+	os.Setenv("HOME", tmpDir)
+	os.Unsetenv("XDG_CONFIG_HOME")
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+	os.Unsetenv("LOGMD_LAYOUT")
+	if err := os.WriteFile(filepath.Join(tmpDir, config.FileName), []byte("layout = \"flat\"\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	migrateTo = "sideways"
+	if err := runMigrateCommand(nil, []string{}); err == nil {
+		t.Error("Expected error for unknown layout")
+	}
+
+	// A dry run leaves the flat layout in place
+	migrateTo = "nested"
+	dryRun = true
+	if err := runMigrateCommand(nil, []string{}); err != nil {
+		t.Fatalf("runMigrateCommand() --dry-run failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "2024-01-15.md")); err != nil {
+		t.Errorf("Expected --dry-run to leave the entry in place: %v", err)
+	}
+	if cfg, err := config.Load(); err != nil || cfg.Layout != "flat" {
+		t.Errorf("Expected --dry-run to keep the flat layout, got %v", err)
+	}
+	dryRun = false
+
+	if err := runMigrateCommand(nil, []string{}); err != nil {
+		t.Fatalf("runMigrateCommand() failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "2024", "01", "2024-01-15.md")); err != nil {
+		t.Errorf("Expected entry in nested layout: %v", err)
+	}
+
+	// The config now names the nested layout, so other commands find the entry
+	if cfg, err := config.Load(); err != nil || cfg.Layout != "nested" {
+		t.Errorf("Expected the nested layout to be saved, got %v", err)
+	}
+	if err := runViewCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Errorf("runViewCommand() with nested layout failed: %v", err)
+	}
+}
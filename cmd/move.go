@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/vault"
+)
+
+// moveRewriteHeading holds the --rewrite-heading flag value
+var moveRewriteHeading bool
+
+// moveCmd represents the move command
+var moveCmd = &cobra.Command{
+	Use:     "move <from> <to>",
+	Aliases: []string{"mv"},
+	Short:   "Move an entry to a different date",
+	Long: `Moves the journal entry for one date to another, for when an entry was
+filed under the wrong day. The destination must not already have an entry.
+
+The content is left untouched unless --rewrite-heading is given, in which
+case a "# <from>" heading becomes "# <to>". Add --dry-run to print
+the file operations without performing them.
+
+Examples:
+  logmd move 2024-01-15 2024-01-14
+  logmd move 2024-01-15 2024-01-14 --rewrite-heading
+  logmd move 2024-01-15 2024-01-14 --dry-run`,
+	Args: cobra.ExactArgs(2),
+	RunE: runMoveCommand,
+}
+
+// runMoveCommand implements the core logic for the move command.
+func runMoveCommand(cmd *cobra.Command, args []string) error {
+	from, to := args[0], args[1]
+
+	// Step 1: Validate both dates before touching the vault
+	for _, date := range []string{from, to} {
+		if !isValidDateFormat(date) {
+			return invalidDateError(date)
+		}
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Create vault instance. Renaming never reads the content, so
+	// the passphrase is only needed to rewrite the heading.
+	v, err := newVault(cfg)
+	if err != nil {
+		return err
+	}
+	if moveRewriteHeading {
+		if err := cfg.UnlockVault(v); err != nil {
+			return err
+		}
+	}
+
+	// Step 4: Print the plan instead when dry running
+	if dryRun {
+		return printMovePlan(v, from, to)
+	}
+
+	// Step 5: Move the entry
+	if err := v.MoveEntry(from, to); err != nil {
+		return fmt.Errorf("failed to move entry: %w", err)
+	}
+	fmt.Printf("✅ Moved %s to %s\n", from, to)
+
+	// Step 6: Update the heading if asked
+	if moveRewriteHeading {
+		changed, err := v.RewriteHeading(to, from, to)
+		if err != nil {
+			return fmt.Errorf("failed to rewrite heading: %w", err)
+		}
+		if changed {
+			fmt.Printf("✏️  Updated heading to # %s\n", to)
+		}
+	}
+
+	return nil
+}
+
+// printMovePlan prints what moving from to to would do, including the
+// heading rewrite when --rewrite-heading would change it.
+func printMovePlan(v *vault.Vault, from, to string) error {
+	plan, err := v.PlanMove(from, to)
+	if err != nil {
+		return fmt.Errorf("failed to move entry: %w", err)
+	}
+	printDryRun(plan)
+
+	if moveRewriteHeading {
+		matches, err := v.HasHeading(from, from)
+		if err != nil {
+			return fmt.Errorf("failed to read heading: %w", err)
+		}
+		if matches {
+			fmt.Printf("rewrite heading in %s: # %s -> # %s\n", absPath(v.DatePath(to)), from, to)
+		}
+	}
+	return nil
+}
+
+func init() {
+	moveCmd.Flags().BoolVar(&moveRewriteHeading, "rewrite-heading", false, "also change a \"# <from>\" heading to the new date")
+	rootCmd.AddCommand(moveCmd)
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestRunMoveCommand tests moving an entry with and without --rewrite-heading.
+func TestRunMoveCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-move-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		moveRewriteHeading = false
+		dryRun = false
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	for _, date := range []string{"2024-01-15", "2024-01-20"} {
+		if err := v.CreateEntry(date); err != nil {
+			t.Fatalf("Failed to create entry: %v", err)
+		}
+	}
+
+	if err := runMoveCommand(nil, []string{"2024-01-15", "01/14/2024"}); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+	if err := runMoveCommand(nil, []string{"2024-01-15", "2024-01-20"}); err == nil {
+		t.Error("Expected error when the destination exists")
+	}
+
+	// A dry run leaves everything where it is
+	dryRun = true
+	if err := runMoveCommand(nil, []string{"2024-01-15", "2024-01-14"}); err != nil {
+		t.Fatalf("runMoveCommand() --dry-run failed: %v", err)
+	}
+	if !v.EntryExists("2024-01-15") || v.EntryExists("2024-01-14") {
+		t.Error("Expected --dry-run not to move the entry")
+	}
+	dryRun = false
+
+	// Without the flag the heading keeps the old date
+	if err := runMoveCommand(nil, []string{"2024-01-15", "2024-01-14"}); err != nil {
+		t.Fatalf("runMoveCommand() failed: %v", err)
+	}
+	if content, err := v.ReadEntry("2024-01-14"); err != nil || string(content) != "# 2024-01-15\n\n" {
+		t.Errorf("Expected untouched content, got %q, %v", content, err)
+	}
+
+	moveRewriteHeading = true
+	if err := runMoveCommand(nil, []string{"2024-01-20", "2024-01-21"}); err != nil {
+		t.Fatalf("runMoveCommand() with --rewrite-heading failed: %v", err)
+	}
+	if content, err := v.ReadEntry("2024-01-21"); err != nil || string(content) != "# 2024-01-21\n\n" {
+		t.Errorf("Expected rewritten heading, got %q, %v", content, err)
+	}
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+)
+
+// Flag values for the new command
+var (
+	// newOpen holds the --open flag value
+	newOpen bool
+	// newNoTemplate holds the --no-template flag value
+	newNoTemplate bool
+)
+
+// newCmd represents the new command
+var newCmd = &cobra.Command{
+	Use:   "new <YYYY-MM-DD>",
+	Short: "Create the entry for a date without opening it",
+	Long: `Creates the journal entry for the given date from the usual template and
+prints its path. Nothing is opened unless --open is given, which makes it
+easy to set up several days at once. It is an error if the entry already
+exists. Use --no-template to start from a completely empty file instead.
+
+Examples:
+  logmd new 2024-01-15
+  logmd new 2024-01-15 --no-template
+  logmd new 2030-01-01 --open
+  for d in 2024-03-0{1..7}; do logmd new "$d"; done`,
+	Args: cobra.ExactArgs(1),
+	RunE: runNewCommand,
+}
+
+// runNewCommand implements the core logic for the new command.
+func runNewCommand(cmd *cobra.Command, args []string) error {
+	dateStr := args[0]
+
+	// Step 1: Validate date format
+	if !isValidDateFormat(dateStr) {
+		return invalidDateError(dateStr)
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Create vault instance
+	v, err := createVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Create the entry, failing if it already exists
+	if err := createEntry(v, dateStr, newNoTemplate); err != nil {
+		return fmt.Errorf("failed to create entry: %w", err)
+	}
+	fmt.Println(v.DatePath(dateStr))
+
+	// Step 5: Optionally open it, then commit if git_auto_commit is enabled
+	if newOpen {
+		editor, editorArgs := editorFor(cfg, "")
+		if err := editEntry(editor, editorArgs, v, dateStr); err != nil {
+			return fmt.Errorf("failed to launch editor: %w", err)
+		}
+	}
+	commitEntry(cfg, v, dateStr)
+
+	return nil
+}
+
+func init() {
+	newCmd.Flags().BoolVar(&newOpen, "open", false, "open the new entry in your editor")
+	newCmd.Flags().BoolVar(&newNoTemplate, "no-template", false, "create an empty entry without the dated heading")
+	rootCmd.AddCommand(newCmd)
+}
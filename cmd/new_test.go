@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestRunNewCommand tests creating entries without opening them.
+func TestRunNewCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-new-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalEditor := os.Getenv("LOGMD_EDITOR")
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	originalFrontMatter := os.Getenv("LOGMD_FRONT_MATTER")
+	defer func() {
+		if originalEditor != "" {
+			os.Setenv("LOGMD_EDITOR", originalEditor)
+		} else {
+			os.Unsetenv("LOGMD_EDITOR")
+		}
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		if originalFrontMatter != "" {
+			os.Setenv("LOGMD_FRONT_MATTER", originalFrontMatter)
+		} else {
+			os.Unsetenv("LOGMD_FRONT_MATTER")
+		}
+		newOpen = false
+		newNoTemplate = false
+	}()
+
+	// A failing editor proves new doesn't open anything without --open
+	os.Setenv("LOGMD_EDITOR", "false")
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+	os.Unsetenv("LOGMD_FRONT_MATTER")
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+
+	if err := runNewCommand(nil, []string{"2024-13-01"}); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+
+	if err := runNewCommand(nil, []string{"2030-01-01"}); err != nil {
+		t.Fatalf("runNewCommand() failed: %v", err)
+	}
+	if content, err := v.ReadEntry("2030-01-01"); err != nil || string(content) != "# 2030-01-01\n\n" {
+		t.Errorf("Expected templated entry, got %q, %v", content, err)
+	}
+
+	err = runNewCommand(nil, []string{"2030-01-01"})
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("Expected an already exists error, got %v", err)
+	}
+
+	// front_matter adds the default fields above the heading
+	os.Setenv("LOGMD_FRONT_MATTER", "true")
+	if err := runNewCommand(nil, []string{"2030-01-04"}); err != nil {
+		t.Fatalf("runNewCommand() with front matter failed: %v", err)
+	}
+	expected := "---\ndate: 2030-01-04\nmood:\ntags: []\n---\n\n# 2030-01-04\n\n"
+	if content, err := v.ReadEntry("2030-01-04"); err != nil || string(content) != expected {
+		t.Errorf("Expected entry with front matter, got %q, %v", content, err)
+	}
+	os.Unsetenv("LOGMD_FRONT_MATTER")
+
+	// --no-template leaves the new entry empty
+	newNoTemplate = true
+	if err := runNewCommand(nil, []string{"2030-01-05"}); err != nil {
+		t.Fatalf("runNewCommand() --no-template failed: %v", err)
+	}
+	if content, err := v.ReadEntry("2030-01-05"); err != nil || len(content) != 0 {
+		t.Errorf("Expected an empty entry, got %q, %v", content, err)
+	}
+	newNoTemplate = false
+
+	// --open hands the new entry to the editor
+	newOpen = true
+	if err := runNewCommand(nil, []string{"2030-01-02"}); err == nil {
+		t.Error("Expected the failing editor's error with --open")
+	}
+	os.Setenv("LOGMD_EDITOR", "true")
+	if err := runNewCommand(nil, []string{"2030-01-03"}); err != nil {
+		t.Errorf("runNewCommand() with --open failed: %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+)
+
+// noteCmd represents the note command
+// Learn: cobra.MinimumNArgs lets users skip quoting multi-word arguments.
+// See: https://pkg.go.dev/github.com/spf13/cobra#MinimumNArgs
+var noteCmd = &cobra.Command{
+	Use:   "note <text>",
+	Short: "Append a timestamped note to today's entry",
+	Long: `Adds a quick bullet to today's journal entry without opening an editor.
+Each note is prefixed with the current time, for example:
+
+  - 14:05 quick thought
+
+If today's entry doesn't exist yet it is created from the usual template
+first. Quoting the text is optional; all arguments are joined with spaces.
+
+Examples:
+  logmd note "quick thought"
+  logmd note remember to call the bank`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runNoteCommand,
+}
+
+// runNoteCommand implements the core logic for the note command.
+func runNoteCommand(cmd *cobra.Command, args []string) error {
+	text := strings.TrimSpace(strings.Join(args, " "))
+	if text == "" {
+		return fmt.Errorf("note text cannot be empty")
+	}
+
+	// Step 1: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Create vault instance
+	v, err := createVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Append the timestamped bullet
+	now := cfg.Now()
+	today := cfg.Today().Format("2006-01-02")
+	if err := v.AppendToEntry(today, []byte(formatNote(now, text))); err != nil {
+		return fmt.Errorf("failed to append note to %s: %w", today, err)
+	}
+	commitEntry(cfg, v, today)
+
+	fmt.Printf("📝 Added note to %s\n", today)
+	return nil
+}
+
+// formatNote renders text as a "- HH:MM text" bullet. Continuation lines of a
+// multi-line note are indented so they stay part of the same list item.
+func formatNote(at time.Time, text string) string {
+	lines := strings.Split(text, "\n")
+	return fmt.Sprintf("- %s %s\n", at.Format("15:04"), strings.Join(lines, "\n  "))
+}
+
+func init() {
+	rootCmd.AddCommand(noteCmd)
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"logmd/vault"
+)
+
+// TestFormatNote tests the timestamped bullet format.
+func TestFormatNote(t *testing.T) {
+	at := time.Date(2024, 1, 15, 9, 5, 0, 0, time.Local)
+
+	if got := formatNote(at, "quick thought"); got != "- 09:05 quick thought\n" {
+		t.Errorf("Unexpected note %q", got)
+	}
+	if got := formatNote(at, "first\nsecond"); got != "- 09:05 first\n  second\n" {
+		t.Errorf("Expected continuation lines to be indented, got %q", got)
+	}
+}
+
+// TestRunNoteCommand tests appending notes to today's entry.
+func TestRunNoteCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-note-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	if err := runNoteCommand(nil, []string{"quick", "thought"}); err != nil {
+		t.Fatalf("runNoteCommand() failed: %v", err)
+	}
+	if err := runNoteCommand(nil, []string{"another one"}); err != nil {
+		t.Fatalf("runNoteCommand() failed: %v", err)
+	}
+	if err := runNoteCommand(nil, []string{"  "}); err == nil {
+		t.Error("Expected error for empty note")
+	}
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	content, err := v.ReadEntry(time.Now().Format("2006-01-02"))
+	if err != nil {
+		t.Fatalf("Failed to read today's entry: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "# ") {
+		t.Fatalf("Expected heading, blank line and two notes, got %q", content)
+	}
+	if !strings.HasSuffix(lines[2], " quick thought") || !strings.HasSuffix(lines[3], " another one") {
+		t.Errorf("Expected notes in order, got %q", content)
+	}
+}
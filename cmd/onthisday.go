@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+)
+
+// onThisDayCmd represents the onthisday command
+var onThisDayCmd = &cobra.Command{
+	Use:   "onthisday [YYYY-MM-DD]",
+	Short: "Show entries from the same day in previous years",
+	Long: `Recalls what you wrote on this month and day in earlier years, such as
+every past January 15, newest first. Give a date to recall a different day;
+only years before that date's year are shown.
+
+Examples:
+  logmd onthisday
+  logmd onthisday 2024-07-04`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runOnThisDayCommand,
+}
+
+// runOnThisDayCommand implements the core logic for the onthisday command.
+func runOnThisDayCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Resolve the day to recall, today by default
+	day := cfg.Today()
+	if len(args) == 1 {
+		if !isValidDateFormat(args[0]) {
+			return invalidDateError(args[0])
+		}
+		day, _ = time.Parse("2006-01-02", args[0])
+	}
+
+	// Step 3: Create vault instance
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Find entries from the same month and day in earlier years
+	filenames, err := v.ListEntries()
+	if err != nil {
+		return fmt.Errorf("failed to list entries: %w", err)
+	}
+	dates := sameDayDates(filenames, day)
+	if len(dates) == 0 {
+		fmt.Println(noEntryStyle.Render(fmt.Sprintf("No entries from previous years on %s.", day.Format("January 2"))))
+		return nil
+	}
+
+	// Step 5: Create markdown renderer
+	renderer, err := markdown.NewRenderer(markdown.Options{
+		Style:     renderStyle(cfg.Style),
+		CodeTheme: cfg.CodeTheme,
+		WordWrap:  resolveWordWrap(0, cfg.WordWrap),
+		WikiLinks: cfg.WikiLinks,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create markdown renderer: %w", err)
+	}
+
+	// Step 6: Render each entry under a header saying how long ago it was
+	fmt.Println(weekTitleStyle.Render(fmt.Sprintf("🕰️  On this day, %s • written in %s",
+		day.Format("January 2"), pluralYears(len(dates)))))
+
+	for _, date := range dates {
+		years := day.Year() - yearOf(date)
+		fmt.Println()
+		fmt.Println(dayHeaderStyle.Render(fmt.Sprintf("── %s · %s ago", date, pluralYears(years))))
+
+		content, err := v.ReadEntry(date)
+		if err != nil {
+			return fmt.Errorf("failed to read entry %s: %w", date, err)
+		}
+		rendered, err := renderer.Render(content)
+		if err != nil {
+			return fmt.Errorf("failed to render entry %s: %w", date, err)
+		}
+		fmt.Print(rendered)
+	}
+
+	return nil
+}
+
+// sameDayDates returns the dates of entries written on the month and day of
+// day in years before it, keeping the newest-first order of filenames.
+func sameDayDates(filenames []string, day time.Time) []string {
+	suffix := day.Format("-01-02")
+	var dates []string
+	for _, filename := range filenames {
+		date := strings.TrimSuffix(filename, ".md")
+		if strings.HasSuffix(date, suffix) && yearOf(date) < day.Year() {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
+
+// yearOf returns the year of a YYYY-MM-DD date.
+func yearOf(date string) int {
+	parsed, _ := time.Parse("2006-01-02", date)
+	return parsed.Year()
+}
+
+// pluralYears formats a year count with the correct noun.
+func pluralYears(n int) string {
+	if n == 1 {
+		return "1 year"
+	}
+	return fmt.Sprintf("%d years", n)
+}
+
+func init() {
+	rootCmd.AddCommand(onThisDayCmd)
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"logmd/vault"
+)
+
+// TestSameDayDates tests matching the month and day across earlier years.
+func TestSameDayDates(t *testing.T) {
+	filenames := []string{"2025-01-15.md", "2024-01-16.md", "2024-01-15.md", "2023-12-15.md", "2022-01-15.md"}
+
+	testCases := []struct {
+		name     string
+		day      string
+		expected []string
+	}{
+		{"PastYears", "2025-01-15", []string{"2024-01-15", "2022-01-15"}},
+		{"OnlyBeforeYear", "2024-01-15", []string{"2022-01-15"}},
+		{"NextDay", "2026-01-16", []string{"2024-01-16"}},
+		{"NoMatches", "2025-03-01", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			day, _ := time.Parse("2006-01-02", tc.day)
+			if got := sameDayDates(filenames, day); !slices.Equal(got, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestRunOnThisDayCommand tests recalling entries and invalid dates.
+func TestRunOnThisDayCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-onthisday-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	for _, date := range []string{"2022-07-04", "2023-07-04"} {
+		if err := v.WriteEntry(date, []byte("# Fireworks "+date+"\n")); err != nil {
+			t.Fatalf("Failed to write entry %s: %v", date, err)
+		}
+	}
+
+	for _, args := range [][]string{{}, {"2024-07-04"}, {"2024-07-05"}} {
+		if err := runOnThisDayCommand(nil, args); err != nil {
+			t.Errorf("runOnThisDayCommand(%v) failed: %v", args, err)
+		}
+	}
+	if err := runOnThisDayCommand(nil, []string{"July 4"}); err == nil {
+		t.Error("Expected error for an invalid date")
+	}
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// defaultPager is used when $PAGER is unset; -R passes colors through.
+var defaultPager = []string{"less", "-R"}
+
+// pageOutput prints text through the user's pager when stdout is a terminal
+// and text is taller than it, and prints it directly otherwise. Piped output,
+// --no-pager and a missing pager all fall back to a plain print.
+// Learn: A pager reads what to show from its stdin and draws on the terminal itself.
+// See: https://pkg.go.dev/os/exec#Cmd
+func pageOutput(text string, noPager bool) error {
+	fd := int(os.Stdout.Fd())
+	if noPager || !term.IsTerminal(fd) {
+		fmt.Print(text)
+		return nil
+	}
+	_, height, err := term.GetSize(fd)
+	if err != nil || !needsPager(text, height) {
+		fmt.Print(text)
+		return nil
+	}
+
+	args := pagerCommand(os.Getenv("PAGER"))
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		fmt.Print(text)
+		return nil
+	}
+
+	cmd := exec.Command(path, args[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run pager %s: %w", args[0], err)
+	}
+	return nil
+}
+
+// pagerCommand splits a $PAGER value such as "less -R" into a command and
+// its arguments, using defaultPager when the value is empty.
+func pagerCommand(pager string) []string {
+	if args := strings.Fields(pager); len(args) > 0 {
+		return args
+	}
+	return defaultPager
+}
+
+// needsPager reports whether text has more lines than a terminal of the
+// given height can show at once.
+func needsPager(text string, height int) bool {
+	if height <= 0 {
+		return false
+	}
+	return strings.Count(strings.TrimSuffix(text, "\n"), "\n")+1 > height
+}
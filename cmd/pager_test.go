@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestPagerCommand tests splitting $PAGER and the less -R default.
+func TestPagerCommand(t *testing.T) {
+	tests := []struct {
+		pager    string
+		expected []string
+	}{
+		{"", []string{"less", "-R"}},
+		{"   ", []string{"less", "-R"}},
+		{"more", []string{"more"}},
+		{"less -RFX", []string{"less", "-RFX"}},
+		{"bat --paging=always -p", []string{"bat", "--paging=always", "-p"}},
+	}
+
+	for _, tt := range tests {
+		if got := pagerCommand(tt.pager); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("pagerCommand(%q) = %v, expected %v", tt.pager, got, tt.expected)
+		}
+	}
+}
+
+// TestNeedsPager tests comparing output height with the terminal height.
+func TestNeedsPager(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		height   int
+		expected bool
+	}{
+		{"Fits", "one\ntwo\n", 24, false},
+		{"ExactlyFits", strings.Repeat("line\n", 24), 24, false},
+		{"TooTall", strings.Repeat("line\n", 25), 24, true},
+		{"NoTrailingNewline", "one\ntwo\nthree", 2, true},
+		{"UnknownHeight", strings.Repeat("line\n", 100), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := needsPager(tt.text, tt.height); got != tt.expected {
+				t.Errorf("needsPager() = %t, expected %t", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"logmd/vault"
+)
+
+// formatPlan renders a plan as shell-like lines with absolute paths, one
+// operation per line in the order they would run.
+// See: https://pkg.go.dev/path/filepath#Abs
+func formatPlan(plan vault.Plan) string {
+	var b strings.Builder
+	for _, dir := range plan.Mkdirs {
+		fmt.Fprintf(&b, "mkdir -p %s\n", absPath(dir))
+	}
+	for _, move := range plan.Moves {
+		fmt.Fprintf(&b, "mv %s %s\n", absPath(move.From), absPath(move.To))
+	}
+	for _, path := range plan.Removes {
+		fmt.Fprintf(&b, "rm %s\n", absPath(path))
+	}
+	for _, dir := range plan.Rmdirs {
+		fmt.Fprintf(&b, "rmdir %s\n", absPath(dir))
+	}
+	return b.String()
+}
+
+// absPath resolves path against the working directory, returning it
+// unchanged if that fails.
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
+
+// printDryRun prints the plan under a heading marking it as a dry run.
+func printDryRun(plan vault.Plan) {
+	fmt.Println("🔍 Dry run, nothing was changed. Planned operations:")
+	fmt.Print(formatPlan(plan))
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestFormatPlan tests that plans print absolute paths in run order.
+func TestFormatPlan(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+
+	plan := vault.Plan{
+		Mkdirs:  []string{"journal/2024/02"},
+		Moves:   []vault.Move{{From: "journal/2024/01/2024-01-31.md", To: "journal/2024/02/2024-02-01.md"}},
+		Removes: []string{"journal/2024/01/2024-01-30.md"},
+		Rmdirs:  []string{"/abs/journal/2024/01"},
+	}
+
+	expected := "mkdir -p " + filepath.Join(wd, "journal/2024/02") + "\n" +
+		"mv " + filepath.Join(wd, "journal/2024/01/2024-01-31.md") + " " + filepath.Join(wd, "journal/2024/02/2024-02-01.md") + "\n" +
+		"rm " + filepath.Join(wd, "journal/2024/01/2024-01-30.md") + "\n" +
+		"rmdir /abs/journal/2024/01\n"
+	if got := formatPlan(plan); got != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, got)
+	}
+
+	if got := formatPlan(vault.Plan{}); got != "" {
+		t.Errorf("Expected an empty plan to print nothing, got %q", got)
+	}
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+)
+
+// withStdin points os.Stdin at a file holding input until the test ends.
+func withStdin(t *testing.T, input string) {
+	t.Helper()
+	file, err := os.CreateTemp("", "logmd-stdin-*")
+	if err != nil {
+		t.Fatalf("Failed to create stdin file: %v", err)
+	}
+	if _, err := file.WriteString(input); err != nil {
+		t.Fatalf("Failed to write stdin file: %v", err)
+	}
+	if _, err := file.Seek(0, 0); err != nil {
+		t.Fatalf("Failed to rewind stdin file: %v", err)
+	}
+
+	original := os.Stdin
+	os.Stdin = file
+	t.Cleanup(func() {
+		os.Stdin = original
+		file.Close()
+		os.Remove(file.Name())
+	})
+}
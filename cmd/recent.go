@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+)
+
+// recentCmd represents the recent command
+var recentCmd = &cobra.Command{
+	Use:   "recent [duration]",
+	Short: "List entries modified recently",
+	Long: `Lists the entries whose files were modified within the given duration,
+most recently modified first, so entries edited on other days are easy to
+find again. The duration uses Go syntax and defaults to 24h.
+
+Examples:
+  logmd recent
+  logmd recent 2h
+  logmd recent 168h`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runRecentCommand,
+}
+
+// runRecentCommand implements the core logic for the recent command.
+// Learn: time.ParseDuration accepts units from ns up to h, like "1h30m".
+// See: https://pkg.go.dev/time#ParseDuration
+func runRecentCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Parse the duration
+	window := 24 * time.Hour
+	if len(args) == 1 {
+		d, err := time.ParseDuration(args[0])
+		if err != nil || d <= 0 {
+			return fmt.Errorf("invalid duration: %s (expected a positive Go duration such as 24h or 90m)", args[0])
+		}
+		window = d
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Create vault instance
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Find entries modified inside the window
+	entries, err := v.EntriesModifiedSince(time.Now().Add(-window))
+	if err != nil {
+		return fmt.Errorf("failed to list entries: %w", err)
+	}
+	if len(entries) == 0 {
+		fmt.Fprintf(os.Stderr, "No entries modified in the last %s\n", window)
+		return nil
+	}
+
+	for _, entry := range entries {
+		title := "(untitled)"
+		if content, err := v.ReadEntry(entry.Date); err == nil {
+			title = markdown.ExtractFirstHeading(content)
+		}
+		fmt.Printf("%s  %-40s %s\n", entry.Date, title, entry.ModTime.Format("2006-01-02 15:04"))
+	}
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(recentCmd)
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"logmd/vault"
+)
+
+// TestRunRecentCommand tests listing recently modified entries and duration parsing.
+func TestRunRecentCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-recent-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	if err := runRecentCommand(nil, []string{}); err != nil {
+		t.Fatalf("runRecentCommand() on empty vault failed: %v", err)
+	}
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.CreateEntry("2024-01-15"); err != nil {
+		t.Fatalf("Failed to create entry: %v", err)
+	}
+	old := time.Now().Add(-3 * time.Hour)
+	if err := os.Chtimes(v.DatePath("2024-01-15"), old, old); err != nil {
+		t.Fatalf("Failed to set modification time: %v", err)
+	}
+
+	for _, args := range [][]string{{}, {"4h"}, {"1h"}} {
+		if err := runRecentCommand(nil, args); err != nil {
+			t.Errorf("runRecentCommand(%v) failed: %v", args, err)
+		}
+	}
+
+	for _, duration := range []string{"1d", "-2h", "0s", "soon"} {
+		if err := runRecentCommand(nil, []string{duration}); err == nil {
+			t.Errorf("Expected error for duration %q", duration)
+		}
+	}
+}
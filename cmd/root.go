@@ -1,10 +1,17 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 	"github.com/spf13/cobra"
 	"logmd/assist"
+	"logmd/config"
+	"logmd/logging"
+	"logmd/prompt"
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -15,20 +22,142 @@ var rootCmd = &cobra.Command{
 	Short: "A minimal, local-first journal CLI",
 	Long: `logmd is a developer-focused journaling tool that creates daily
 markdown files. It provides a simple CLI interface for creating, viewing,
-and browsing your daily logs.`,
+and browsing your daily logs.
+
+Running logmd without a command shows this help, or runs the command set
+as default_command in the config, e.g. "timeline".
+
+Exit status:
+  0  success
+  1  any other error
+  2  usage error: unknown command or flag, wrong arguments or an invalid date
+  3  the requested entry does not exist
+  4  configuration error, including a missing journal directory`,
+	PersistentPreRun: applyGlobalFlags,
+	RunE:             runRootCommand,
 }
 
+// noColor disables colored output for every command
+var noColor bool
+
+// dryRun makes commands that move or remove files print the operations
+// they would perform instead of performing them
+var dryRun bool
+
+// verbose logs config resolution and file operations to stderr
+var verbose bool
+
+// profileName selects a named journal directory from the [profiles] table
+var profileName string
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 // Learn: cobra.Execute() handles command parsing, validation, and execution flow.
 func Execute() {
-	err := rootCmd.Execute()
+	if code := execute(); code != exitOK {
+		os.Exit(code)
+	}
+}
+
+// execute runs the root command and returns the exit code for how it went.
+func execute() int {
+	markUsage.Do(func() { markUsageErrors(rootCmd) })
+	return exitCode(rootCmd.Execute())
+}
+
+// runRootCommand runs the configured default_command when logmd is called
+// without a subcommand, or shows the help when there is none.
+func runRootCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	target, targetArgs, err := resolveDefaultCommand(cmd, cfg.DefaultCommand)
 	if err != nil {
-		os.Exit(1)
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: %v\n", err)
+	}
+	if target == nil {
+		return cmd.Help()
+	}
+	return runSubcommand(target, targetArgs)
+}
+
+// resolveDefaultCommand finds the subcommand of root named by a
+// default_command value such as "recent" or "list --limit 7", returning it
+// with the rest of the value as its arguments. An empty value or "help"
+// gives no command. Values naming no subcommand are an error rather than
+// resolving to root, which would run itself again.
+func resolveDefaultCommand(root *cobra.Command, value string) (*cobra.Command, []string, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 || fields[0] == "help" {
+		return nil, nil, nil
+	}
+
+	target, args, err := root.Find(fields)
+	if err != nil || target == root {
+		return nil, nil, fmt.Errorf("default_command %q is not a logmd command, showing help instead", value)
+	}
+	return target, args, nil
+}
+
+// runSubcommand parses args as target's flags and arguments and runs it,
+// as Execute would after finding it on the command line.
+// Learn: Cobra exposes the steps of Execute, so a command can be run directly.
+// See: https://pkg.go.dev/github.com/spf13/cobra#Command.ParseFlags
+func runSubcommand(target *cobra.Command, args []string) error {
+	if err := target.ParseFlags(args); err != nil {
+		return fmt.Errorf("invalid default_command flags: %w", err)
+	}
+	args = target.Flags().Args()
+	if err := target.ValidateArgs(args); err != nil {
+		return fmt.Errorf("invalid default_command arguments: %w", err)
+	}
+
+	switch {
+	case target.RunE != nil:
+		return target.RunE(target, args)
+	case target.Run != nil:
+		target.Run(target, args)
+		return nil
+	default:
+		return target.Help()
+	}
+}
+
+// applyGlobalFlags applies the root flags that affect every command.
+// Learn: PersistentPreRun on the root command runs before any subcommand.
+func applyGlobalFlags(cmd *cobra.Command, args []string) {
+	applyColorSettings(cmd, args)
+	config.SetProfile(profileName)
+	if verbose {
+		logging.SetLevel(logging.LevelDebug)
+	}
+}
+
+// colorDisabled reports whether colors are turned off, either with
+// --no-color or by setting NO_COLOR to any non-empty value.
+// See: https://no-color.org
+func colorDisabled() bool {
+	return noColor || os.Getenv("NO_COLOR") != ""
+}
+
+// applyColorSettings switches lipgloss to the plain ASCII profile when
+// colors are disabled, so every style renders without escape codes.
+// See: https://pkg.go.dev/github.com/charmbracelet/lipgloss#SetColorProfile
+func applyColorSettings(cmd *cobra.Command, args []string) {
+	if colorDisabled() {
+		lipgloss.SetColorProfile(termenv.Ascii)
 	}
 }
 
 func init() {
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colored output (also honors NO_COLOR)")
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "", "use the journal directory of a profile from [profiles] (also LOGMD_PROFILE)")
+	rootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "log the config file, overrides and file operations to stderr")
+	rootCmd.PersistentFlags().BoolVarP(&prompt.AssumeYes, "yes", "y", false, "answer yes to confirmation prompts (delete, trash empty, init, assist)")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "print file operations instead of performing them (move, migrate, delete, trash empty)")
+
 	// Register the assist command from the assist package
 	rootCmd.AddCommand(assist.AssistCmd)
 }
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/spf13/cobra"
+)
+
+// TestColorDisabled tests the --no-color flag and the NO_COLOR variable.
+func TestColorDisabled(t *testing.T) {
+	originalNoColor, hadNoColor := os.LookupEnv("NO_COLOR")
+	originalProfile := lipgloss.ColorProfile()
+	defer func() {
+		noColor = false
+		if hadNoColor {
+			os.Setenv("NO_COLOR", originalNoColor)
+		} else {
+			os.Unsetenv("NO_COLOR")
+		}
+		lipgloss.SetColorProfile(originalProfile)
+	}()
+
+	os.Unsetenv("NO_COLOR")
+	if colorDisabled() {
+		t.Error("Expected colors enabled by default")
+	}
+
+	// An empty NO_COLOR doesn't count as set
+	os.Setenv("NO_COLOR", "")
+	if colorDisabled() {
+		t.Error("Expected an empty NO_COLOR to be ignored")
+	}
+
+	os.Setenv("NO_COLOR", "1")
+	if !colorDisabled() {
+		t.Error("Expected NO_COLOR to disable colors")
+	}
+
+	os.Unsetenv("NO_COLOR")
+	noColor = true
+	if !colorDisabled() {
+		t.Error("Expected --no-color to disable colors")
+	}
+
+	// Styles render as plain text once the settings are applied
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	applyColorSettings(rootCmd, nil)
+	if got := streakStyle.Render("5 days"); got != "5 days" {
+		t.Errorf("Expected unstyled output with colors disabled, got %q", got)
+	}
+	if got := renderStyle("dark"); got != "notty" {
+		t.Errorf("Expected notty render style with colors disabled, got %s", got)
+	}
+}
+
+// TestResolveDefaultCommand tests finding the subcommand for default_command.
+func TestResolveDefaultCommand(t *testing.T) {
+	testCases := []struct {
+		value   string
+		command *cobra.Command
+		args    []string
+		wantErr bool
+	}{
+		{value: "", command: nil},
+		{value: "  ", command: nil},
+		{value: "help", command: nil},
+		{value: "timeline", command: timelineCmd, args: []string{}},
+		{value: "list --limit 7", command: listCmd, args: []string{"--limit", "7"}},
+		{value: "trash list", command: trashListCmd, args: []string{}},
+		{value: "not-a-command", wantErr: true},
+		{value: "--no-color", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		command, args, err := resolveDefaultCommand(rootCmd, tc.value)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("resolveDefaultCommand(%q) error = %v, wantErr %v", tc.value, err, tc.wantErr)
+			continue
+		}
+		if command != tc.command {
+			t.Errorf("resolveDefaultCommand(%q) = %v, expected %v", tc.value, command, tc.command)
+		}
+		if !slices.Equal(args, tc.args) {
+			t.Errorf("resolveDefaultCommand(%q) args = %v, expected %v", tc.value, args, tc.args)
+		}
+	}
+}
+
+// TestRunRootCommand tests running the default command, and showing help
+// when it's unset or invalid rather than running logmd again.
+func TestRunRootCommand(t *testing.T) {
+	originalVars := saveEnvironment()
+	defer restoreEnvironment(originalVars)
+	defer func() {
+		versionCmd.SetOut(nil)
+		rootCmd.SetOut(nil)
+	}()
+
+	clearLogmdEnvironment()
+
+	var versionOut, rootOut bytes.Buffer
+	versionCmd.SetOut(&versionOut)
+	rootCmd.SetOut(&rootOut)
+
+	os.Setenv("LOGMD_DEFAULT_COMMAND", "version")
+	if err := runRootCommand(rootCmd, []string{}); err != nil {
+		t.Fatalf("runRootCommand() failed: %v", err)
+	}
+	if !strings.HasPrefix(versionOut.String(), "logmd ") || rootOut.Len() != 0 {
+		t.Errorf("Expected the version command to run, got %q and help %q", versionOut.String(), rootOut.String())
+	}
+
+	for _, value := range []string{"", "logmd", "not-a-command"} {
+		rootOut.Reset()
+		os.Setenv("LOGMD_DEFAULT_COMMAND", value)
+		if err := runRootCommand(rootCmd, []string{}); err != nil {
+			t.Errorf("runRootCommand() with %q failed: %v", value, err)
+		}
+		if !strings.Contains(rootOut.String(), "Available Commands") {
+			t.Errorf("Expected help for default_command %q, got %q", value, rootOut.String())
+		}
+	}
+
+	os.Setenv("LOGMD_DEFAULT_COMMAND", "version extra")
+	if err := runRootCommand(rootCmd, []string{}); err == nil {
+		t.Error("Expected an error for arguments the command doesn't take")
+	}
+}
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// Flag values for the stats command
+// Learn: Cobra binds flags to package-level variables declared next to the command.
+// See: https://github.com/spf13/cobra/blob/main/site/content/user_guide.md#working-with-flags
+var (
+	statsStreakCalendar bool
+	statsWeeks          int
+)
+
+// Styles for the streak calendar
+var (
+	streakStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#F59E0B"))
+
+	filledDayStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#10B981"))
+
+	emptyDayStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#374151"))
+
+	calendarLabelStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#6B7280"))
+)
+
+// statsCmd represents the stats command
+// Learn: Boolean flags let one command switch between related output modes.
+// See: https://pkg.go.dev/github.com/spf13/pflag#BoolVar
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show journaling statistics",
+	Long: `Shows a summary of your journal: how many entries you have written, your
+current daily streak, and the total word count with its reading time.
+
+Use --streak-calendar for a compact habit-tracking view of the last few
+weeks, with days that have an entry highlighted.
+
+Examples:
+  logmd stats
+  logmd stats --streak-calendar
+  logmd stats --streak-calendar --weeks 26`,
+	RunE: runStatsCommand,
+}
+
+// runStatsCommand implements the core logic for the stats command.
+func runStatsCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Create vault instance
+	v, err := newVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Collect the dates that have entries
+	dates, err := v.ExistingDates()
+	if err != nil {
+		return fmt.Errorf("failed to list entries: %w", err)
+	}
+
+	// Step 4: Display the requested view
+	now := cfg.Today()
+	if statsStreakCalendar {
+		if statsWeeks <= 0 {
+			return fmt.Errorf("invalid --weeks value: %d (must be positive)", statsWeeks)
+		}
+		fmt.Println(renderStreakCalendar(dates, now, statsWeeks))
+		return nil
+	}
+
+	// Step 5: Count words, which needs the content of every entry
+	if err := cfg.UnlockVault(v); err != nil {
+		return err
+	}
+	words, err := totalWords(v, dates)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Entries:        %d\n", len(dates))
+	fmt.Printf("Current streak: %s\n", pluralDays(vault.CurrentStreak(dates, now)))
+	fmt.Printf("Words:          %d\n", words)
+	fmt.Printf("Reading time:   %s\n", markdown.FormatReadingTime(words))
+	return nil
+}
+
+// totalWords adds up the word count of every entry in dates.
+func totalWords(v vault.Store, dates map[string]bool) (int, error) {
+	words := 0
+	for date := range dates {
+		content, err := v.ReadEntry(date)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read entry %s: %w", date, err)
+		}
+		words += markdown.WordCount(content)
+	}
+	return words, nil
+}
+
+// renderStreakCalendar renders a week-per-column calendar ending with the week
+// containing today, highlighting days with entries and the current streak.
+// Learn: lipgloss.JoinHorizontal lays out multi-line blocks side by side.
+// See: https://github.com/charmbracelet/lipgloss#joining-paragraphs
+func renderStreakCalendar(dates map[string]bool, today time.Time, weeks int) string {
+	// Find the Monday of the current week, then step back to the first week shown
+	start := weekStart(today).AddDate(0, 0, -(weeks-1)*7)
+
+	labels := []string{"Mon", "", "Wed", "", "Fri", "", "Sun"}
+	columns := []string{calendarLabelStyle.Render(strings.Join(labels, "\n"))}
+
+	entries := 0
+	for w := 0; w < weeks; w++ {
+		cells := make([]string, 7)
+		for d := 0; d < 7; d++ {
+			day := start.AddDate(0, 0, w*7+d)
+			switch {
+			case day.After(today):
+				cells[d] = " "
+			case dates[day.Format("2006-01-02")]:
+				cells[d] = filledDayStyle.Render("■")
+				entries++
+			default:
+				cells[d] = emptyDayStyle.Render("·")
+			}
+		}
+		columns = append(columns, " "+strings.Join(cells, "\n"))
+	}
+
+	var b strings.Builder
+	b.WriteString(streakStyle.Render(fmt.Sprintf("🔥 Current streak: %s", pluralDays(vault.CurrentStreak(dates, today)))))
+	b.WriteString("\n\n")
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, columns...))
+	b.WriteString("\n\n")
+	b.WriteString(calendarLabelStyle.Render(fmt.Sprintf("Last %d weeks • %d entries", weeks, entries)))
+
+	return b.String()
+}
+
+// pluralDays formats a day count with the correct noun.
+func pluralDays(n int) string {
+	if n == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", n)
+}
+
+func init() {
+	statsCmd.Flags().BoolVar(&statsStreakCalendar, "streak-calendar", false, "show a calendar of recent weeks with the current streak")
+	statsCmd.Flags().IntVar(&statsWeeks, "weeks", 12, "number of weeks shown by --streak-calendar")
+	rootCmd.AddCommand(statsCmd)
+}
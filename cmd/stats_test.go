@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"logmd/vault"
+)
+
+// TestRenderStreakCalendar tests the calendar layout and streak headline.
+func TestRenderStreakCalendar(t *testing.T) {
+	// Wednesday, so the last column is only partially filled
+	today := time.Date(2024, 3, 6, 9, 0, 0, 0, time.Local)
+	dates := map[string]bool{
+		"2024-03-04": true,
+		"2024-03-05": true,
+		"2024-03-06": true,
+		"2024-02-20": true,
+		"2023-01-01": true, // Outside the window
+	}
+
+	output := ansi.Strip(renderStreakCalendar(dates, today, 4))
+
+	if !strings.Contains(output, "Current streak: 3 days") {
+		t.Errorf("Expected streak headline, got:\n%s", output)
+	}
+
+	if !strings.Contains(output, "Last 4 weeks • 4 entries") {
+		t.Errorf("Expected footer to count only entries in window, got:\n%s", output)
+	}
+
+	if got := strings.Count(output, "■"); got != 4 {
+		t.Errorf("Expected 4 filled days, got %d", got)
+	}
+
+	// 4 weeks of 7 days minus 4 filled days minus 4 future days (Thu-Sun)
+	if got := strings.Count(output, "·"); got != 20 {
+		t.Errorf("Expected 20 empty days, got %d", got)
+	}
+}
+
+// TestRunStatsCommand tests the stats command in both output modes.
+func TestRunStatsCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-stats-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.CreateTodayEntry(); err != nil {
+		t.Fatalf("Failed to create today's entry: %v", err)
+	}
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		statsStreakCalendar = false
+		statsWeeks = 12
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	if err := runStatsCommand(nil, []string{}); err != nil {
+		t.Fatalf("runStatsCommand() failed: %v", err)
+	}
+
+	statsStreakCalendar = true
+	if err := runStatsCommand(nil, []string{}); err != nil {
+		t.Fatalf("runStatsCommand() with --streak-calendar failed: %v", err)
+	}
+
+	statsWeeks = 0
+	if err := runStatsCommand(nil, []string{}); err == nil {
+		t.Error("Expected error for non-positive --weeks")
+	}
+}
+
+// TestTotalWords tests summing word counts across entries.
+func TestTotalWords(t *testing.T) {
+	v := vault.NewMemory()
+	v.WriteEntry("2024-01-01", []byte("# New Year\n\nRun **more** often.\n"))
+	v.WriteEntry("2024-01-02", []byte(""))
+
+	words, err := totalWords(v, map[string]bool{"2024-01-01": true, "2024-01-02": true})
+	if err != nil {
+		t.Fatalf("totalWords() failed: %v", err)
+	}
+	if words != 5 {
+		t.Errorf("Expected 5 words, got %d", words)
+	}
+
+	if _, err := totalWords(v, map[string]bool{"2024-02-01": true}); err == nil {
+		t.Error("Expected error for a missing entry")
+	}
+}
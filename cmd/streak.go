@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/vault"
+)
+
+// streakCmd represents the streak command
+var streakCmd = &cobra.Command{
+	Use:   "streak",
+	Short: "Show your current and longest writing streaks",
+	Long: `Reports your current run of consecutive days with an entry, the longest
+run you have ever had, and the total number of days written.
+
+If you haven't written today yet, the current streak still counts through
+yesterday, so it only breaks once a whole day is missed.
+
+Examples:
+  logmd streak`,
+	Args: cobra.NoArgs,
+	RunE: runStreakCommand,
+}
+
+// runStreakCommand implements the core logic for the streak command.
+func runStreakCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Create vault instance
+	v, err := newVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Collect the dates that have entries
+	dates, err := v.ExistingDates()
+	if err != nil {
+		return fmt.Errorf("failed to list entries: %w", err)
+	}
+
+	// Step 4: Print the summary
+	now := cfg.Today()
+	current := vault.CurrentStreak(dates, now)
+
+	fmt.Printf("🔥 Current streak: %s\n", pluralDays(current))
+	fmt.Printf("🏆 Longest streak: %s\n", pluralDays(vault.LongestStreak(dates)))
+	fmt.Printf("📝 Days written:   %d\n", len(dates))
+
+	if current > 0 && !dates[now.Format("2006-01-02")] {
+		fmt.Println("\nWrite today's entry to keep your streak going!")
+	}
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(streakCmd)
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"logmd/vault"
+)
+
+// TestRunStreakCommand tests the streak summary on an empty and a used vault.
+func TestRunStreakCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-streak-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	if err := runStreakCommand(nil, []string{}); err != nil {
+		t.Fatalf("runStreakCommand() on empty vault failed: %v", err)
+	}
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	yesterday := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	if err := v.CreateEntry(yesterday); err != nil {
+		t.Fatalf("Failed to create entry: %v", err)
+	}
+
+	if err := runStreakCommand(nil, []string{}); err != nil {
+		t.Fatalf("runStreakCommand() failed: %v", err)
+	}
+}
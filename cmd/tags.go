@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+)
+
+// tagsCmd represents the tags command
+// Learn: cobra.MaximumNArgs allows a command to work with or without an argument.
+// See: https://pkg.go.dev/github.com/spf13/cobra#MaximumNArgs
+var tagsCmd = &cobra.Command{
+	Use:   "tags [tag]",
+	Short: "List #tags used in journal entries",
+	Long: `Without arguments, lists every #tag found in your journal entries along
+with the number of entries using it, most used first. With a tag argument,
+lists the dates of entries containing that tag, newest first.
+
+Tags are words prefixed with '#' in the body of an entry. Headings and code
+blocks are ignored, and tags are matched case-insensitively.
+
+Examples:
+  logmd tags
+  logmd tags work
+  logmd tags '#work'`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runTagsCommand,
+}
+
+// runTagsCommand implements the core logic for the tags command.
+func runTagsCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Create vault instance
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 3: List dates for a single tag
+	if len(args) == 1 {
+		dates, err := v.EntriesByTag(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to search tags: %w", err)
+		}
+		if len(dates) == 0 {
+			return fmt.Errorf("no entries tagged %s", args[0])
+		}
+		for _, date := range dates {
+			fmt.Println(date)
+		}
+		return nil
+	}
+
+	// Step 4: List all tags with counts
+	index, err := v.TagIndex()
+	if err != nil {
+		return fmt.Errorf("failed to build tag index: %w", err)
+	}
+
+	for _, tag := range sortedTags(index) {
+		fmt.Printf("#%-30s %d\n", tag, len(index[tag]))
+	}
+
+	return nil
+}
+
+// sortedTags orders tags by entry count (descending), then alphabetically.
+func sortedTags(index map[string][]string) []string {
+	tags := make([]string, 0, len(index))
+	for tag := range index {
+		tags = append(tags, tag)
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		if len(index[tags[i]]) != len(index[tags[j]]) {
+			return len(index[tags[i]]) > len(index[tags[j]])
+		}
+		return tags[i] < tags[j]
+	})
+
+	return tags
+}
+
+func init() {
+	rootCmd.AddCommand(tagsCmd)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestRunTagsCommand tests listing all tags and entries for a tag.
+func TestRunTagsCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-tags-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-01", []byte("# Day\n\n#work and #health")); err != nil {
+		t.Fatalf("Failed to write test entry: %v", err)
+	}
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	if err := runTagsCommand(nil, []string{}); err != nil {
+		t.Fatalf("runTagsCommand() failed: %v", err)
+	}
+
+	if err := runTagsCommand(nil, []string{"#Work"}); err != nil {
+		t.Fatalf("runTagsCommand() with tag failed: %v", err)
+	}
+
+	err = runTagsCommand(nil, []string{"missing"})
+	if err == nil || !strings.Contains(err.Error(), "no entries tagged") {
+		t.Errorf("Expected 'no entries tagged' error, got: %v", err)
+	}
+}
+
+// TestSortedTags tests ordering by count then name.
+func TestSortedTags(t *testing.T) {
+	index := map[string][]string{
+		"beta":  {"2024-01-01"},
+		"alpha": {"2024-01-01"},
+		"work":  {"2024-01-01", "2024-01-02"},
+	}
+
+	got := strings.Join(sortedTags(index), ",")
+	if got != "work,alpha,beta" {
+		t.Errorf("Expected work,alpha,beta, got %s", got)
+	}
+}
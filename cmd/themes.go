@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+)
+
+// themesCmd represents the themes command
+var themesCmd = &cobra.Command{
+	Use:   "themes",
+	Short: "List the syntax highlighting themes for code blocks",
+	Long: `Lists the themes that can be used to highlight code blocks in rendered
+entries, marking the one that is configured. Set one with the code_theme
+setting; leaving it empty keeps the highlighting of the glamour style.
+
+To try a theme on an entry before choosing it, set it for one command
+through the environment.
+
+Examples:
+  logmd themes
+  LOGMD_CODE_THEME=monokai logmd view 2024-01-15
+  logmd config set code_theme monokai`,
+	Args: cobra.NoArgs,
+	RunE: runThemesCommand,
+}
+
+// runThemesCommand implements the core logic for the themes command.
+func runThemesCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Load configuration to mark the configured theme
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: List the themes
+	fmt.Print(formatThemes(markdown.CodeThemes(), cfg.CodeTheme))
+	if cfg.CodeTheme == "" {
+		fmt.Println(calendarLabelStyle.Render(fmt.Sprintf("No code_theme set; code blocks use the %s style's highlighting.", cfg.Style)))
+	} else if !markdown.IsCodeTheme(cfg.CodeTheme) {
+		fmt.Println(linkBrokenStyle.Render(fmt.Sprintf("The configured code_theme %q is not a known theme.", cfg.CodeTheme)))
+	}
+	return nil
+}
+
+// formatThemes lists one theme per line, checking the current one.
+func formatThemes(themes []string, current string) string {
+	var b strings.Builder
+	for _, theme := range themes {
+		if theme == current {
+			b.WriteString(linkOKStyle.Render("✓ "+theme) + "\n")
+		} else {
+			b.WriteString("  " + theme + "\n")
+		}
+	}
+	return b.String()
+}
+
+func init() {
+	rootCmd.AddCommand(themesCmd)
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// TestFormatThemes tests marking the configured theme in the listing.
+func TestFormatThemes(t *testing.T) {
+	originalProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.Ascii)
+	defer lipgloss.SetColorProfile(originalProfile)
+
+	themes := []string{"dracula", "github", "monokai"}
+	expected := "  dracula\n  github\n✓ monokai\n"
+	if got := formatThemes(themes, "monokai"); got != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	expected = "  dracula\n  github\n  monokai\n"
+	if got := formatThemes(themes, ""); got != expected {
+		t.Errorf("Expected no theme checked, got:\n%s", got)
+	}
+}
+
+// TestRunThemesCommand tests listing themes with and without one configured.
+func TestRunThemesCommand(t *testing.T) {
+	originalTheme, hadTheme := os.LookupEnv("LOGMD_CODE_THEME")
+	defer func() {
+		if hadTheme {
+			os.Setenv("LOGMD_CODE_THEME", originalTheme)
+		} else {
+			os.Unsetenv("LOGMD_CODE_THEME")
+		}
+	}()
+
+	for _, theme := range []string{"", "monokai", "not-a-theme"} {
+		os.Setenv("LOGMD_CODE_THEME", theme)
+		if err := runThemesCommand(nil, []string{}); err != nil {
+			t.Errorf("runThemesCommand() with code_theme %q failed: %v", theme, err)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -19,14 +20,33 @@ var timelineCmd = &cobra.Command{
 Navigate through your journal entries, expand/collapse previews, and
 browse your writing history in a beautiful terminal interface.
 
+Today's entry is pinned to the top of the timeline. If it hasn't been
+written yet it shows as "(not written)"; press enter to create it.
+
 Controls:
   ↑/k     Move up
   ↓/j     Move down
-  enter   Toggle expand/collapse entry
-  space   Toggle expand/collapse entry
+  enter   Expand entry further (preview, more, all), then collapse
+  space   Expand entry further (preview, more, all), then collapse
   pgup    Page up
   pgdown  Page down
-  q       Quit`,
+  /       Search titles and previews (enter keeps, esc clears)
+  v       View the full rendered entry (esc to go back)
+  y       Copy the selected entry's markdown to the clipboard
+  g       Go to a date (YYYY-MM-DD, or the nearest entry)
+  o       Toggle newest-first / oldest-first order
+  e       Edit the selected entry in your editor
+  ?       Show all keybindings
+  q       Quit
+
+Keys can be changed per action in the [keys] table of the config file:
+
+  [keys]
+  up = ["w", "up"]
+  down = ["s", "down"]
+
+Actions: up, down, page_up, page_down, home, end, toggle, read, copy,
+edit, search, jump, order, help, quit`,
 	RunE: runTimelineCommand,
 }
 
@@ -39,19 +59,51 @@ func runTimelineCommand(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
 
-	// Step 2: Create and initialize the TUI model
-	model := tui.NewModel(cfg.Directory, cfg.PreviewLines)
+	// Step 2: Apply configured keybindings, warning about clashes
+	keys, err := tui.DefaultKeyMap().WithOverrides(cfg.KeyBindings)
+	if err != nil {
+		return fmt.Errorf("invalid [keys] configuration: %w", err)
+	}
+	for _, conflict := range keys.Conflicts() {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: %s\n", conflict)
+	}
+
+	// Step 3: Ask for the passphrase before the TUI takes over the terminal
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Create and initialize the TUI model
+	style := cfg.Style
+	if colorDisabled() {
+		style = "notty"
+	}
+	model := tui.NewModel(cfg.Directory, cfg.PreviewLines).
+		WithEditor(editorFor(cfg, "")).
+		WithStyle(style).
+		WithCodeTheme(cfg.CodeTheme).
+		WithWikiLinks(cfg.WikiLinks).
+		WithLayout(v.Layout).
+		WithDateFormat(v.DateFormat).
+		WithPreviewFrom(tui.PreviewFrom(cfg.PreviewFrom)).
+		WithRenderedPreview(cfg.RenderedPreview).
+		WithPageSize(cfg.PageSize).
+		WithPassphrase(v.Passphrase).
+		WithFrontMatter(v.FrontMatter).
+		WithToday(cfg.Today().Format("2006-01-02")).
+		WithKeyMap(keys)
 
-	// Step 3: Start the Bubble Tea program
+	// Step 5: Start the Bubble Tea program
 	program := tea.NewProgram(model, tea.WithAltScreen())
 
-	// Step 4: Run the program and handle any errors
+	// Step 6: Run the program and handle any errors
 	finalModel, err := program.Run()
 	if err != nil {
 		return fmt.Errorf("failed to start timeline interface: %w", err)
 	}
 
-	// Step 5: Check if the program exited with an error
+	// Step 7: Check if the program exited with an error
 	if m, ok := finalModel.(tui.Model); ok && m.Error() != nil {
 		return fmt.Errorf("timeline error: %w", m.Error())
 	}
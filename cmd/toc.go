@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+)
+
+// tocCmd represents the toc command
+// Learn: Headings form a tree by level, so indenting by level draws an outline.
+var tocCmd = &cobra.Command{
+	Use:   "toc <YYYY-MM-DD>",
+	Short: "Show an outline of an entry's headings",
+	Long: `Prints the headings of an entry as an indented outline, one level of
+indentation per heading level, to find your way around long entries. Each
+heading is followed by its anchor, as used in links like [see](#anchor)
+and in exported HTML.
+
+Examples:
+  logmd toc 2024-01-15`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTocCommand,
+}
+
+// runTocCommand implements the core logic for the toc command.
+func runTocCommand(cmd *cobra.Command, args []string) error {
+	date := args[0]
+
+	// Step 1: Validate date format
+	if !isValidDateFormat(date) {
+		return invalidDateError(date)
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Open the vault
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Read the entry
+	content, err := v.ReadEntry(date)
+	if err != nil {
+		return fmt.Errorf("failed to read entry: %w", err)
+	}
+
+	// Step 5: Collect the headings; nothing is rendered, so the style doesn't matter
+	renderer, err := markdown.NewRenderer(markdown.Options{Style: "notty"})
+	if err != nil {
+		return fmt.Errorf("failed to create markdown renderer: %w", err)
+	}
+	entries, err := renderer.TableOfContents(content)
+	if err != nil {
+		return fmt.Errorf("failed to read headings: %w", err)
+	}
+
+	// Step 6: Print the outline
+	if len(entries) == 0 {
+		fmt.Println(noEntryStyle.Render(fmt.Sprintf("No headings in entry %s.", date)))
+		return nil
+	}
+	fmt.Print(formatTOC(entries))
+	return nil
+}
+
+// formatTOC renders entries as an outline indented two spaces per level
+// below the shallowest heading, so an entry without a "#" title still
+// starts at the margin.
+func formatTOC(entries []markdown.TOCEntry) string {
+	top := entries[0].Level
+	for _, entry := range entries {
+		top = min(top, entry.Level)
+	}
+
+	var b strings.Builder
+	for _, entry := range entries {
+		indent := strings.Repeat("  ", entry.Level-top)
+		fmt.Fprintf(&b, "%s- %s %s\n", indent, entry.Text, calendarLabelStyle.Render("#"+entry.ID))
+	}
+	return b.String()
+}
+
+func init() {
+	rootCmd.AddCommand(tocCmd)
+}
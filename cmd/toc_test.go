@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// TestFormatTOC tests indenting headings relative to the shallowest level.
+func TestFormatTOC(t *testing.T) {
+	originalProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.Ascii)
+	defer lipgloss.SetColorProfile(originalProfile)
+
+	got := formatTOC([]markdown.TOCEntry{
+		{Level: 2, Text: "Morning", ID: "morning"},
+		{Level: 3, Text: "Run", ID: "run"},
+		{Level: 4, Text: "Splits", ID: "splits"},
+		{Level: 2, Text: "Work", ID: "work"},
+	})
+	expected := "- Morning #morning\n  - Run #run\n    - Splits #splits\n- Work #work\n"
+	if got != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+// TestRunTocCommand tests printing an outline and reporting missing entries.
+func TestRunTocCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-toc-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-15", []byte("# Monday\n\n## Run\n\n## Work\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-16", []byte("No headings today.\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	for _, date := range []string{"2024-01-15", "2024-01-16"} {
+		if err := runTocCommand(nil, []string{date}); err != nil {
+			t.Errorf("runTocCommand(%s) failed: %v", date, err)
+		}
+	}
+
+	if err := runTocCommand(nil, []string{"2024-01-17"}); !errors.Is(err, vault.ErrEntryNotFound) {
+		t.Errorf("Expected ErrEntryNotFound for a missing entry, got %v", err)
+	}
+	if err := runTocCommand(nil, []string{"monday"}); err == nil || !strings.Contains(err.Error(), "invalid date") {
+		t.Errorf("Expected an invalid date error, got %v", err)
+	}
+}
@@ -1,16 +1,33 @@
 package cmd
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"logmd/config"
+	"logmd/markdown"
 	"logmd/vault"
 )
 
+// Flag values for the today command
+var (
+	// todayNoTemplate holds the --no-template flag value
+	todayNoTemplate bool
+	// todayEditor holds the --editor flag value (empty means use config)
+	todayEditor string
+	// todayStdin reads the entry from stdin instead of opening an editor
+	todayStdin bool
+	// todayAppend and todayForce decide what --stdin does to an existing entry
+	todayAppend bool
+	todayForce  bool
+)
+
 // todayCmd represents the today command
 // Learn: Each command in Cobra is a struct that defines its behavior and flags.
 // See: https://pkg.go.dev/github.com/spf13/cobra#Command
@@ -19,7 +36,32 @@ var todayCmd = &cobra.Command{
 	Short: "Open today's journal entry for editing",
 	Long: `Opens today's journal entry in your preferred editor. If the entry doesn't
 exist, it will be created with a simple template. The file is saved in the
-configured journal directory with the format YYYY-MM-DD.md.`,
+configured journal directory with the format YYYY-MM-DD.md.
+
+Today is the date in the configured timezone (local time by default). With
+day_start_hour set, the day rolls over at that hour instead of midnight, so
+with 4 an entry opened at 2am is still yesterday's.
+
+Use --no-template to start from a completely empty file instead.
+
+With word_goal set in the config, the entry's word count is shown against
+the goal once the editor closes.
+
+With --stdin the entry is written from piped input instead of opening an
+editor. If today's entry already exists, --append adds the input to the
+end and --force replaces the entry; one of them is required.
+
+  echo "shipped the release" | logmd today --stdin --append
+
+Editor precedence (highest to lowest):
+1. --editor flag
+2. LOGMD_EDITOR environment variable
+3. editor in the configuration file
+4. $EDITOR, then vim
+
+GUI editors such as code, subl and atom return as soon as their window
+opens unless told to wait; with editor_wait on (the default) logmd adds
+their wait flag, so the entry is saved before "saved" is printed.`,
 	RunE: runTodayCommand,
 }
 
@@ -27,6 +69,10 @@ configured journal directory with the format YYYY-MM-DD.md.`,
 // Learn: Separating command logic into functions makes testing easier.
 // See: https://go.dev/doc/effective_go#functions
 func runTodayCommand(cmd *cobra.Command, args []string) error {
+	if (todayAppend || todayForce) && !todayStdin {
+		return fmt.Errorf("--append and --force only apply with --stdin")
+	}
+
 	// Step 1: Load configuration
 	cfg, err := config.Load()
 	if err != nil {
@@ -34,19 +80,23 @@ func runTodayCommand(cmd *cobra.Command, args []string) error {
 	}
 
 	// Step 2: Create vault instance (handles directory creation)
-	v, err := vault.New(cfg.Directory)
+	v, err := createVault(cfg)
 	if err != nil {
-		return fmt.Errorf("failed to initialize journal directory: %w", err)
+		return err
 	}
 
 	// Step 3: Get today's date and check if entry exists
-	today := time.Now().Format("2006-01-02")
+	today := cfg.Today().Format("2006-01-02")
 	entryPath := v.TodayPath()
 
+	// Piped input replaces the editor entirely
+	if todayStdin {
+		return writeTodayFromStdin(cmd, cfg, v, today)
+	}
+
 	// Step 4: Create today's entry if it doesn't exist
 	if !v.TodayExists() {
-		err = v.CreateTodayEntry()
-		if err != nil {
+		if err := createEntry(v, today, todayNoTemplate); err != nil {
 			return fmt.Errorf("failed to create today's entry: %w", err)
 		}
 		fmt.Printf("Created new journal entry: %s\n", today)
@@ -54,22 +104,136 @@ func runTodayCommand(cmd *cobra.Command, args []string) error {
 		fmt.Printf("Opening existing journal entry: %s\n", today)
 	}
 
-	// Step 5: Launch editor
-	err = launchEditor(cfg.Editor, entryPath)
+	// Step 5: Launch editor (--editor overrides config when non-empty)
+	editor, editorArgs := editorFor(cfg, todayEditor)
+	err = editEntry(editor, editorArgs, v, today)
 	if err != nil {
 		return fmt.Errorf("failed to launch editor: %w", err)
 	}
 
+	// Step 6: Commit the entry if git_auto_commit is enabled
+	commitEntry(cfg, v, today)
+
 	fmt.Printf("Journal entry saved: %s\n", entryPath)
+
+	// Step 7: Report progress toward the daily word goal
+	if cfg.WordGoal > 0 {
+		content, err := v.ReadEntry(today)
+		if err != nil {
+			return fmt.Errorf("failed to read today's entry: %w", err)
+		}
+		fmt.Println(wordGoalProgress(markdown.WordCount(content), cfg.WordGoal))
+	}
+	return nil
+}
+
+// wordGoalProgress describes how far words is toward goal, e.g.
+// "✍️  420/500 words, 84%", celebrating once the goal is met.
+func wordGoalProgress(words, goal int) string {
+	percent := words * 100 / goal
+	if words >= goal {
+		return fmt.Sprintf("🎉 Word goal reached: %d/%d words, %d%%", words, goal, percent)
+	}
+	return fmt.Sprintf("✍️  %d/%d words, %d%%", words, goal, percent)
+}
+
+// writeTodayFromStdin writes piped input into today's entry, creating it if
+// needed. An existing entry is only changed with --append or --force.
+// Learn: io.ReadAll reads until EOF, which is when the writing end of a pipe closes.
+// See: https://pkg.go.dev/io#ReadAll
+func writeTodayFromStdin(cmd *cobra.Command, cfg *config.Config, v *vault.Vault, today string) error {
+	if todayAppend && todayForce {
+		return fmt.Errorf("--append and --force cannot be used together")
+	}
+
+	content, err := io.ReadAll(cmd.InOrStdin())
+	if err != nil {
+		return fmt.Errorf("failed to read stdin: %w", err)
+	}
+	if len(bytes.TrimSpace(content)) == 0 {
+		return fmt.Errorf("no content on stdin")
+	}
+
+	switch {
+	case !v.EntryExists(today) || todayForce:
+		err = v.WriteEntry(today, content)
+	case todayAppend:
+		err = v.AppendToEntry(today, content)
+	default:
+		return fmt.Errorf("entry %s already exists (use --append or --force)", today)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write today's entry: %w", err)
+	}
+	commitEntry(cfg, v, today)
+
+	fmt.Printf("Journal entry saved: %s\n", v.TodayPath())
 	return nil
 }
 
-// launchEditor spawns the specified editor with the given file path.
+// editorQuickReturn is how soon an editor that exits without saving is
+// taken to have handed the file to a window rather than waited for it.
+const editorQuickReturn = time.Second
+
+// editorFor returns the editor and arguments to open entries with. A
+// non-empty override replaces the configured editor along with its
+// arguments, which were written for it. With editor_wait set, GUI editors
+// known to return early also get their wait flag.
+func editorFor(cfg *config.Config, override string) (string, string) {
+	editor, args := cfg.Editor, cfg.EditorArgs
+	if strings.TrimSpace(override) != "" {
+		editor, args = override, ""
+	}
+	if cfg.EditorWait {
+		args = config.WithWaitFlag(editor, args)
+	}
+	return editor, args
+}
+
+// createEntry creates the entry for date from the template, or as an empty
+// file when blank is set by --no-template. It fails if the entry exists.
+func createEntry(v *vault.Vault, date string, blank bool) error {
+	if blank {
+		return v.CreateBlankEntry(date)
+	}
+	return v.CreateEntry(date)
+}
+
+// returnedEarly reports whether an editor that ran for elapsed looks like
+// it returned without waiting for the file to be edited: it exited within
+// editorQuickReturn and the file's modification time didn't change.
+func returnedEarly(elapsed time.Duration, before, after time.Time) bool {
+	return elapsed < editorQuickReturn && after.Equal(before)
+}
+
+// editEntry opens the entry for date in editor with editorArgs. Encrypted
+// vaults hand the editor a private decrypted copy that is encrypted back
+// once it exits.
+func editEntry(editor, editorArgs string, v *vault.Vault, date string) error {
+	path, err := v.CheckoutEntry(date)
+	if err != nil {
+		return err
+	}
+
+	if err := launchEditor(editor, editorArgs, path); err != nil {
+		// Check in anyway: a plain entry would keep whatever the editor saved
+		v.CheckinEntry(date, path)
+		return err
+	}
+	return v.CheckinEntry(date, path)
+}
+
+// launchEditor spawns the specified editor with the given file path, placed
+// into args as config.EditorCommand describes.
 // Learn: os/exec package is used to run external programs from Go.
 // See: https://pkg.go.dev/os/exec#Cmd
-func launchEditor(editor, filePath string) error {
+func launchEditor(editor, args, filePath string) error {
 	// Create command to launch editor
-	cmd := exec.Command(editor, filePath)
+	argv, err := config.EditorCommand(editor, args, filePath)
+	if err != nil {
+		return err
+	}
+	cmd := exec.Command(argv[0], argv[1:]...)
 
 	// Connect stdin, stdout, stderr to allow interactive editing
 	// Learn: This allows the editor to interact with the user normally.
@@ -79,7 +243,12 @@ func launchEditor(editor, filePath string) error {
 	cmd.Stderr = os.Stderr
 
 	// Run the command and wait for it to complete
-	err := cmd.Run()
+	var modified time.Time
+	if info, err := os.Stat(filePath); err == nil {
+		modified = info.ModTime()
+	}
+	start := time.Now()
+	err = cmd.Run()
 	if err != nil {
 		// Check if it's an exit status error (editor exited non-zero)
 		if exitError, ok := err.(*exec.ExitError); ok {
@@ -89,11 +258,22 @@ func launchEditor(editor, filePath string) error {
 		return fmt.Errorf("failed to run editor '%s': %w", editor, err)
 	}
 
+	// A GUI editor that doesn't wait leaves the entry open after returning
+	if info, err := os.Stat(filePath); err == nil && returnedEarly(time.Since(start), modified, info.ModTime()) {
+		fmt.Fprintf(os.Stderr, "⚠️  %s returned right away without saving. If it opens a window, pass its\n", editor)
+		fmt.Fprintln(os.Stderr, "   wait flag, e.g. logmd config set editor_args '--wait'")
+	}
+
 	return nil
 }
 
 func init() {
 	// Learn: init() functions run automatically when the package is imported.
 	// This is how Cobra commands are typically registered.
+	todayCmd.Flags().BoolVar(&todayNoTemplate, "no-template", false, "create an empty entry without the dated heading")
+	todayCmd.Flags().StringVar(&todayEditor, "editor", "", "editor to open the entry with (overrides config and LOGMD_EDITOR)")
+	todayCmd.Flags().BoolVar(&todayStdin, "stdin", false, "write the entry from stdin instead of opening an editor")
+	todayCmd.Flags().BoolVar(&todayAppend, "append", false, "with --stdin, append to an existing entry")
+	todayCmd.Flags().BoolVar(&todayForce, "force", false, "with --stdin, overwrite an existing entry")
 	rootCmd.AddCommand(todayCmd)
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"logmd/config"
 	"logmd/vault"
 )
 
@@ -89,6 +90,156 @@ func TestRunTodayCommand(t *testing.T) {
 			t.Error("Today's entry should still exist")
 		}
 	})
+
+	// Test reporting the word goal once the editor closes
+	t.Run("WordGoal", func(t *testing.T) {
+		defer os.Unsetenv("LOGMD_WORD_GOAL")
+		os.Setenv("LOGMD_WORD_GOAL", "500")
+
+		if err := runTodayCommand(nil, []string{}); err != nil {
+			t.Fatalf("runTodayCommand() with a word goal failed: %v", err)
+		}
+	})
+
+	// Test dating the entry in the configured timezone; these two are 26
+	// hours apart, so they never share a date
+	t.Run("Timezone", func(t *testing.T) {
+		defer os.Unsetenv("LOGMD_TIMEZONE")
+
+		v, err := vault.New(tmpDir)
+		if err != nil {
+			t.Fatalf("Failed to create vault: %v", err)
+		}
+		for _, name := range []string{"Pacific/Kiritimati", "Etc/GMT+12"} {
+			os.Setenv("LOGMD_TIMEZONE", name)
+			if err := runTodayCommand(nil, []string{}); err != nil {
+				t.Fatalf("runTodayCommand() in %s failed: %v", name, err)
+			}
+
+			loc, err := time.LoadLocation(name)
+			if err != nil {
+				t.Fatalf("Failed to load timezone %s: %v", name, err)
+			}
+			if date := time.Now().In(loc).Format("2006-01-02"); !v.EntryExists(date) {
+				t.Errorf("Expected an entry for %s, today in %s", date, name)
+			}
+		}
+
+		os.Setenv("LOGMD_TIMEZONE", "Nowhere/Special")
+		if err := runTodayCommand(nil, []string{}); err == nil || !strings.Contains(err.Error(), "invalid timezone") {
+			t.Errorf("Expected an invalid timezone error, got %v", err)
+		}
+	})
+}
+
+// TestWordGoalProgress tests the word goal progress message.
+func TestWordGoalProgress(t *testing.T) {
+	testCases := []struct {
+		words    int
+		goal     int
+		expected string
+	}{
+		{0, 500, "✍️  0/500 words, 0%"},
+		{420, 500, "✍️  420/500 words, 84%"},
+		{500, 500, "🎉 Word goal reached: 500/500 words, 100%"},
+		{750, 500, "🎉 Word goal reached: 750/500 words, 150%"},
+	}
+
+	for _, tc := range testCases {
+		if got := wordGoalProgress(tc.words, tc.goal); got != tc.expected {
+			t.Errorf("wordGoalProgress(%d, %d) = %q, expected %q", tc.words, tc.goal, got, tc.expected)
+		}
+	}
+}
+
+// TestRunTodayCommandNoTemplate tests that --no-template creates an empty entry.
+func TestRunTodayCommandNoTemplate(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-today-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalEditor := os.Getenv("LOGMD_EDITOR")
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalEditor != "" {
+			os.Setenv("LOGMD_EDITOR", originalEditor)
+		} else {
+			os.Unsetenv("LOGMD_EDITOR")
+		}
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		todayNoTemplate = false
+	}()
+
+	os.Setenv("LOGMD_EDITOR", "true")
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+	todayNoTemplate = true
+
+	if err := runTodayCommand(nil, []string{}); err != nil {
+		t.Fatalf("runTodayCommand() failed: %v", err)
+	}
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+
+	content, err := v.ReadEntry(time.Now().Format("2006-01-02"))
+	if err != nil {
+		t.Fatalf("Failed to read created entry: %v", err)
+	}
+
+	if len(content) != 0 {
+		t.Errorf("Expected empty entry, got %q", string(content))
+	}
+}
+
+// TestRunTodayCommandEditorFlag tests that --editor overrides the configured editor.
+func TestRunTodayCommandEditorFlag(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-today-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalEditor := os.Getenv("LOGMD_EDITOR")
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalEditor != "" {
+			os.Setenv("LOGMD_EDITOR", originalEditor)
+		} else {
+			os.Unsetenv("LOGMD_EDITOR")
+		}
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		todayEditor = ""
+	}()
+
+	// Configured editor would fail, so success means the flag took precedence
+	os.Setenv("LOGMD_EDITOR", "nonexistent-editor-command")
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	todayEditor = "true"
+	if err := runTodayCommand(nil, []string{}); err != nil {
+		t.Fatalf("runTodayCommand() with --editor failed: %v", err)
+	}
+
+	// A blank flag value must not replace the configured editor
+	todayEditor = "  "
+	err = runTodayCommand(nil, []string{})
+	if err == nil || !strings.Contains(err.Error(), "nonexistent-editor-command") {
+		t.Errorf("Expected configured editor to be used for blank flag, got: %v", err)
+	}
 }
 
 // TestRunTodayCommandWithInvalidEditor tests error handling with bad editor.
@@ -187,6 +338,7 @@ func TestLaunchEditor(t *testing.T) {
 	testCases := []struct {
 		name        string
 		editor      string
+		args        string
 		expectError bool
 		errorMsg    string
 	}{
@@ -201,6 +353,26 @@ func TestLaunchEditor(t *testing.T) {
 			expectError: true,
 			errorMsg:    "editor exited with status",
 		},
+		{
+			name:        "ArgsWithFilePlaceholder",
+			editor:      "sh",
+			args:        `-c 'test "$1" = --goto && test -f "$2"' sh --goto {file}`,
+			expectError: false,
+		},
+		{
+			name:        "ArgsMissingFile",
+			editor:      "sh",
+			args:        `-c 'test -f "$0"' /nonexistent/{file}`,
+			expectError: true,
+			errorMsg:    "editor exited with status",
+		},
+		{
+			name:        "UnterminatedQuoteInArgs",
+			editor:      "true",
+			args:        `--goto "{file}`,
+			expectError: true,
+			errorMsg:    "invalid editor_args",
+		},
 		{
 			name:        "NonexistentEditor",
 			editor:      "nonexistent-editor-command-12345",
@@ -211,7 +383,7 @@ func TestLaunchEditor(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			err := launchEditor(tc.editor, tmpFile.Name())
+			err := launchEditor(tc.editor, tc.args, tmpFile.Name())
 
 			if tc.expectError {
 				if err == nil {
@@ -228,6 +400,49 @@ func TestLaunchEditor(t *testing.T) {
 	}
 }
 
+// TestEditorFor tests resolving the editor, its arguments and wait flag.
+func TestEditorFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        config.Config
+		override   string
+		editor     string
+		editorArgs string
+	}{
+		{"TerminalEditor", config.Config{Editor: "vim", EditorArgs: "+3", EditorWait: true}, "", "vim", "+3"},
+		{"GUIEditorWaits", config.Config{Editor: "code", EditorArgs: "--goto {file}:3", EditorWait: true}, "", "code", "--wait --goto {file}:3"},
+		{"AlreadyWaiting", config.Config{Editor: "subl", EditorArgs: "-w", EditorWait: true}, "", "subl", "-w"},
+		{"WaitDisabled", config.Config{Editor: "code", EditorWait: false}, "", "code", ""},
+		{"OverrideDropsArgs", config.Config{Editor: "vim", EditorArgs: "+3", EditorWait: true}, "code", "code", "--wait"},
+		{"BlankOverride", config.Config{Editor: "nano", EditorWait: true}, "  ", "nano", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			editor, editorArgs := editorFor(&tc.cfg, tc.override)
+			if editor != tc.editor || editorArgs != tc.editorArgs {
+				t.Errorf("editorFor() = %q, %q, expected %q, %q", editor, editorArgs, tc.editor, tc.editorArgs)
+			}
+		})
+	}
+}
+
+// TestReturnedEarly tests spotting an editor that didn't wait for the file.
+func TestReturnedEarly(t *testing.T) {
+	saved := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	later := saved.Add(time.Minute)
+
+	if !returnedEarly(50*time.Millisecond, saved, saved) {
+		t.Error("Expected an instant return without saving to count as early")
+	}
+	if returnedEarly(50*time.Millisecond, saved, later) {
+		t.Error("Expected a quick save not to count as early")
+	}
+	if returnedEarly(5*time.Minute, saved, saved) {
+		t.Error("Expected a long session without saving not to count as early")
+	}
+}
+
 // TestTodayCommandIntegration tests the full command integration including config loading.
 func TestTodayCommandIntegration(t *testing.T) {
 	// Create temporary directory for testing
@@ -295,3 +510,133 @@ preview_lines = 5`
 		t.Errorf("Expected content %q, got %q", expectedContent, string(content))
 	}
 }
+
+// TestRunTodayCommandStdin tests writing today's entry from piped input.
+func TestRunTodayCommandStdin(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-today-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalEditor := os.Getenv("LOGMD_EDITOR")
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalEditor != "" {
+			os.Setenv("LOGMD_EDITOR", originalEditor)
+		} else {
+			os.Unsetenv("LOGMD_EDITOR")
+		}
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		todayStdin, todayAppend, todayForce = false, false, false
+		todayCmd.SetIn(nil)
+	}()
+
+	// An editor that fails proves --stdin never launches it
+	os.Setenv("LOGMD_EDITOR", "false")
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	today := time.Now().Format("2006-01-02")
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+
+	run := func(input string) error {
+		todayCmd.SetIn(strings.NewReader(input))
+		return runTodayCommand(todayCmd, []string{})
+	}
+	expectContent := func(expected string) {
+		t.Helper()
+		content, err := v.ReadEntry(today)
+		if err != nil || string(content) != expected {
+			t.Errorf("Expected content %q, got %q (%v)", expected, content, err)
+		}
+	}
+
+	todayAppend = true
+	if err := run("text"); err == nil {
+		t.Error("Expected error for --append without --stdin")
+	}
+	todayAppend = false
+
+	todayStdin = true
+	if err := run("  \n"); err == nil {
+		t.Error("Expected error for empty input")
+	}
+
+	// A missing entry is created from the input as is
+	if err := run("first\n"); err != nil {
+		t.Fatalf("runTodayCommand() --stdin failed: %v", err)
+	}
+	expectContent("first\n")
+
+	// An existing entry needs --append or --force
+	if err := run("second\n"); err == nil {
+		t.Error("Expected error for an existing entry without --append or --force")
+	}
+	expectContent("first\n")
+
+	todayAppend = true
+	if err := run("second"); err != nil {
+		t.Fatalf("runTodayCommand() --append failed: %v", err)
+	}
+	expectContent("first\nsecond\n")
+
+	todayForce = true
+	if err := run("third\n"); err == nil {
+		t.Error("Expected error for --append with --force")
+	}
+
+	todayAppend = false
+	if err := run("third\n"); err != nil {
+		t.Fatalf("runTodayCommand() --force failed: %v", err)
+	}
+	expectContent("third\n")
+}
+
+// TestEditEntryEncrypted tests editing an encrypted entry through a temp file.
+func TestEditEntryEncrypted(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-encrypt-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// The "editor" appends a line to whatever file it is given
+	editor := filepath.Join(tmpDir, "editor.sh")
+	if err := os.WriteFile(editor, []byte("#!/bin/sh\necho edited >> \"$1\"\n"), 0755); err != nil {
+		t.Fatalf("Failed to write editor script: %v", err)
+	}
+
+	v, err := vault.New(filepath.Join(tmpDir, "journal"))
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	v.Passphrase = "correct horse"
+	if err := v.CreateEntry("2024-01-15"); err != nil {
+		t.Fatalf("Failed to create entry: %v", err)
+	}
+
+	if err := editEntry(editor, "", v, "2024-01-15"); err != nil {
+		t.Fatalf("editEntry() failed: %v", err)
+	}
+
+	content, err := v.ReadEntry("2024-01-15")
+	if err != nil {
+		t.Fatalf("ReadEntry() failed: %v", err)
+	}
+	if string(content) != "# 2024-01-15\n\nedited\n" {
+		t.Errorf("Expected edited content, got %q", content)
+	}
+
+	raw, err := os.ReadFile(v.DatePath("2024-01-15"))
+	if err != nil || !vault.IsEncrypted(raw) {
+		t.Errorf("Expected the edited entry to stay encrypted, got %q", raw)
+	}
+}
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/prompt"
+	"logmd/vault"
+)
+
+// trashCmd represents the trash command
+var trashCmd = &cobra.Command{
+	Use:   "trash",
+	Short: "List, restore or empty deleted entries",
+	Long: `Entries removed with 'logmd delete' are moved into .trash/ in the journal
+directory, with the time they were deleted in their filename, as long as
+use_trash is enabled (the default). Trashed entries don't appear in the
+timeline, lists or searches.
+
+Use 'logmd trash list' to see what is in the trash,
+'logmd trash restore <date>' to put the latest deleted copy of an entry
+back, and 'logmd trash empty' to remove the trashed files for good.`,
+	Args: cobra.NoArgs,
+	RunE: runTrashListCommand,
+}
+
+// trashListCmd represents the trash list command
+var trashListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List deleted entries, most recently deleted first",
+	Args:  cobra.NoArgs,
+	RunE:  runTrashListCommand,
+}
+
+// trashRestoreCmd represents the trash restore command
+var trashRestoreCmd = &cobra.Command{
+	Use:   "restore <YYYY-MM-DD>",
+	Short: "Restore the most recently deleted copy of an entry",
+	Long: `Moves the most recently deleted copy of the entry for a date out of the
+trash and back into the journal. An entry written for that date since it
+was deleted is never overwritten; move or delete it first.
+
+Examples:
+  logmd trash restore 2024-01-15`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTrashRestoreCommand,
+}
+
+// trashEmptyCmd represents the trash empty command
+var trashEmptyCmd = &cobra.Command{
+	Use:   "empty",
+	Short: "Permanently remove every deleted entry",
+	Long: `Permanently removes every entry in the trash, after confirming unless
+--yes is given. Add --dry-run to print the files it would remove instead.`,
+	Args: cobra.NoArgs,
+	RunE: runTrashEmptyCommand,
+}
+
+// openTrashVault loads configuration and opens the vault for the trash
+// subcommands, which move files without reading them.
+func openTrashVault() (*vault.Vault, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+	return newVault(cfg)
+}
+
+// runTrashListCommand implements the core logic for the trash list command.
+func runTrashListCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Open the vault
+	v, err := openTrashVault()
+	if err != nil {
+		return err
+	}
+
+	// Step 2: Read the trash
+	items, err := v.Trash()
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		fmt.Println(noEntryStyle.Render("The trash is empty."))
+		return nil
+	}
+
+	// Step 3: List the trashed entries
+	for _, item := range items {
+		fmt.Println(formatTrashItem(item))
+	}
+	return nil
+}
+
+// formatTrashItem describes a trashed entry as its date, when it was
+// deleted and its size.
+func formatTrashItem(item vault.TrashItem) string {
+	return fmt.Sprintf("%s  %s  %s", item.Date,
+		calendarLabelStyle.Render("deleted "+item.DeletedAt.Format("2006-01-02 15:04")),
+		formatSize(item.Size))
+}
+
+// runTrashRestoreCommand implements the core logic for the trash restore command.
+func runTrashRestoreCommand(cmd *cobra.Command, args []string) error {
+	date := args[0]
+
+	// Step 1: Validate date format
+	if !isValidDateFormat(date) {
+		return invalidDateError(date)
+	}
+
+	// Step 2: Open the vault
+	v, err := openTrashVault()
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Move the entry back
+	item, err := v.RestoreEntry(date)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("♻️  Restored %s (deleted %s)\n", date, item.DeletedAt.Format("2006-01-02 15:04"))
+	return nil
+}
+
+// runTrashEmptyCommand implements the core logic for the trash empty command.
+func runTrashEmptyCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Open the vault
+	v, err := openTrashVault()
+	if err != nil {
+		return err
+	}
+
+	// Step 2: Confirm, since emptied entries can't be restored, or print
+	// the plan when dry running
+	items, err := v.Trash()
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		fmt.Println(noEntryStyle.Render("The trash is empty."))
+		return nil
+	}
+	if dryRun {
+		plan, err := v.PlanEmptyTrash()
+		if err != nil {
+			return err
+		}
+		printDryRun(plan)
+		return nil
+	}
+	ok, err := prompt.Confirm(os.Stdin, os.Stderr, fmt.Sprintf("Permanently remove %s from the trash?", pluralEntries(len(items))))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println("Cancelled; the trash was kept.")
+		return nil
+	}
+
+	// Step 3: Remove the trashed files
+	removed, err := v.EmptyTrash()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("🗑️  Permanently removed %s from the trash\n", pluralEntries(removed))
+	return nil
+}
+
+// pluralEntries formats an entry count with the correct noun.
+func pluralEntries(n int) string {
+	if n == 1 {
+		return "1 entry"
+	}
+	return fmt.Sprintf("%d entries", n)
+}
+
+func init() {
+	trashCmd.AddCommand(trashListCmd)
+	trashCmd.AddCommand(trashRestoreCmd)
+	trashCmd.AddCommand(trashEmptyCmd)
+	rootCmd.AddCommand(trashCmd)
+}
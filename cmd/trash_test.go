@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"logmd/prompt"
+	"logmd/vault"
+)
+
+// TestFormatTrashItem tests describing a trashed entry.
+func TestFormatTrashItem(t *testing.T) {
+	originalProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.Ascii)
+	defer lipgloss.SetColorProfile(originalProfile)
+
+	item := vault.TrashItem{
+		Date:      "2024-01-15",
+		DeletedAt: time.Date(2024, 1, 16, 9, 30, 0, 0, time.UTC),
+		Size:      2048,
+	}
+	expected := "2024-01-15  deleted 2024-01-16 09:30  2.0 KB"
+	if got := formatTrashItem(item); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+// TestTrashCommands tests deleting an entry, listing the trash, restoring
+// the entry and emptying the trash.
+func TestTrashCommands(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-trash-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	originalTrash, hadTrash := os.LookupEnv("LOGMD_USE_TRASH")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		if hadTrash {
+			os.Setenv("LOGMD_USE_TRASH", originalTrash)
+		} else {
+			os.Unsetenv("LOGMD_USE_TRASH")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+	os.Unsetenv("LOGMD_USE_TRASH")
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	for _, date := range []string{"2024-01-15", "2024-01-16"} {
+		if err := v.WriteEntry(date, []byte("# "+date+"\n")); err != nil {
+			t.Fatalf("Failed to write entry: %v", err)
+		}
+	}
+
+	if err := runTrashListCommand(nil, []string{}); err != nil {
+		t.Errorf("runTrashListCommand() on an empty trash failed: %v", err)
+	}
+
+	if err := runDeleteCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Fatalf("runDeleteCommand() failed: %v", err)
+	}
+	if v.EntryExists("2024-01-15") {
+		t.Error("Expected the entry to be deleted")
+	}
+	if err := runTrashListCommand(nil, []string{}); err != nil {
+		t.Errorf("runTrashListCommand() failed: %v", err)
+	}
+
+	if err := runTrashRestoreCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Fatalf("runTrashRestoreCommand() failed: %v", err)
+	}
+	if !v.EntryExists("2024-01-15") {
+		t.Error("Expected the entry to be restored")
+	}
+	if err := runTrashRestoreCommand(nil, []string{"2024-01-15"}); err == nil {
+		t.Error("Expected an error restoring an entry that isn't trashed")
+	}
+	if err := runTrashRestoreCommand(nil, []string{"15/01/2024"}); err == nil || !strings.Contains(err.Error(), "invalid date") {
+		t.Errorf("Expected an invalid date error, got %v", err)
+	}
+
+	if err := runDeleteCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Fatalf("runDeleteCommand() failed: %v", err)
+	}
+
+	// Emptying asks first, and keeps the trash when the answer is no
+	withStdin(t, "n\n")
+	if err := runTrashEmptyCommand(nil, []string{}); err != nil {
+		t.Fatalf("runTrashEmptyCommand() failed: %v", err)
+	}
+	if items, _ := v.Trash(); len(items) != 1 {
+		t.Errorf("Expected the trash kept after declining, got %+v", items)
+	}
+	withStdin(t, "yes\n")
+	if err := runTrashEmptyCommand(nil, []string{}); err != nil {
+		t.Fatalf("runTrashEmptyCommand() failed: %v", err)
+	}
+	if items, _ := v.Trash(); len(items) != 0 {
+		t.Errorf("Expected an empty trash, got %+v", items)
+	}
+
+	// Without the trash the file is removed outright, once confirmed
+	os.Setenv("LOGMD_USE_TRASH", "false")
+	withStdin(t, "")
+	if err := runDeleteCommand(nil, []string{"2024-01-16"}); err == nil || !strings.Contains(err.Error(), "--yes") {
+		t.Errorf("Expected an error suggesting --yes without an answer, got %v", err)
+	}
+	if !v.EntryExists("2024-01-16") {
+		t.Fatal("Expected the entry kept without confirmation")
+	}
+	prompt.AssumeYes = true
+	defer func() { prompt.AssumeYes = false }()
+	if err := runDeleteCommand(nil, []string{"2024-01-16"}); err != nil {
+		t.Fatalf("runDeleteCommand() failed: %v", err)
+	}
+	if items, _ := v.Trash(); v.EntryExists("2024-01-16") || len(items) != 0 {
+		t.Error("Expected the entry removed without going to the trash")
+	}
+	if err := runDeleteCommand(nil, []string{"2024-01-16"}); !errors.Is(err, vault.ErrEntryNotFound) {
+		t.Errorf("Expected ErrEntryNotFound, got %v", err)
+	}
+}
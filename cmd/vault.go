@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"logmd/config"
+	"logmd/vault"
+)
+
+// openVault opens the configured vault, asking for the passphrase when
+// encrypt is enabled. Commands that read entry content use it; ones that
+// only look at dates and file sizes use newVault and never need the
+// passphrase. Both fail when the journal directory doesn't exist.
+func openVault(cfg *config.Config) (*vault.Vault, error) {
+	v, err := newVault(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.UnlockVault(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// createVault is openVault for commands that write entries, creating the
+// journal directory when it doesn't exist yet.
+func createVault(cfg *config.Config) (*vault.Vault, error) {
+	v, err := cfg.OpenVault(true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.UnlockVault(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// newVault opens the configured vault without asking for a passphrase. A
+// missing directory is an error rather than a new, empty journal.
+func newVault(cfg *config.Config) (*vault.Vault, error) {
+	v, err := cfg.OpenVault(false)
+	if errors.Is(err, vault.ErrNoDirectory) {
+		return nil, fmt.Errorf("%w (check the directory setting, or run 'logmd today' to start a journal there)", err)
+	}
+	return v, err
+}
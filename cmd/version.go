@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Build information, set at build time by the Makefile.
+// Learn: -ldflags "-X importpath.name=value" overwrites a string variable when linking.
+// See: https://pkg.go.dev/cmd/link
+var (
+	// Version is the release version, or "dev" for local builds
+	Version = "dev"
+	// Commit is the git commit the binary was built from
+	Commit = ""
+	// Date is when the binary was built (RFC 3339, UTC)
+	Date = ""
+)
+
+// versionCmd represents the version command
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print the logmd version and build information",
+	Long: `Prints the version of logmd along with the git commit and build date
+when they were recorded at build time. Please include this when reporting
+a bug.
+
+The same information is available with 'logmd --version'.`,
+	Args: cobra.NoArgs,
+	RunE: runVersionCommand,
+}
+
+// runVersionCommand prints the build information.
+func runVersionCommand(cmd *cobra.Command, args []string) error {
+	fmt.Fprintf(cmd.OutOrStdout(), "logmd %s\n", versionString())
+	return nil
+}
+
+// versionString describes the build, e.g. "1.2.0 (commit abc1234, built
+// 2024-01-15T10:00:00Z)". Builds without ldflags report just "dev".
+func versionString() string {
+	var details []string
+	if Commit != "" {
+		details = append(details, "commit "+Commit)
+	}
+	if Date != "" {
+		details = append(details, "built "+Date)
+	}
+
+	if len(details) == 0 {
+		return Version
+	}
+	return fmt.Sprintf("%s (%s)", Version, strings.Join(details, ", "))
+}
+
+func init() {
+	// Setting Version makes cobra add a --version flag to the root command
+	rootCmd.Version = versionString()
+	rootCmd.SetVersionTemplate("logmd {{.Version}}\n")
+	rootCmd.AddCommand(versionCmd)
+}
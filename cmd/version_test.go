@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestVersionString tests the version text with and without build info.
+func TestVersionString(t *testing.T) {
+	originalVersion, originalCommit, originalDate := Version, Commit, Date
+	defer func() { Version, Commit, Date = originalVersion, originalCommit, originalDate }()
+
+	Version, Commit, Date = "dev", "", ""
+	if got := versionString(); got != "dev" {
+		t.Errorf("Expected \"dev\" without build info, got %q", got)
+	}
+
+	Version, Commit, Date = "1.2.0", "abc1234", "2024-01-15T10:00:00Z"
+	if got, want := versionString(), "1.2.0 (commit abc1234, built 2024-01-15T10:00:00Z)"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+
+	var out bytes.Buffer
+	versionCmd.SetOut(&out)
+	defer versionCmd.SetOut(nil)
+	if err := runVersionCommand(versionCmd, nil); err != nil {
+		t.Fatalf("runVersionCommand() failed: %v", err)
+	}
+	if got := out.String(); got != "logmd 1.2.0 (commit abc1234, built 2024-01-15T10:00:00Z)\n" {
+		t.Errorf("Unexpected version output %q", got)
+	}
+}
+
+// TestRootVersionFlag tests that --version is available on the root command.
+func TestRootVersionFlag(t *testing.T) {
+	if rootCmd.Version == "" {
+		t.Fatal("Expected rootCmd.Version to be set so cobra adds --version")
+	}
+
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetArgs([]string{"--version"})
+	defer func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetArgs(nil)
+	}()
+
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("logmd --version failed: %v", err)
+	}
+	if got := out.String(); got != "logmd "+rootCmd.Version+"\n" {
+		t.Errorf("Unexpected --version output %q", got)
+	}
+}
@@ -1,16 +1,55 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"logmd/clipboard"
 	"logmd/config"
 	"logmd/markdown"
 	"logmd/vault"
 )
 
+// Flag values for the view command
+var (
+	// viewWidth holds the --width flag value (0 means detect from the terminal)
+	viewWidth int
+	// viewRaw prints the markdown source instead of rendering it
+	viewRaw bool
+	// viewCopy copies the entry's markdown source to the system clipboard
+	viewCopy bool
+	// viewCopyRendered copies the output as displayed, without colors, instead
+	viewCopyRendered bool
+	// viewStrip prints syntax-free plain text instead of rendering
+	viewStrip bool
+	// viewAtWidth is a comma-separated list of widths to render side by side
+	viewAtWidth string
+	// viewCountMatches is a term whose occurrences are counted instead of rendering
+	viewCountMatches string
+	// viewWholeWord restricts --count-matches to whole-word occurrences
+	viewWholeWord bool
+	// viewCountAll tallies --count-matches across every entry in the vault
+	viewCountAll bool
+	// viewPlain renders without colors even when stdout is a terminal
+	viewPlain bool
+	// viewStrict rejects mistyped dates instead of normalizing them or
+	// suggesting the closest entries
+	viewStrict bool
+	// viewLineNumbers prefixes output with source line numbers
+	viewLineNumbers bool
+	// viewNoPager prints long rendered output directly instead of paging it
+	viewNoPager bool
+)
+
 // viewCmd represents the view command
 // Learn: Commands can accept positional arguments via the Args field or RunE function parameters.
 // See: https://pkg.go.dev/github.com/spf13/cobra#PositionalArgs
@@ -18,30 +57,78 @@ var viewCmd = &cobra.Command{
 	Use:   "view <YYYY-MM-DD>",
 	Short: "Display a journal entry with formatted markdown",
 	Long: `Renders and displays a specific journal entry using glamour for
-beautiful markdown formatting. The date must match exactly the format
-used for journal files (YYYY-MM-DD).
+beautiful markdown formatting. The date uses the YYYY-MM-DD format.
 
 Examples:
   logmd view 2024-01-15
   logmd view 2025-06-30
+  logmd view 2025/6/30
+  logmd view 2025-06-30 --width 120
+  logmd view 2025-06-30 --plain
+  logmd view 2025-06-30 --no-pager
+  logmd view 2025-06-30 --copy
+  logmd view 2025-06-30 --copy-rendered
+  logmd view 2025-06-30 --strip
+  logmd view 2025-06-30 --line-numbers
+  logmd view 2025-06-30 --at-width 40,80,120
+  logmd view 2025-06-30 --count-matches deadline
+  logmd view --count-matches deadline --whole-word --all
 
 The entry will be displayed with:
 - Colored headings and text formatting
 - Syntax-highlighted code blocks  
 - Properly rendered tables and lists
-- Beautiful terminal styling`,
-	Args: cobra.ExactArgs(1),
+- Beautiful terminal styling
+
+Output wraps at the terminal width, or 80 columns when piped. Piped output
+is rendered without colors so it reads cleanly in files and simple pagers;
+use --plain to get the same uncolored output in a terminal. In a terminal,
+an entry taller than the window opens in $PAGER (less -R by default, which
+keeps colors); use --no-pager to print it directly. Use --raw to
+print the markdown source, and --copy to also copy the source to the
+clipboard; --copy-rendered copies the output as shown, without colors,
+instead. Use --strip
+to print plain prose with all markdown syntax and front matter removed.
+Use --at-width to render the entry once per listed width, separated by
+labeled dividers, to compare layouts across terminal sizes.
+
+Dates typed with other separators or without zero padding, such as
+2024/1/15 or 20240115, are read as 2024-01-15. When there is no entry for
+the date, or it still isn't valid, the five closest existing entries are
+listed, and in a terminal you can pick one to view. Use --strict to turn
+this off and fail on anything but an exact date.
+
+Use --line-numbers to show where text sits in the source file. With --raw
+every line is numbered. Rendered output is reflowed, so there the gutter
+shows the source line each paragraph, heading, list or code block starts
+on, and the lines it wraps onto are left unnumbered. Numbers count front
+matter, so they match the file on disk.
+
+Use --count-matches to print how often a term appears in the entry's plain
+text (case-insensitive). Add --whole-word to skip partial-word matches, or
+--all (without a date) for a per-date breakdown and total across the vault.`,
+	Args: viewArgs,
 	RunE: runViewCommand,
 }
 
 // runViewCommand implements the core logic for the view command.
 // Learn: Separating command logic into functions makes testing and maintenance easier.
 func runViewCommand(cmd *cobra.Command, args []string) error {
+	// Counting across the vault doesn't target a single entry
+	if viewCountAll {
+		return runCountAllMatches()
+	}
+
 	dateStr := args[0]
 
-	// Step 1: Validate date format
-	if !isValidDateFormat(dateStr) {
-		return fmt.Errorf("invalid date format: %s (expected YYYY-MM-DD)", dateStr)
+	// Step 1: Normalize separators, then validate date format. Outside
+	// --strict an invalid date gets suggestions once the vault is open.
+	if !viewStrict {
+		dateStr = normalizeDate(dateStr)
+	}
+	validDate := isValidDateFormat(dateStr)
+	if !validDate && viewStrict {
+		return invalidDateError(dateStr)
 	}
 
 	// Step 2: Load configuration
@@ -51,40 +138,339 @@ func runViewCommand(cmd *cobra.Command, args []string) error {
 	}
 
 	// Step 3: Create vault instance
-	v, err := vault.New(cfg.Directory)
+	v, err := openVault(cfg)
 	if err != nil {
-		return fmt.Errorf("failed to initialize journal directory: %w", err)
+		return err
 	}
 
-	// Step 4: Check if entry exists
-	if !v.EntryExists(dateStr) {
-		return fmt.Errorf("journal entry for %s does not exist", dateStr)
+	// Step 4: Offer the closest existing entries for a miss
+	if !viewStrict && (!validDate || !v.EntryExists(dateStr)) {
+		miss := fmt.Errorf("journal entry for %s: %w", dateStr, vault.ErrEntryNotFound)
+		if !validDate {
+			miss = invalidDateError(args[0])
+		}
+		interactive := term.IsTerminal(int(os.Stdin.Fd()))
+		if dateStr, err = pickClosestDate(v, dateStr, miss, os.Stdin, interactive); err != nil {
+			return err
+		}
 	}
 
-	// Step 5: Read entry content
+	// Step 5: Read entry content; a missing entry's error already says so
 	content, err := v.ReadEntry(dateStr)
+	if errors.Is(err, vault.ErrEntryNotFound) {
+		return err
+	}
 	if err != nil {
 		return fmt.Errorf("failed to read entry %s: %w", dateStr, err)
 	}
 
-	// Step 6: Create markdown renderer
-	renderer, err := markdown.NewRenderer()
+	// Step 6: Raw, strip and count modes skip rendering entirely
+	if viewCountMatches != "" {
+		fmt.Println(countMatches(markdown.StripMarkdown(content), viewCountMatches, viewWholeWord))
+		return nil
+	}
+	if viewRaw {
+		raw := string(content)
+		if viewLineNumbers {
+			raw = numberLines(raw)
+		}
+		fmt.Print(raw)
+		return copyIfRequested(content, raw)
+	}
+	if viewStrip {
+		stripped := markdown.StripMarkdown(content)
+		fmt.Print(stripped)
+		return copyIfRequested(content, stripped)
+	}
+
+	// Step 7: Comparison mode renders once per requested width
+	if viewAtWidth != "" {
+		widths, err := parseWidthList(viewAtWidth)
+		if err != nil {
+			return err
+		}
+		for _, width := range widths {
+			renderer, err := markdown.NewRenderer(markdown.Options{Style: renderStyle(cfg.Style), WordWrap: width, CodeTheme: cfg.CodeTheme, WikiLinks: cfg.WikiLinks})
+			if err != nil {
+				return fmt.Errorf("failed to create markdown renderer: %w", err)
+			}
+			rendered, err := renderer.Render(content)
+			if err != nil {
+				return fmt.Errorf("failed to render markdown: %w", err)
+			}
+			fmt.Println(widthDivider(width))
+			fmt.Print(rendered)
+		}
+		return nil
+	}
+
+	// Step 8: Create markdown renderer
+	renderer, err := markdown.NewRenderer(markdown.Options{
+		Style:     renderStyle(cfg.Style),
+		WordWrap:  resolveWordWrap(viewWidth, cfg.WordWrap),
+		CodeTheme: cfg.CodeTheme,
+		WikiLinks: cfg.WikiLinks,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create markdown renderer: %w", err)
 	}
 
-	// Step 7: Render and display the content
-	rendered, err := renderer.Render(content)
+	// Step 9: Render and display the content
+	var rendered string
+	if viewLineNumbers {
+		rendered, err = renderNumbered(renderer, content)
+	} else {
+		rendered, err = renderer.Render(content)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to render markdown: %w", err)
 	}
 
-	// Step 8: Display the rendered content
-	fmt.Print(rendered)
+	// Step 10: Display a header with the reading time, then the content,
+	// paging it when it's taller than the terminal
+	header := calendarLabelStyle.Render(formatViewHeader(dateStr, content))
+	if err := pageOutput(header+"\n"+rendered, viewNoPager); err != nil {
+		return err
+	}
+
+	// Step 11: Copy without ANSI escapes so it pastes cleanly elsewhere
+	return copyIfRequested(content, ansi.Strip(rendered))
+}
+
+// formatViewHeader summarizes an entry as "date · N words · M min read",
+// indented to line up with glamour's left margin.
+func formatViewHeader(date string, content []byte) string {
+	words := markdown.WordCount(content)
+	noun := "words"
+	if words == 1 {
+		noun = "word"
+	}
+	return fmt.Sprintf("  %s · %d %s · %s read", date, words, noun, markdown.FormatReadingTime(words))
+}
+
+// lineNumberStyle dims the line number gutter
+var lineNumberStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("#6B7280"))
+
+// numberLines prefixes every line of content with its 1-based line number.
+func numberLines(content string) string {
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	width := len(strconv.Itoa(len(lines)))
+
+	var b strings.Builder
+	for i, line := range lines {
+		fmt.Fprintf(&b, "%s %s\n", gutter(strconv.Itoa(i+1), width), line)
+	}
+	return b.String()
+}
+
+// renderNumbered renders content one block at a time, labelling the first
+// rendered line of each block with the source line the block starts on.
+// Glamour reflows text within a block, so continuation lines get an empty
+// gutter rather than a number that wouldn't match the source.
+func renderNumbered(renderer *markdown.Renderer, content []byte) (string, error) {
+	blocks := markdown.SplitBlocks(content)
+	if len(blocks) == 0 {
+		return "", nil
+	}
+	width := len(strconv.Itoa(blocks[len(blocks)-1].Line))
+
+	var b strings.Builder
+	for i, block := range blocks {
+		rendered, err := renderer.Render(block.Source)
+		if err != nil {
+			return "", err
+		}
+
+		if i > 0 {
+			fmt.Fprintln(&b, gutter("", width))
+		}
+		for j, line := range trimBlankLines(strings.Split(rendered, "\n")) {
+			label := ""
+			if j == 0 {
+				label = strconv.Itoa(block.Line)
+			}
+			fmt.Fprintf(&b, "%s %s\n", gutter(label, width), line)
+		}
+	}
+	return b.String(), nil
+}
+
+// gutter right-aligns label in a line number column of width digits.
+func gutter(label string, width int) string {
+	return lineNumberStyle.Render(fmt.Sprintf("%*s │", width, label))
+}
+
+// trimBlankLines drops the leading and trailing lines that are empty once
+// ANSI escapes and spaces are removed, such as glamour's block margins.
+func trimBlankLines(lines []string) []string {
+	blank := func(line string) bool { return strings.TrimSpace(ansi.Strip(line)) == "" }
+	for len(lines) > 0 && blank(lines[0]) {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && blank(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// copyToClipboard writes text to the system clipboard. Tests replace it so
+// they don't touch the real clipboard.
+var copyToClipboard = clipboard.Copy
+
+// copyIfRequested copies the entry to the clipboard: its markdown source
+// with --copy, or shown, the output as printed, with --copy-rendered.
+// The confirmation goes to stderr so piped stdout stays clean.
+func copyIfRequested(source []byte, shown string) error {
+	var text string
+	switch {
+	case viewCopy:
+		text = string(source)
+	case viewCopyRendered:
+		text = shown
+	default:
+		return nil
+	}
+
+	if err := copyToClipboard(text); err != nil {
+		return fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+
+	fmt.Fprintln(os.Stderr, "📋 Copied to clipboard")
+	return nil
+}
+
+// viewArgs validates positional arguments for the view command: a single
+// date normally, or none when counting matches across the whole vault.
+// Learn: Custom PositionalArgs can depend on flag values, which are parsed first.
+// See: https://pkg.go.dev/github.com/spf13/cobra#PositionalArgs
+func viewArgs(cmd *cobra.Command, args []string) error {
+	if viewCountAll {
+		if viewCountMatches == "" {
+			return fmt.Errorf("--all requires --count-matches")
+		}
+		return cobra.NoArgs(cmd, args)
+	}
+	if viewWholeWord && viewCountMatches == "" {
+		return fmt.Errorf("--whole-word requires --count-matches")
+	}
+	return cobra.ExactArgs(1)(cmd, args)
+}
+
+// runCountAllMatches prints per-date counts of viewCountMatches across the
+// vault (dates without matches are omitted) followed by the total.
+func runCountAllMatches() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
 
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	filenames, err := v.ListEntries()
+	if err != nil {
+		return fmt.Errorf("failed to list entries: %w", err)
+	}
+
+	total := 0
+	for _, filename := range filenames {
+		date := strings.TrimSuffix(filename, ".md")
+		content, err := v.ReadEntry(date)
+		if err != nil {
+			return fmt.Errorf("failed to read entry %s: %w", date, err)
+		}
+
+		count := countMatches(markdown.StripMarkdown(content), viewCountMatches, viewWholeWord)
+		if count > 0 {
+			fmt.Printf("%s  %d\n", date, count)
+			total += count
+		}
+	}
+
+	fmt.Printf("Total: %d\n", total)
 	return nil
 }
 
+// countMatches counts case-insensitive, non-overlapping occurrences of term
+// in text, optionally only where the term forms a whole word.
+func countMatches(text, term string, wholeWord bool) int {
+	pattern := regexp.QuoteMeta(term)
+	if wholeWord {
+		pattern = `\b` + pattern + `\b`
+	}
+	return len(regexp.MustCompile("(?i)"+pattern).FindAllStringIndex(text, -1))
+}
+
+// renderStyle picks the glamour style for view output: glamour's "notty"
+// style when --plain or --no-color is set or stdout isn't a terminal, so
+// headings and lists keep their layout without ANSI escape codes, and the
+// configured style otherwise.
+// See: https://github.com/charmbracelet/glamour/tree/master/styles#notty
+func renderStyle(configStyle string) string {
+	if viewPlain || colorDisabled() || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return "notty"
+	}
+	return configStyle
+}
+
+// resolveWordWrap picks the render width for view output.
+// Precedence: --width flag, then word_wrap from config, then the terminal
+// width when stdout is a TTY, and finally markdown.DefaultWordWrap.
+// Learn: term.GetSize reads the window size of a terminal file descriptor.
+// See: https://pkg.go.dev/golang.org/x/term#GetSize
+func resolveWordWrap(flagWidth, configWidth int) int {
+	if flagWidth > 0 {
+		return flagWidth
+	}
+	if configWidth > 0 {
+		return configWidth
+	}
+
+	fd := int(os.Stdout.Fd())
+	if term.IsTerminal(fd) {
+		if width, _, err := term.GetSize(fd); err == nil && width > 0 {
+			return width
+		}
+	}
+
+	return markdown.DefaultWordWrap
+}
+
+// parseWidthList parses a comma-separated list of positive column widths.
+// All invalid values are reported together so they can be fixed in one go.
+func parseWidthList(list string) ([]int, error) {
+	var widths []int
+	var invalid []string
+
+	for _, part := range strings.Split(list, ",") {
+		part = strings.TrimSpace(part)
+		width, err := strconv.Atoi(part)
+		if err != nil || width <= 0 {
+			invalid = append(invalid, strconv.Quote(part))
+			continue
+		}
+		widths = append(widths, width)
+	}
+
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid --at-width values: %s (expected positive integers)", strings.Join(invalid, ", "))
+	}
+	return widths, nil
+}
+
+// widthDivider returns a labeled divider line drawn to the given width.
+func widthDivider(width int) string {
+	label := fmt.Sprintf("── width %d ", width)
+	fill := width - len([]rune(label))
+	if fill < 0 {
+		fill = 0
+	}
+	return label + strings.Repeat("─", fill)
+}
+
 // isValidDateFormat validates that the date string matches YYYY-MM-DD format.
 // Learn: Regular expressions are useful for format validation.
 // See: https://pkg.go.dev/regexp
@@ -100,6 +486,31 @@ func isValidDateFormat(dateStr string) bool {
 	return err == nil
 }
 
+// invalidDateError reports date as not a YYYY-MM-DD date. It is a usage
+// error, so the command exits with status 2.
+func invalidDateError(date string) error {
+	return &usageError{err: fmt.Errorf("%w: %s (expected YYYY-MM-DD)", vault.ErrInvalidDate, date)}
+}
+
 func init() {
+	viewCmd.Flags().IntVar(&viewWidth, "width", 0, "wrap rendered output at this column (default: terminal width)")
+	viewCmd.Flags().BoolVar(&viewRaw, "raw", false, "print the markdown source without rendering")
+	viewCmd.Flags().BoolVar(&viewCopy, "copy", false, "copy the entry's markdown source to the system clipboard")
+	viewCmd.Flags().BoolVar(&viewCopyRendered, "copy-rendered", false, "copy the output as shown, without colors, to the system clipboard")
+	viewCmd.Flags().BoolVar(&viewStrip, "strip", false, "print plain text with markdown syntax removed")
+	viewCmd.Flags().StringVar(&viewAtWidth, "at-width", "", "render at each comma-separated width for comparison (e.g. 40,80,120)")
+	viewCmd.Flags().BoolVar(&viewPlain, "plain", false, "render without colors even in a terminal")
+	viewCmd.Flags().BoolVar(&viewNoPager, "no-pager", false, "print long output directly instead of through $PAGER")
+	viewCmd.Flags().BoolVar(&viewLineNumbers, "line-numbers", false, "prefix output with source line numbers")
+	viewCmd.Flags().BoolVar(&viewStrict, "strict", false, "require an exact YYYY-MM-DD date with an entry, without suggestions")
+	viewCmd.MarkFlagsMutuallyExclusive("raw", "strip", "at-width")
+	viewCmd.MarkFlagsMutuallyExclusive("raw", "strip", "plain")
+	viewCmd.Flags().StringVar(&viewCountMatches, "count-matches", "", "print how many times a term appears in the entry")
+	viewCmd.Flags().BoolVar(&viewWholeWord, "whole-word", false, "with --count-matches, only count whole-word matches")
+	viewCmd.Flags().BoolVar(&viewCountAll, "all", false, "with --count-matches, count across all entries")
+	viewCmd.MarkFlagsMutuallyExclusive("copy", "copy-rendered", "at-width")
+	for _, other := range []string{"raw", "strip", "at-width", "copy", "copy-rendered", "plain"} {
+		viewCmd.MarkFlagsMutuallyExclusive("count-matches", other)
+	}
 	rootCmd.AddCommand(viewCmd)
 }
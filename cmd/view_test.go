@@ -1,10 +1,15 @@
 package cmd
 
 import (
+	"errors"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"logmd/clipboard"
+	"logmd/markdown"
 	"logmd/vault"
 )
 
@@ -175,13 +180,17 @@ func TestRunViewCommandWithNonexistentEntry(t *testing.T) {
 		t.Error("Expected error for nonexistent entry, got nil")
 	}
 
-	if !strings.Contains(err.Error(), "does not exist") {
-		t.Errorf("Expected 'does not exist' in error message, got: %v", err)
+	if !errors.Is(err, vault.ErrEntryNotFound) {
+		t.Errorf("Expected ErrEntryNotFound, got: %v", err)
 	}
 }
 
-// TestRunViewCommandWithInvalidDate tests error handling for invalid date formats.
+// TestRunViewCommandWithInvalidDate tests --strict rejects invalid date formats
+// before touching the vault.
 func TestRunViewCommandWithInvalidDate(t *testing.T) {
+	viewStrict = true
+	defer func() { viewStrict = false }()
+
 	invalidDates := []string{
 		"2024-13-01", // Invalid month
 		"2024-01-32", // Invalid day
@@ -234,9 +243,9 @@ func TestRunViewCommandWithInvalidDirectory(t *testing.T) {
 		t.Error("Expected error with invalid directory, got nil")
 	}
 
-	// Should fail during vault initialization
-	if !strings.Contains(err.Error(), "failed to initialize journal directory") {
-		t.Errorf("Expected vault initialization error, got: %v", err)
+	// Should fail while opening the vault
+	if !strings.Contains(err.Error(), "failed to open journal directory") {
+		t.Errorf("Expected vault open error, got: %v", err)
 	}
 }
 
@@ -310,3 +319,362 @@ func TestViewCommandArgs(t *testing.T) {
 		t.Errorf("Expected no error with one argument, got: %v", err)
 	}
 }
+
+// TestResolveWordWrap tests render width precedence for the view command.
+func TestResolveWordWrap(t *testing.T) {
+	testCases := []struct {
+		name        string
+		flagWidth   int
+		configWidth int
+		expected    int
+	}{
+		{name: "FlagWins", flagWidth: 120, configWidth: 100, expected: 120},
+		{name: "ConfigWhenNoFlag", flagWidth: 0, configWidth: 100, expected: 100},
+		// Test output is not a TTY, so detection falls back to the default
+		{name: "DefaultWhenPiped", flagWidth: 0, configWidth: 0, expected: markdown.DefaultWordWrap},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := resolveWordWrap(tc.flagWidth, tc.configWidth); got != tc.expected {
+				t.Errorf("resolveWordWrap(%d, %d) = %d, expected %d", tc.flagWidth, tc.configWidth, got, tc.expected)
+			}
+		})
+	}
+}
+
+// TestRenderStyle tests that piped and --plain output use the notty style.
+func TestRenderStyle(t *testing.T) {
+	defer func() { viewPlain = false }()
+
+	// Test output is not a TTY, so the configured style is overridden
+	if got := renderStyle("dracula"); got != "notty" {
+		t.Errorf("Expected notty style when piped, got %s", got)
+	}
+
+	viewPlain = true
+	if got := renderStyle("dark"); got != "notty" {
+		t.Errorf("Expected notty style with --plain, got %s", got)
+	}
+
+	renderer, err := markdown.NewRenderer(markdown.Options{Style: renderStyle("dark")})
+	if err != nil {
+		t.Fatalf("Failed to create renderer: %v", err)
+	}
+	rendered, err := renderer.Render([]byte("# Heading\n\n- **bold** item\n"))
+	if err != nil {
+		t.Fatalf("Failed to render: %v", err)
+	}
+	if strings.Contains(rendered, "\x1b[") {
+		t.Errorf("Expected no ANSI escape codes, got %q", rendered)
+	}
+	if !strings.Contains(rendered, "# Heading") || !strings.Contains(rendered, "• **bold** item") {
+		t.Errorf("Expected headings and lists to be preserved, got %q", rendered)
+	}
+}
+
+// TestRunViewCommandRawAndCopy tests --raw/--strip output and --copy error handling.
+func TestRunViewCommandRawAndCopy(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-view-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+
+	testDate := "2024-01-15"
+	if err := v.WriteEntry(testDate, []byte("# Raw Entry\n\nSource text.")); err != nil {
+		t.Fatalf("Failed to write test entry: %v", err)
+	}
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	originalPath := os.Getenv("PATH")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		os.Setenv("PATH", originalPath)
+		viewRaw = false
+		viewCopy = false
+		viewCopyRendered = false
+		viewStrip = false
+		viewLineNumbers = false
+		copyToClipboard = clipboard.Copy
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	viewRaw = true
+	if err := runViewCommand(nil, []string{testDate}); err != nil {
+		t.Fatalf("runViewCommand() with --raw failed: %v", err)
+	}
+	viewLineNumbers = true
+	if err := runViewCommand(nil, []string{testDate}); err != nil {
+		t.Fatalf("runViewCommand() with --raw --line-numbers failed: %v", err)
+	}
+	viewRaw = false
+	if err := runViewCommand(nil, []string{testDate}); err != nil {
+		t.Fatalf("runViewCommand() with --line-numbers failed: %v", err)
+	}
+	viewLineNumbers = false
+
+	viewStrip = true
+	if err := runViewCommand(nil, []string{testDate}); err != nil {
+		t.Fatalf("runViewCommand() with --strip failed: %v", err)
+	}
+	viewStrip = false
+
+	// --copy takes the markdown source whatever is shown, and
+	// --copy-rendered the output as shown
+	var copied string
+	copyToClipboard = func(text string) error {
+		copied = text
+		return nil
+	}
+	viewCopy = true
+	for _, flags := range []struct{ raw, lineNumbers bool }{{false, false}, {true, true}} {
+		viewRaw, viewLineNumbers = flags.raw, flags.lineNumbers
+		if err := runViewCommand(nil, []string{testDate}); err != nil {
+			t.Fatalf("runViewCommand() with --copy failed: %v", err)
+		}
+		if copied != "# Raw Entry\n\nSource text." {
+			t.Errorf("Expected --copy to copy the source with %+v, got %q", flags, copied)
+		}
+	}
+	viewRaw, viewLineNumbers, viewCopy = false, false, false
+
+	viewCopyRendered = true
+	if err := runViewCommand(nil, []string{testDate}); err != nil {
+		t.Fatalf("runViewCommand() with --copy-rendered failed: %v", err)
+	}
+	if !strings.Contains(copied, "  Source text.") || strings.Contains(copied, "\x1b[") {
+		t.Errorf("Expected the rendered text without colors, got %q", copied)
+	}
+	viewCopyRendered = false
+	copyToClipboard = clipboard.Copy
+
+	// With no clipboard tool on PATH, --copy should report a clear error
+	os.Setenv("PATH", tmpDir)
+	viewCopy = true
+	err = runViewCommand(nil, []string{testDate})
+	if err == nil {
+		t.Fatal("Expected error when no clipboard tool is available")
+	}
+
+	if !strings.Contains(err.Error(), "no clipboard tool found") {
+		t.Errorf("Expected clipboard error, got: %v", err)
+	}
+}
+
+// TestNumberLines tests numbering every line of raw output.
+func TestNumberLines(t *testing.T) {
+	originalProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.Ascii)
+	defer lipgloss.SetColorProfile(originalProfile)
+
+	content := "# Day\n\n" + strings.Repeat("line\n", 8)
+	got := numberLines(content)
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	if len(lines) != 10 {
+		t.Fatalf("Expected 10 numbered lines, got %d:\n%s", len(lines), got)
+	}
+	if lines[0] != " 1 │ # Day" {
+		t.Errorf("Expected padded first line, got %q", lines[0])
+	}
+	if lines[1] != " 2 │ " {
+		t.Errorf("Expected blank line to keep its number, got %q", lines[1])
+	}
+	if lines[9] != "10 │ line" {
+		t.Errorf("Expected last line numbered 10, got %q", lines[9])
+	}
+}
+
+// TestRenderNumbered tests labelling rendered blocks with their source line.
+func TestRenderNumbered(t *testing.T) {
+	originalProfile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.Ascii)
+	defer lipgloss.SetColorProfile(originalProfile)
+
+	renderer, err := markdown.NewRenderer(markdown.Options{Style: "notty", WordWrap: 40})
+	if err != nil {
+		t.Fatalf("Failed to create renderer: %v", err)
+	}
+
+	content := "---\ndate: 2024-01-15\n---\n# Day\n\n" + strings.Repeat("long paragraph text ", 10) + "\n\n- item\n"
+	got, err := renderNumbered(renderer, []byte(content))
+	if err != nil {
+		t.Fatalf("renderNumbered() failed: %v", err)
+	}
+
+	var labels []string
+	wrapped := false
+	for _, line := range strings.Split(strings.TrimSuffix(got, "\n"), "\n") {
+		label, text, ok := strings.Cut(line, " │")
+		if !ok {
+			t.Fatalf("Expected every line to have a gutter, got %q", line)
+		}
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		} else if strings.TrimSpace(text) != "" {
+			wrapped = true
+		}
+	}
+
+	// Front matter is hidden but still counted
+	if strings.Join(labels, ",") != "4,6,8" {
+		t.Errorf("Expected labels 4,6,8, got %v in:\n%s", labels, got)
+	}
+	if !wrapped {
+		t.Errorf("Expected wrapped paragraph lines without a label:\n%s", got)
+	}
+}
+
+// TestParseWidthList tests parsing of --at-width values.
+func TestParseWidthList(t *testing.T) {
+	widths, err := parseWidthList("40, 80,120")
+	if err != nil {
+		t.Fatalf("parseWidthList() failed: %v", err)
+	}
+	if len(widths) != 3 || widths[0] != 40 || widths[1] != 80 || widths[2] != 120 {
+		t.Errorf("Expected [40 80 120], got %v", widths)
+	}
+
+	_, err = parseWidthList("40,abc,0,-5,80")
+	if err == nil {
+		t.Fatal("Expected error for invalid widths")
+	}
+	for _, bad := range []string{`"abc"`, `"0"`, `"-5"`} {
+		if !strings.Contains(err.Error(), bad) {
+			t.Errorf("Expected error to list %s, got: %v", bad, err)
+		}
+	}
+	if strings.Contains(err.Error(), `"40"`) {
+		t.Errorf("Valid width should not be listed as invalid: %v", err)
+	}
+}
+
+// TestWidthDivider tests the labeled divider between comparison renders.
+func TestWidthDivider(t *testing.T) {
+	divider := widthDivider(40)
+	if !strings.Contains(divider, "width 40") {
+		t.Errorf("Divider should be labeled with the width, got %q", divider)
+	}
+	if n := len([]rune(divider)); n != 40 {
+		t.Errorf("Expected divider of 40 columns, got %d", n)
+	}
+
+	// Narrow widths still show the label
+	if !strings.Contains(widthDivider(5), "width 5") {
+		t.Error("Narrow divider should keep its label")
+	}
+}
+
+// TestCountMatches tests case-insensitive and whole-word counting.
+func TestCountMatches(t *testing.T) {
+	text := "Deadline today. Two deadlines next week; the DEADLINE moved. deadline-driven."
+
+	if got := countMatches(text, "deadline", false); got != 4 {
+		t.Errorf("Expected 4 substring matches, got %d", got)
+	}
+	if got := countMatches(text, "deadline", true); got != 3 {
+		t.Errorf("Expected 3 whole-word matches, got %d", got)
+	}
+	if got := countMatches(text, "a.b", false); got != 0 {
+		t.Errorf("Expected regex metacharacters to be literal, got %d", got)
+	}
+}
+
+// TestViewArgsWithCountAll tests positional argument rules for --count-matches --all.
+func TestViewArgsWithCountAll(t *testing.T) {
+	defer func() {
+		viewCountMatches = ""
+		viewCountAll = false
+		viewWholeWord = false
+	}()
+
+	viewCountAll = true
+	if err := viewArgs(viewCmd, []string{}); err == nil {
+		t.Error("Expected error for --all without --count-matches")
+	}
+
+	viewCountMatches = "term"
+	if err := viewArgs(viewCmd, []string{}); err != nil {
+		t.Errorf("Expected no date with --all, got: %v", err)
+	}
+	if err := viewArgs(viewCmd, []string{"2024-01-15"}); err == nil {
+		t.Error("Expected error for date with --all")
+	}
+
+	viewCountAll = false
+	viewCountMatches = ""
+	viewWholeWord = true
+	if err := viewArgs(viewCmd, []string{"2024-01-15"}); err == nil {
+		t.Error("Expected error for --whole-word without --count-matches")
+	}
+}
+
+// TestRunViewCommandCountMatches tests counting in one entry and across the vault.
+func TestRunViewCommandCountMatches(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-view-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := v.WriteEntry("2024-01-15", []byte("# Deadline\n\nThe **deadline** is near.")); err != nil {
+		t.Fatalf("Failed to write test entry: %v", err)
+	}
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+		viewCountMatches = ""
+		viewCountAll = false
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	viewCountMatches = "deadline"
+	if err := runViewCommand(nil, []string{"2024-01-15"}); err != nil {
+		t.Fatalf("runViewCommand() with --count-matches failed: %v", err)
+	}
+
+	viewCountAll = true
+	if err := runViewCommand(nil, []string{}); err != nil {
+		t.Fatalf("runViewCommand() with --count-matches --all failed: %v", err)
+	}
+}
+
+// TestFormatViewHeader tests the reading time shown above a viewed entry.
+func TestFormatViewHeader(t *testing.T) {
+	tests := []struct {
+		content  string
+		expected string
+	}{
+		{"", "  2024-01-15 · 0 words · <1 min read"},
+		{"# Hello\n", "  2024-01-15 · 1 word · 1 min read"},
+		{"# Long\n\n" + strings.Repeat("word ", 450), "  2024-01-15 · 451 words · 3 min read"},
+	}
+
+	for _, tt := range tests {
+		if got := formatViewHeader("2024-01-15", []byte(tt.content)); got != tt.expected {
+			t.Errorf("formatViewHeader() = %q, expected %q", got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// watchDebounce is how long the entry must stay unchanged before it is
+// rendered again, so an editor's burst of writes renders once.
+const watchDebounce = 150 * time.Millisecond
+
+// clearScreen moves the cursor home and clears the terminal.
+const clearScreen = "\033[H\033[2J"
+
+// watchCmd represents the watch command
+// Learn: fsnotify wraps inotify, kqueue and ReadDirectoryChangesW behind one API.
+// See: https://pkg.go.dev/github.com/fsnotify/fsnotify
+var watchCmd = &cobra.Command{
+	Use:   "watch <YYYY-MM-DD>",
+	Short: "Live-preview an entry while you edit it elsewhere",
+	Long: `Renders an entry like 'logmd view' and renders it again, after clearing
+the screen, every time its file changes. Keep it open in a terminal next
+to your editor for a live preview. Press Ctrl-C to stop.
+
+Editors that save by writing a new file and renaming it over the entry
+are handled: the entry's directory is watched rather than the file, and a
+moment without the file is waited out.
+
+Examples:
+  logmd watch 2024-01-15`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWatchCommand,
+}
+
+// runWatchCommand implements the core logic for the watch command.
+func runWatchCommand(cmd *cobra.Command, args []string) error {
+	date := args[0]
+
+	// Step 1: Validate date format
+	if !isValidDateFormat(date) {
+		return invalidDateError(date)
+	}
+
+	// Step 2: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 3: Open the vault
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+	if !v.EntryExists(date) {
+		return fmt.Errorf("journal entry for %s: %w (create it with 'logmd edit %s')", date, vault.ErrEntryNotFound, date)
+	}
+
+	// Step 4: Create markdown renderer
+	renderer, err := markdown.NewRenderer(markdown.Options{
+		Style:     renderStyle(cfg.Style),
+		WordWrap:  resolveWordWrap(0, cfg.WordWrap),
+		CodeTheme: cfg.CodeTheme,
+		WikiLinks: cfg.WikiLinks,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create markdown renderer: %w", err)
+	}
+
+	// Step 5: Render now, then after every change until Ctrl-C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	renderWatched(os.Stdout, v, renderer, date)
+	return watchEntry(ctx, v.DatePath(date), watchDebounce, func() {
+		renderWatched(os.Stdout, v, renderer, date)
+	})
+}
+
+// renderWatched clears w and prints the rendered entry for date. While the
+// file is missing, as in the middle of an atomic save, the previous render
+// is left on screen; the save's next event renders it again.
+func renderWatched(w io.Writer, v *vault.Vault, renderer *markdown.Renderer, date string) {
+	content, err := v.ReadEntry(date)
+	if errors.Is(err, vault.ErrEntryNotFound) {
+		return
+	}
+
+	fmt.Fprint(w, clearScreen)
+	if err == nil {
+		var rendered string
+		if rendered, err = renderer.Render(content); err == nil {
+			fmt.Fprint(w, rendered)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(w, "⚠️  Failed to render %s: %v\n", date, err)
+	}
+	fmt.Fprintln(w, calendarLabelStyle.Render(fmt.Sprintf("👀 Watching %s • updated %s • Ctrl-C to stop", date, time.Now().Format("15:04:05"))))
+}
+
+// watchEntry calls onChange each time the file at path is written, created
+// or replaced, once it has been quiet for debounce, until ctx is done. The
+// parent directory is watched because renaming a new file over path would
+// end a watch on the file itself.
+// Learn: A timer reset on every event turns a burst into a single call.
+// See: https://pkg.go.dev/time#Timer.Reset
+func watchEntry(ctx context.Context, path string, debounce time.Duration, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("failed to watch %s: %w", filepath.Dir(path), err)
+	}
+
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if filepath.Clean(event.Name) == filepath.Clean(path) && event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+				timer.Reset(debounce)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			return fmt.Errorf("failed to watch %s: %w", path, err)
+		case <-timer.C:
+			onChange()
+		}
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+}
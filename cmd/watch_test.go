@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// TestWatchEntry tests that writes and atomic renames onto the entry are
+// reported once per burst, and that other files are ignored.
+func TestWatchEntry(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-watch-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	path := filepath.Join(tmpDir, "2024-01-15.md")
+	if err := os.WriteFile(path, []byte("# Monday\n"), 0644); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var changes atomic.Int32
+	done := make(chan error, 1)
+	go func() {
+		done <- watchEntry(ctx, path, 50*time.Millisecond, func() { changes.Add(1) })
+	}()
+	// Give the watcher time to start
+	time.Sleep(100 * time.Millisecond)
+
+	waitForChanges := func(want int32) {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for changes.Load() < want && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		// Leave room for an unwanted extra call to show up
+		time.Sleep(150 * time.Millisecond)
+		if got := changes.Load(); got != want {
+			t.Fatalf("Expected %d change(s), got %d", want, got)
+		}
+	}
+
+	// A burst of writes is one change
+	for i := 0; i < 5; i++ {
+		os.WriteFile(path, []byte("# Monday\n\nTyping...\n"), 0644)
+	}
+	waitForChanges(1)
+
+	// Other files in the directory don't count
+	os.WriteFile(filepath.Join(tmpDir, "2024-01-16.md"), []byte("# Tuesday\n"), 0644)
+	time.Sleep(150 * time.Millisecond)
+	if got := changes.Load(); got != 1 {
+		t.Fatalf("Expected another file to be ignored, got %d change(s)", got)
+	}
+
+	// Saving by renaming a new file over the entry is a change
+	tmpPath := filepath.Join(tmpDir, ".2024-01-15.md.swp")
+	os.WriteFile(tmpPath, []byte("# Monday\n\nSaved.\n"), 0644)
+	if err := os.Rename(tmpPath, path); err != nil {
+		t.Fatalf("Failed to rename: %v", err)
+	}
+	waitForChanges(2)
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("watchEntry() returned %v after cancel", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("watchEntry() didn't return after cancel")
+	}
+}
+
+// TestRenderWatched tests clearing and rendering, and leaving the screen
+// alone while the entry is missing.
+func TestRenderWatched(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-watch-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	renderer, err := markdown.NewRenderer(markdown.Options{Style: "notty"})
+	if err != nil {
+		t.Fatalf("Failed to create renderer: %v", err)
+	}
+
+	var out bytes.Buffer
+	renderWatched(&out, v, renderer, "2024-01-15")
+	if out.Len() != 0 {
+		t.Errorf("Expected no output while the entry is missing, got %q", out.String())
+	}
+
+	if err := v.WriteEntry("2024-01-15", []byte("# Monday\n\nLive preview\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+	renderWatched(&out, v, renderer, "2024-01-15")
+	got := out.String()
+	if !strings.HasPrefix(got, clearScreen) {
+		t.Errorf("Expected output to start by clearing the screen, got %q", got)
+	}
+	if !strings.Contains(got, "Live preview") || !strings.Contains(got, "Watching 2024-01-15") {
+		t.Errorf("Expected the rendered entry and status line, got %q", got)
+	}
+}
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"logmd/config"
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// Styles for the weekly digest
+var (
+	weekTitleStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#7C3AED"))
+
+	dayHeaderStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#10B981"))
+
+	noEntryStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#6B7280")).
+			Italic(true)
+)
+
+// weekCmd represents the week command
+// Learn: time.Time.ISOWeek numbers weeks the same way as ISO 8601 calendars.
+// See: https://pkg.go.dev/time#Time.ISOWeek
+var weekCmd = &cobra.Command{
+	Use:   "week [YYYY-MM-DD]",
+	Short: "Show every entry from one week together",
+	Long: `Renders the entries for the Monday-to-Sunday week containing the given
+date (default today), one after another under a header for each day. Days
+without an entry show "(no entry)" so gaps stand out.
+
+Examples:
+  logmd week
+  logmd week 2024-01-17`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWeekCommand,
+}
+
+// runWeekCommand implements the core logic for the week command.
+func runWeekCommand(cmd *cobra.Command, args []string) error {
+	// Step 1: Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Step 2: Resolve the day whose week is shown, today by default
+	day := cfg.Today()
+	if len(args) == 1 {
+		if !isValidDateFormat(args[0]) {
+			return invalidDateError(args[0])
+		}
+		day, _ = time.Parse(vault.DefaultDateFormat, args[0])
+	}
+	monday := weekStart(day)
+	sunday := monday.AddDate(0, 0, 6)
+
+	// Step 3: Create vault instance
+	v, err := openVault(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Step 4: Find the entries written that week
+	filenames, err := v.ListEntriesInRange(monday.Format(vault.DefaultDateFormat), sunday.Format(vault.DefaultDateFormat))
+	if err != nil {
+		return fmt.Errorf("failed to list entries: %w", err)
+	}
+	written := make(map[string]bool, len(filenames))
+	for _, filename := range filenames {
+		written[strings.TrimSuffix(filename, ".md")] = true
+	}
+
+	// Step 5: Create markdown renderer
+	renderer, err := markdown.NewRenderer(markdown.Options{
+		Style:     renderStyle(cfg.Style),
+		CodeTheme: cfg.CodeTheme,
+		WordWrap:  resolveWordWrap(0, cfg.WordWrap),
+		WikiLinks: cfg.WikiLinks,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create markdown renderer: %w", err)
+	}
+
+	// Step 6: Render each day under its own header
+	year, week := monday.ISOWeek()
+	fmt.Println(weekTitleStyle.Render(fmt.Sprintf("📅 Week %d of %d (%s – %s) • %d of 7 days written",
+		week, year, monday.Format(vault.DefaultDateFormat), sunday.Format(vault.DefaultDateFormat), len(written))))
+
+	for i := 0; i < 7; i++ {
+		date := monday.AddDate(0, 0, i).Format(vault.DefaultDateFormat)
+		fmt.Println()
+		fmt.Println(dayHeaderStyle.Render(fmt.Sprintf("── %s %s", monday.AddDate(0, 0, i).Format("Monday"), date)))
+
+		if !written[date] {
+			fmt.Println(noEntryStyle.Render("(no entry)"))
+			continue
+		}
+
+		content, err := v.ReadEntry(date)
+		if err != nil {
+			return fmt.Errorf("failed to read entry %s: %w", date, err)
+		}
+		rendered, err := renderer.Render(content)
+		if err != nil {
+			return fmt.Errorf("failed to render entry %s: %w", date, err)
+		}
+		fmt.Print(rendered)
+	}
+
+	return nil
+}
+
+// weekStart returns midnight on the Monday of the ISO week containing day.
+// Learn: time.Weekday counts from Sunday, so shifting by 6 mod 7 makes Monday 0.
+// See: https://pkg.go.dev/time#Weekday
+func weekStart(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7
+	return time.Date(day.Year(), day.Month(), day.Day()-offset, 0, 0, 0, 0, day.Location())
+}
+
+func init() {
+	rootCmd.AddCommand(weekCmd)
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"logmd/vault"
+)
+
+// TestWeekStart tests that every day maps to the Monday of its ISO week.
+func TestWeekStart(t *testing.T) {
+	testCases := []struct {
+		day      string
+		expected string
+	}{
+		{day: "2024-01-15", expected: "2024-01-15"}, // Monday
+		{day: "2024-01-17", expected: "2024-01-15"}, // Wednesday
+		{day: "2024-01-21", expected: "2024-01-15"}, // Sunday belongs to the week before
+		{day: "2024-03-01", expected: "2024-02-26"}, // Across a month boundary
+		{day: "2025-01-01", expected: "2024-12-30"}, // Across a year boundary
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.day, func(t *testing.T) {
+			day, _ := time.Parse("2006-01-02", tc.day)
+			if got := weekStart(day.Add(15 * time.Hour)).Format("2006-01-02"); got != tc.expected {
+				t.Errorf("weekStart(%s) = %s, expected %s", tc.day, got, tc.expected)
+			}
+		})
+	}
+}
+
+// TestRunWeekCommand tests rendering a week with gaps.
+func TestRunWeekCommand(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-week-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	for _, date := range []string{"2024-01-15", "2024-01-18", "2024-01-22"} {
+		if err := v.CreateEntry(date); err != nil {
+			t.Fatalf("Failed to create entry: %v", err)
+		}
+	}
+
+	// Save original environment
+	originalDir := os.Getenv("LOGMD_DIRECTORY")
+	defer func() {
+		if originalDir != "" {
+			os.Setenv("LOGMD_DIRECTORY", originalDir)
+		} else {
+			os.Unsetenv("LOGMD_DIRECTORY")
+		}
+	}()
+
+	os.Setenv("LOGMD_DIRECTORY", tmpDir)
+
+	if err := runWeekCommand(nil, []string{"2024-01-17"}); err != nil {
+		t.Fatalf("runWeekCommand() failed: %v", err)
+	}
+	if err := runWeekCommand(nil, []string{}); err != nil {
+		t.Fatalf("runWeekCommand() for the current week failed: %v", err)
+	}
+	if err := runWeekCommand(nil, []string{"2024-13-01"}); err == nil {
+		t.Error("Expected error for invalid date")
+	}
+}
@@ -7,10 +7,16 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
+	"logmd/logging"
 )
 
 // Config holds all configuration values for logmd.
@@ -21,15 +27,121 @@ type Config struct {
 	Directory string `mapstructure:"directory"`
 	// Editor is the command used to open journal files for editing
 	Editor string `mapstructure:"editor"`
+	// EditorArgs are the editor's arguments, with {file} for the entry's path (empty passes just the path)
+	EditorArgs string `mapstructure:"editor_args"`
+	// EditorWait adds the wait flag for GUI editors known to return before the file is closed
+	EditorWait bool `mapstructure:"editor_wait"`
 	// PreviewLines controls how many lines to show in timeline previews
 	PreviewLines int `mapstructure:"preview_lines"`
+	// PreviewFrom is where timeline previews start: "after_title" or "first_content"
+	PreviewFrom string `mapstructure:"preview_from"`
+	// RenderedPreview styles timeline previews as markdown instead of showing the raw lines
+	RenderedPreview bool `mapstructure:"rendered_preview"`
+	// PageSize is how many entries pgup/pgdown move in the timeline (0 pages by a screenful)
+	PageSize int `mapstructure:"page_size"`
+	// Style is the glamour style used when rendering entries ("auto", "dark", "light", ...)
+	Style string `mapstructure:"style"`
+	// CodeTheme is the chroma theme used to highlight code blocks (empty uses the style's own)
+	CodeTheme string `mapstructure:"code_theme"`
+	// WikiLinks shows [[YYYY-MM-DD]] references to other entries as links
+	WikiLinks bool `mapstructure:"wiki_links"`
+	// WordWrap is the column width for rendered entries (0 uses the renderer default)
+	WordWrap int `mapstructure:"word_wrap"`
+	// WordGoal is the daily word count today reports progress toward (0 disables it)
+	WordGoal int `mapstructure:"word_goal"`
+	// Layout is how entries are arranged on disk: "flat" or "nested" (YYYY/MM/)
+	Layout string `mapstructure:"layout"`
+	// DateFormat is the Go time layout of entry filenames, e.g. "2006_01_02"
+	DateFormat string `mapstructure:"date_format"`
+	// Timezone is the IANA name, e.g. "America/New_York", whose date is
+	// "today" (empty uses the local timezone)
+	Timezone string `mapstructure:"timezone"`
+	// DayStartHour is the hour (0-23) a new journal day begins, so entries
+	// written before it still count as the previous day
+	DayStartHour int `mapstructure:"day_start_hour"`
+	// LLMAPIKey authenticates assist requests (empty uses the offline mock engine)
+	LLMAPIKey string `mapstructure:"llm_api_key"`
+	// LLMURL is the base URL of an OpenAI-compatible API (empty uses the OpenAI default)
+	LLMURL string `mapstructure:"llm_url"`
+	// LLMModel is the chat model used by assist (empty uses the engine default)
+	LLMModel string `mapstructure:"llm_model"`
+	// GitAutoCommit commits each saved entry when the directory is a git repository
+	GitAutoCommit bool `mapstructure:"git_auto_commit"`
+	// DefaultCommand is what running logmd without a subcommand does, e.g.
+	// "timeline" or "recent" (empty shows help)
+	DefaultCommand string `mapstructure:"default_command"`
+	// UseTrash moves deleted entries into .trash/ so they can be restored
+	UseTrash bool `mapstructure:"use_trash"`
+	// Encrypt stores new and edited entries encrypted with a passphrase
+	Encrypt bool `mapstructure:"encrypt"`
+	// FrontMatter starts new entries with a YAML front matter block
+	FrontMatter bool `mapstructure:"front_matter"`
+	// FrontMatterFields lists the fields of that block, in order
+	FrontMatterFields []string `mapstructure:"front_matter_fields"`
+	// KeyBindings overrides timeline keys by action name, from the [keys] table
+	KeyBindings map[string][]string `mapstructure:"keys"`
+	// Profile names the entry of Profiles whose directory replaces Directory
+	Profile string `mapstructure:"profile"`
+	// Profiles maps profile names to journal directories, from the [profiles] table
+	Profiles map[string]string `mapstructure:"profiles"`
+
+	// baseDirectory is Directory before a profile replaced it, so a written
+	// config file keeps the configured value
+	baseDirectory string
+	// location is Timezone loaded by Load (nil means local time)
+	location *time.Location
+}
+
+// DefaultFrontMatterFields are the front matter fields used when none are configured.
+var DefaultFrontMatterFields = []string{"date", "mood", "tags"}
+
+// profileOverride is the profile selected with SetProfile.
+var profileOverride string
+
+// SetProfile makes Load use the named profile, taking precedence over
+// LOGMD_PROFILE. An empty name leaves the choice to the environment.
+// Learn: The --profile flag calls this before any command loads its config.
+func SetProfile(name string) {
+	profileOverride = name
+}
+
+// ErrInvalidConfig matches every error from Load, such as an unreadable
+// config file or an unknown profile. Check for it with errors.Is.
+var ErrInvalidConfig = errors.New("invalid configuration")
+
+// loadError keeps the message of an error from Load while matching
+// ErrInvalidConfig as well as the error it wraps.
+type loadError struct {
+	err error
+}
+
+func (e *loadError) Error() string {
+	return e.err.Error()
+}
+
+func (e *loadError) Unwrap() error {
+	return e.err
+}
+
+func (e *loadError) Is(target error) bool {
+	return target == ErrInvalidConfig
 }
 
 // Load reads configuration from file, environment, and defaults.
 // Returns a Config struct with all values resolved according to precedence.
+// Errors match ErrInvalidConfig.
 // Learn: Viper automatically handles multiple configuration sources.
 // See: https://github.com/spf13/viper#reading-config-files
 func Load() (*Config, error) {
+	config, err := load()
+	if err != nil {
+		return nil, &loadError{err: err}
+	}
+	return config, nil
+}
+
+// load does the work of Load.
+func load() (*Config, error) {
 	v := viper.New()
 
 	// Set defaults
@@ -40,20 +152,46 @@ func Load() (*Config, error) {
 
 	v.SetDefault("directory", filepath.Join(homeDir, "logmd"))
 	v.SetDefault("editor", getDefaultEditor())
+	v.SetDefault("editor_args", "")
+	v.SetDefault("editor_wait", true)
 	v.SetDefault("preview_lines", 5)
+	v.SetDefault("preview_from", "after_title")
+	v.SetDefault("rendered_preview", false)
+	v.SetDefault("page_size", 0)
+	v.SetDefault("style", "auto")
+	v.SetDefault("code_theme", "")
+	v.SetDefault("wiki_links", false)
+	v.SetDefault("word_wrap", 0)
+	v.SetDefault("word_goal", 0)
+	v.SetDefault("layout", "flat")
+	v.SetDefault("date_format", "2006-01-02")
+	v.SetDefault("timezone", "")
+	v.SetDefault("day_start_hour", 0)
+	v.SetDefault("git_auto_commit", false)
+	v.SetDefault("use_trash", true)
+	v.SetDefault("default_command", "")
+	v.SetDefault("encrypt", false)
+	v.SetDefault("front_matter", false)
+	v.SetDefault("front_matter_fields", DefaultFrontMatterFields)
+	// Registered so AutomaticEnv picks up LOGMD_LLM_* when unmarshalling
+	v.SetDefault("llm_api_key", "")
+	v.SetDefault("llm_url", "")
+	v.SetDefault("llm_model", "")
+	v.SetDefault("profile", "")
 
-	// Configure file reading
-	v.SetConfigName(".logmdconfig")
+	// Configure file reading (XDG location first, then the home dotfile)
 	v.SetConfigType("toml")
-	v.AddConfigPath(homeDir)
+	if configPath := GetConfigPath(); configPath != "" {
+		v.SetConfigFile(configPath)
+	}
 
 	// Configure environment variables
 	v.SetEnvPrefix("LOGMD")
 	v.AutomaticEnv()
 
 	// Read config file (ignore if not found)
-	if err := v.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+	if v.ConfigFileUsed() != "" {
+		if err := v.ReadInConfig(); err != nil {
 			return nil, err
 		}
 	}
@@ -63,9 +201,122 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
+	// A selected profile decides the journal directory
+	if profileOverride != "" {
+		config.Profile = profileOverride
+	}
+	if err := config.applyProfile(); err != nil {
+		return nil, err
+	}
+
+	// Catch a mistyped timezone now rather than when a date is computed
+	if config.Timezone != "" {
+		if config.location, err = time.LoadLocation(config.Timezone); err != nil {
+			return nil, fmt.Errorf("invalid timezone %q (expected an IANA name such as America/New_York): %w", config.Timezone, err)
+		}
+	}
+	if config.DayStartHour < 0 || config.DayStartHour > 23 {
+		return nil, fmt.Errorf("invalid day_start_hour %d (expected an hour from 0 to 23)", config.DayStartHour)
+	}
+
+	logResolution(v.ConfigFileUsed(), &config)
 	return &config, nil
 }
 
+// logResolution logs where the loaded configuration came from: the config
+// file, or the paths searched for one, each LOGMD_* variable overriding a
+// setting, and the journal directory that resulted.
+func logResolution(configPath string, config *Config) {
+	if !logging.Enabled(logging.LevelInfo) {
+		return
+	}
+
+	if configPath != "" {
+		logging.Infof("config file: %s", configPath)
+	} else if paths, err := SearchPaths(); err == nil {
+		logging.Infof("no config file found (searched %s), using defaults", strings.Join(paths, ", "))
+	}
+
+	// Only keys from Keys are echoed, which leaves out the LLM API key
+	for _, key := range append(Keys, "llm_url", "llm_model", "profile") {
+		name := "LOGMD_" + strings.ToUpper(key)
+		if value, ok := os.LookupEnv(name); ok {
+			logging.Infof("%s=%q overrides %s", name, value, key)
+		}
+	}
+
+	if config.Profile != "" {
+		logging.Infof("profile %q selects the journal directory", config.Profile)
+	}
+	logging.Infof("journal directory: %s", config.Directory)
+}
+
+// Location returns the timezone dates are computed in: the configured
+// Timezone, or local time when it's unset.
+func (c *Config) Location() *time.Location {
+	if c.location == nil {
+		return time.Local
+	}
+	return c.location
+}
+
+// Now returns the current time in the configured timezone, so formatting
+// it gives today's date where the journal is kept rather than where the
+// computer happens to be.
+// Learn: time.Time.In changes how an instant is displayed, not the instant.
+// See: https://pkg.go.dev/time#Time.In
+func (c *Config) Now() time.Time {
+	return time.Now().In(c.Location())
+}
+
+// Today returns Now moved back by DayStartHour, so its date is the journal
+// day: at 2am with day_start_hour = 4 that is still yesterday. Use Now for
+// clock times, such as note timestamps, and Today for dates.
+func (c *Config) Today() time.Time {
+	return c.Now().Add(-time.Duration(c.DayStartHour) * time.Hour)
+}
+
+// applyProfile replaces Directory with the active profile's directory.
+// Profile names are matched case-insensitively since Viper lowercases the
+// keys of the [profiles] table.
+func (c *Config) applyProfile() error {
+	if c.Profile == "" {
+		return nil
+	}
+
+	c.Profile = strings.ToLower(c.Profile)
+	directory, ok := c.Profiles[c.Profile]
+	if !ok {
+		if len(c.Profiles) == 0 {
+			return fmt.Errorf("unknown profile %q (no profiles configured; add a [profiles] table to the config file)", c.Profile)
+		}
+		return fmt.Errorf("unknown profile %q (configured profiles: %s)", c.Profile, strings.Join(c.ProfileNames(), ", "))
+	}
+	c.baseDirectory = c.Directory
+	c.Directory = directory
+	return nil
+}
+
+// SetDirectory changes the journal directory. With a profile active the
+// directory is the profile's, so it is changed in Profiles and Template
+// writes it into the [profiles] table, leaving the directory key alone.
+func (c *Config) SetDirectory(directory string) {
+	c.Directory = directory
+	if _, ok := c.Profiles[c.Profile]; ok && c.Profile != "" {
+		c.Profiles[c.Profile] = directory
+	}
+}
+
+// ProfileNames returns the configured profile names in sorted order.
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // getDefaultEditor returns the default editor based on environment.
 // Respects $EDITOR environment variable, falls back to vim.
 // Learn: Environment variable access is done through the os package.
@@ -77,18 +328,42 @@ func getDefaultEditor() string {
 	return "vim"
 }
 
-// GetConfigPath returns the path to the configuration file.
-// Returns empty string if no config file is found.
-func GetConfigPath() string {
+// SearchPaths returns the config file locations in precedence order:
+// $XDG_CONFIG_HOME/logmd/config.toml, ~/.config/logmd/config.toml, and
+// finally the legacy ~/.logmdconfig.
+// Learn: The XDG Base Directory spec defaults XDG_CONFIG_HOME to ~/.config.
+// See: https://specifications.freedesktop.org/basedir-spec/latest/
+func SearchPaths() ([]string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		return ""
+		return nil, err
 	}
 
-	configPath := filepath.Join(homeDir, ".logmdconfig")
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+	var paths []string
+	if xdgHome := os.Getenv("XDG_CONFIG_HOME"); xdgHome != "" {
+		paths = append(paths, filepath.Join(xdgHome, "logmd", "config.toml"))
+	}
+	paths = append(paths,
+		filepath.Join(homeDir, ".config", "logmd", "config.toml"),
+		filepath.Join(homeDir, FileName),
+	)
+
+	return paths, nil
+}
+
+// GetConfigPath returns the path to the configuration file in use, checking
+// SearchPaths in order. Returns empty string if no config file is found.
+func GetConfigPath() string {
+	paths, err := SearchPaths()
+	if err != nil {
 		return ""
 	}
 
-	return configPath
+	for _, configPath := range paths {
+		if info, err := os.Stat(configPath); err == nil && !info.IsDir() {
+			return configPath
+		}
+	}
+
+	return ""
 }
@@ -1,9 +1,15 @@
 package config
 
 import (
+	"bytes"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"logmd/logging"
 )
 
 // TestLoad verifies that configuration loading works with defaults.
@@ -43,6 +49,16 @@ func TestLoad(t *testing.T) {
 	if config.PreviewLines != expectedPreviewLines {
 		t.Errorf("Expected PreviewLines=%d, got %d", expectedPreviewLines, config.PreviewLines)
 	}
+
+	// Test default rendering style
+	if config.Style != "auto" {
+		t.Errorf("Expected Style=auto, got %s", config.Style)
+	}
+
+	// Deleted entries go to the trash unless disabled
+	if !config.UseTrash {
+		t.Error("Expected UseTrash to default to true")
+	}
 }
 
 // TestLoadWithEnvironment verifies that environment variables override defaults.
@@ -138,9 +154,281 @@ func TestGetConfigPath(t *testing.T) {
 			t.Error("Config path should be absolute")
 		}
 
-		expectedFilename := ".logmdconfig"
-		if filepath.Base(path) != expectedFilename {
-			t.Errorf("Expected config filename %s, got %s", expectedFilename, filepath.Base(path))
+		if base := filepath.Base(path); base != ".logmdconfig" && base != "config.toml" {
+			t.Errorf("Expected config filename .logmdconfig or config.toml, got %s", base)
+		}
+	}
+}
+
+// TestGetConfigPathPrecedence verifies XDG locations win over the home dotfile.
+func TestGetConfigPathPrecedence(t *testing.T) {
+	home := withTempHome(t)
+	xdgHome := filepath.Join(home, "xdg")
+	os.Setenv("XDG_CONFIG_HOME", xdgHome)
+
+	writeConfig := func(path, content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create config dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write config file: %v", err)
+		}
+	}
+
+	if path := GetConfigPath(); path != "" {
+		t.Errorf("Expected no config file, got %s", path)
+	}
+
+	legacy := filepath.Join(home, ".logmdconfig")
+	dotConfig := filepath.Join(home, ".config", "logmd", "config.toml")
+	xdg := filepath.Join(xdgHome, "logmd", "config.toml")
+
+	// Each file added takes over from the ones before it
+	for _, tc := range []struct{ path, editor string }{
+		{legacy, "legacy"},
+		{dotConfig, "dotconfig"},
+		{xdg, "xdg"},
+	} {
+		writeConfig(tc.path, `editor = "`+tc.editor+`"`)
+
+		if path := GetConfigPath(); path != tc.path {
+			t.Errorf("Expected config path %s, got %s", tc.path, path)
+		}
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.Editor != tc.editor {
+			t.Errorf("Expected editor %s from %s, got %s", tc.editor, tc.path, cfg.Editor)
+		}
+	}
+}
+
+// TestPassphrase tests reading the passphrase from the environment.
+func TestPassphrase(t *testing.T) {
+	original := os.Getenv(PassphraseEnv)
+	defer os.Setenv(PassphraseEnv, original)
+
+	os.Setenv(PassphraseEnv, "correct horse")
+	passphrase, err := Passphrase()
+	if err != nil || passphrase != "correct horse" {
+		t.Errorf("Expected passphrase from %s, got %q, %v", PassphraseEnv, passphrase, err)
+	}
+
+	// Tests don't run on a terminal, so there is nothing to prompt on
+	os.Unsetenv(PassphraseEnv)
+	if _, err := Passphrase(); err == nil {
+		t.Error("Expected error without a passphrase or terminal")
+	}
+}
+
+// TestLoadProfile verifies a selected profile replaces the directory.
+func TestLoadProfile(t *testing.T) {
+	home := withTempHome(t)
+	originalProfile, hadProfile := os.LookupEnv("LOGMD_PROFILE")
+	defer func() {
+		SetProfile("")
+		if hadProfile {
+			os.Setenv("LOGMD_PROFILE", originalProfile)
+		} else {
+			os.Unsetenv("LOGMD_PROFILE")
+		}
+	}()
+	os.Unsetenv("LOGMD_PROFILE")
+
+	content := `directory = "/journal/default"
+
+[profiles]
+work = "/journal/work"
+Personal = "/journal/personal"
+`
+	if err := os.WriteFile(filepath.Join(home, FileName), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	// Without a profile the directory is unchanged
+	config, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if config.Directory != "/journal/default" || config.Profile != "" {
+		t.Errorf("Expected the default directory, got %s (profile %q)", config.Directory, config.Profile)
+	}
+	if names := config.ProfileNames(); len(names) != 2 || names[0] != "personal" || names[1] != "work" {
+		t.Errorf("Expected sorted lowercase profile names, got %v", names)
+	}
+
+	// LOGMD_PROFILE selects a profile, matching names case-insensitively
+	os.Setenv("LOGMD_PROFILE", "Personal")
+	config, err = Load()
+	if err != nil {
+		t.Fatalf("Load() with LOGMD_PROFILE failed: %v", err)
+	}
+	if config.Directory != "/journal/personal" || config.Profile != "personal" {
+		t.Errorf("Expected the personal directory, got %s (profile %q)", config.Directory, config.Profile)
+	}
+
+	// SetProfile takes precedence over the environment
+	SetProfile("work")
+	config, err = Load()
+	if err != nil {
+		t.Fatalf("Load() with SetProfile failed: %v", err)
+	}
+	if config.Directory != "/journal/work" {
+		t.Errorf("Expected the work directory, got %s", config.Directory)
+	}
+
+	// Writing the config back keeps the configured directory
+	if !strings.Contains(Template(config), `directory = "/journal/default"`) {
+		t.Error("Expected the template to keep the configured directory")
+	}
+
+	// A new directory goes to the active profile
+	config.SetDirectory("/journal/office")
+	content = Template(config)
+	if !strings.Contains(content, `work = "/journal/office"`) || !strings.Contains(content, `directory = "/journal/default"`) {
+		t.Errorf("Expected the new directory in the work profile, got:\n%s", content)
+	}
+
+	// Unknown profiles are errors naming the configured ones
+	SetProfile("play")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "personal, work") {
+		t.Errorf("Expected an unknown profile error listing profiles, got %v", err)
+	}
+}
+
+// TestLoadTimezone tests that dates use the configured timezone and that
+// an unknown timezone fails to load.
+func TestLoadTimezone(t *testing.T) {
+	home := withTempHome(t)
+	path := filepath.Join(home, FileName)
+
+	// Unset means local time
+	config, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if config.Location() != time.Local {
+		t.Errorf("Expected local time without a timezone, got %s", config.Location())
+	}
+
+	if err := os.WriteFile(path, []byte("timezone = \"Pacific/Kiritimati\"\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	config, err = Load()
+	if err != nil {
+		t.Fatalf("Load() with a timezone failed: %v", err)
+	}
+	// Kiritimati is UTC+14, so its date is never behind UTC's
+	now := config.Now()
+	if now.Location().String() != "Pacific/Kiritimati" {
+		t.Errorf("Expected Now() in Pacific/Kiritimati, got %s", now.Location())
+	}
+	if _, offset := now.Zone(); offset != 14*60*60 {
+		t.Errorf("Expected a UTC+14 offset, got %d seconds", offset)
+	}
+
+	if err := os.WriteFile(path, []byte("timezone = \"Mars/Olympus_Mons\"\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "invalid timezone \"Mars/Olympus_Mons\"") {
+		t.Errorf("Expected an invalid timezone error, got %v", err)
+	}
+}
+
+// TestLoadDayStartHour tests that Today moves back by day_start_hour and
+// that hours outside 0-23 fail to load.
+func TestLoadDayStartHour(t *testing.T) {
+	home := withTempHome(t)
+	path := filepath.Join(home, FileName)
+
+	if err := os.WriteFile(path, []byte("timezone = \"UTC\"\nday_start_hour = 4\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	config, err := Load()
+	if err != nil {
+		t.Fatalf("Load() with day_start_hour failed: %v", err)
+	}
+	today := config.Today()
+	if gap := config.Now().Sub(today); gap < 4*time.Hour || gap > 4*time.Hour+time.Second {
+		t.Errorf("Expected Today() 4 hours behind Now(), got %s", gap)
+	}
+
+	if err := os.WriteFile(path, []byte("day_start_hour = 24\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "invalid day_start_hour 24") {
+		t.Errorf("Expected an invalid day_start_hour error, got %v", err)
+	}
+}
+
+// TestLoadVerbose verifies that verbose logging explains where settings came from.
+func TestLoadVerbose(t *testing.T) {
+	home := withTempHome(t)
+	path := filepath.Join(home, FileName)
+
+	originalEditor, hadEditor := os.LookupEnv("LOGMD_EDITOR")
+	defer func() {
+		if hadEditor {
+			os.Setenv("LOGMD_EDITOR", originalEditor)
+		} else {
+			os.Unsetenv("LOGMD_EDITOR")
+		}
+	}()
+	os.Setenv("LOGMD_EDITOR", "nano")
+
+	var buf bytes.Buffer
+	logging.SetOutput(&buf)
+	defer logging.SetOutput(os.Stderr)
+	defer logging.SetLevel(logging.LevelQuiet)
+
+	// Quiet by default
+	if _, err := Load(); err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing logged by default, got %q", buf.String())
+	}
+
+	logging.SetLevel(logging.LevelInfo)
+	if _, err := Load(); err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "no config file found") {
+		t.Errorf("Expected the searched paths logged, got:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	if err := os.WriteFile(path, []byte("directory = \"/journal\"\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	if _, err := Load(); err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	for _, want := range []string{
+		"config file: " + path,
+		`LOGMD_EDITOR="nano" overrides editor`,
+		"journal directory: /journal",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected %q in the log, got:\n%s", want, buf.String())
 		}
 	}
 }
+
+// TestLoadErrorsMatchInvalidConfig verifies Load errors can be told apart by scripts.
+func TestLoadErrorsMatchInvalidConfig(t *testing.T) {
+	home := withTempHome(t)
+
+	if err := os.WriteFile(filepath.Join(home, FileName), []byte("day_start_hour = 24\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	_, err := Load()
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("Expected an error matching ErrInvalidConfig, got %v", err)
+	}
+	if err != nil && err.Error() != "invalid day_start_hour 24 (expected an hour from 0 to 23)" {
+		t.Errorf("Expected the message kept as it was, got %q", err.Error())
+	}
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// FilePlaceholder marks where the entry's path goes in EditorArgs.
+const FilePlaceholder = "{file}"
+
+// editorWaitFlags maps GUI editors that return as soon as their window
+// opens to the flag that makes them wait until the file is closed,
+// followed by any other spellings of it.
+var editorWaitFlags = map[string][]string{
+	"code":          {"--wait", "-w"},
+	"code-insiders": {"--wait", "-w"},
+	"codium":        {"--wait", "-w"},
+	"cursor":        {"--wait", "-w"},
+	"subl":          {"--wait", "-w"},
+	"atom":          {"--wait", "-w"},
+	"zed":           {"--wait", "-w"},
+	"mate":          {"--wait", "-w"},
+	"bbedit":        {"--wait", "-w"},
+	"gedit":         {"--wait", "-w"},
+	"kate":          {"--block", "-b"},
+	"gvim":          {"--nofork", "-f"},
+	"mvim":          {"--nofork", "-f"},
+}
+
+// WithWaitFlag returns args with editor's wait flag in front of them, or
+// args unchanged when the editor has no wait flag or args already passes
+// it. editor is the program itself, as EditorCommand runs it, and only its
+// name counts, so "/usr/local/bin/code" is code.
+// Learn: code, subl and friends hand the file to a running window and exit at once.
+// See: https://code.visualstudio.com/docs/editor/command-line#_core-cli-options
+func WithWaitFlag(editor, args string) string {
+	flags, ok := editorWaitFlags[editorName(editor)]
+	if !ok {
+		return args
+	}
+
+	given, _ := SplitArgs(args)
+	for _, arg := range given {
+		if slices.Contains(flags, arg) {
+			return args
+		}
+	}
+	return strings.TrimSpace(flags[0] + " " + args)
+}
+
+// editorName is the editor program's name without its directory or a
+// Windows extension, e.g. "code" for C:\Program Files\code.cmd.
+func editorName(editor string) string {
+	editor = strings.TrimSpace(editor)
+	if editor == "" {
+		return ""
+	}
+	name := filepath.Base(strings.ReplaceAll(editor, "\\", "/"))
+	name = strings.ToLower(name)
+	for _, ext := range []string{".exe", ".cmd", ".bat"} {
+		name = strings.TrimSuffix(name, ext)
+	}
+	return name
+}
+
+// EditorCommand returns the argv that opens file in editor. editor is the
+// program alone, never split, so a path with spaces needs no quoting; its
+// flags belong in args. With args empty it is just the editor and the
+// file; otherwise args is split into
+// arguments after the editor, each {file} in them is replaced by the path,
+// and the path is added at the end when args has no {file}.
+// Learn: Replacing the placeholder after splitting keeps a path with spaces one argument.
+// See: https://pkg.go.dev/os/exec#Command
+func EditorCommand(editor, args, file string) ([]string, error) {
+	split, err := SplitArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("invalid editor_args: %w", err)
+	}
+
+	argv := []string{editor}
+	placed := false
+	for _, arg := range split {
+		if strings.Contains(arg, FilePlaceholder) {
+			arg = strings.ReplaceAll(arg, FilePlaceholder, file)
+			placed = true
+		}
+		argv = append(argv, arg)
+	}
+	if !placed {
+		argv = append(argv, file)
+	}
+	return argv, nil
+}
+
+// SplitArgs splits s into arguments at unquoted whitespace, the way a shell
+// would without expanding anything: single quotes keep everything inside
+// as written, double quotes keep spaces but allow \" and \\, and outside
+// quotes a backslash escapes the next character. An unterminated quote or
+// trailing backslash is an error.
+func SplitArgs(s string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			if quote == '"' && r != '"' && r != '\\' {
+				current.WriteRune('\\')
+			}
+			current.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in %q", quote, s)
+	}
+	if escaped {
+		return nil, fmt.Errorf("trailing backslash in %q", s)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
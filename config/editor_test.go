@@ -0,0 +1,101 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestSplitArgs tests splitting argument strings with quotes and escapes.
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"", nil},
+		{"  --wait  ", []string{"--wait"}},
+		{"--wait --goto {file}:3", []string{"--wait", "--goto", "{file}:3"}},
+		{`-c "set nu" '+normal G'`, []string{"-c", "set nu", "+normal G"}},
+		{`"say \"hi\"" 'it\s raw'`, []string{`say "hi"`, `it\s raw`}},
+		{`a\ b c\\d "e\f"`, []string{"a b", `c\d`, `e\f`}},
+		{`"" x`, []string{"", "x"}},
+		{`--title="My Journal"`, []string{"--title=My Journal"}},
+	}
+
+	for _, tt := range tests {
+		got, err := SplitArgs(tt.input)
+		if err != nil {
+			t.Errorf("SplitArgs(%q) failed: %v", tt.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("SplitArgs(%q) = %q, expected %q", tt.input, got, tt.expected)
+		}
+	}
+
+	for _, input := range []string{`"open`, `'open`, `trailing\`} {
+		if _, err := SplitArgs(input); err == nil {
+			t.Errorf("SplitArgs(%q) expected an error", input)
+		}
+	}
+}
+
+// TestEditorCommand tests placing the file into the editor's arguments.
+func TestEditorCommand(t *testing.T) {
+	file := "/journal/my notes/2024-01-15.md"
+	tests := []struct {
+		editor   string
+		args     string
+		expected []string
+	}{
+		{"vim", "", []string{"vim", file}},
+		{"code", "--wait", []string{"code", "--wait", file}},
+		{"code", "--wait --goto {file}:3", []string{"code", "--wait", "--goto", file + ":3"}},
+		{"emacsclient", "-c '{file}' -a ''", []string{"emacsclient", "-c", file, "-a", ""}},
+		{"/Applications/My Editor/bin/edit", "", []string{"/Applications/My Editor/bin/edit", file}},
+	}
+
+	for _, tt := range tests {
+		got, err := EditorCommand(tt.editor, tt.args, file)
+		if err != nil {
+			t.Errorf("EditorCommand(%q, %q) failed: %v", tt.editor, tt.args, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("EditorCommand(%q, %q) = %q, expected %q", tt.editor, tt.args, got, tt.expected)
+		}
+	}
+
+	if _, err := EditorCommand("code", `--goto "{file}`, file); err == nil {
+		t.Error("Expected an error for an unterminated quote")
+	}
+}
+
+// TestWithWaitFlag tests adding the wait flag for GUI editors.
+func TestWithWaitFlag(t *testing.T) {
+	tests := []struct {
+		editor   string
+		args     string
+		expected string
+	}{
+		{"vim", "", ""},
+		{"nano", "+3", "+3"},
+		{"code", "", "--wait"},
+		{"/usr/local/bin/subl", "{file}:3", "--wait {file}:3"},
+		{`C:\Tools\Code.cmd`, "", "--wait"},
+		{"kate", "", "--block"},
+		{"code", "-w --goto {file}:3", "-w --goto {file}:3"},
+		{"code --wait", "", ""},
+		{"code -w", "", ""},
+		{`C:\Program Files\Microsoft VS Code\bin\code.cmd`, "", "--wait"},
+		{"  ", "", ""},
+		{"atom", "--new-window", "--wait --new-window"},
+		{"gvim", "", "--nofork"},
+		{"emacs", "", ""},
+	}
+
+	for _, tt := range tests {
+		if got := WithWaitFlag(tt.editor, tt.args); got != tt.expected {
+			t.Errorf("WithWaitFlag(%q, %q) = %q, expected %q", tt.editor, tt.args, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"logmd/logging"
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// FileName is the name of the config file in the user's home directory.
+const FileName = ".logmdconfig"
+
+// Keys lists the settings that can be written to the config file, in the
+// order they appear in a generated file.
+var Keys = []string{"directory", "editor", "editor_args", "editor_wait", "preview_lines", "preview_from", "rendered_preview", "page_size", "style", "code_theme", "wiki_links", "word_wrap", "word_goal", "layout", "date_format", "timezone", "day_start_hour", "git_auto_commit", "use_trash", "default_command", "encrypt", "front_matter", "front_matter_fields"}
+
+// WritePath returns the config file that should be written: the one in use
+// if there is one, otherwise the legacy ~/.logmdconfig.
+func WritePath() (string, error) {
+	if configPath := GetConfigPath(); configPath != "" {
+		return configPath, nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, FileName), nil
+}
+
+// Template renders cfg as a commented TOML config file.
+// Learn: strconv.Quote produces escapes that are also valid in TOML basic strings.
+// See: https://toml.io/en/v1.0.0#string
+func Template(cfg *Config) string {
+	var b strings.Builder
+
+	b.WriteString("# logmd configuration\n")
+	b.WriteString("# Environment variables (LOGMD_*) take precedence over these values.\n\n")
+
+	// The configured directory, not the one an active profile put in its place
+	directory := cfg.Directory
+	if cfg.baseDirectory != "" {
+		directory = cfg.baseDirectory
+	}
+	b.WriteString("# Directory where journal entries are stored\n")
+	fmt.Fprintf(&b, "directory = %s\n\n", strconv.Quote(directory))
+
+	b.WriteString("# Editor program used to open entries; put its flags in editor_args\n")
+	fmt.Fprintf(&b, "editor = %s\n\n", strconv.Quote(cfg.Editor))
+
+	b.WriteString("# Arguments for the editor, quoted like a shell, with {file} where the entry goes,\n")
+	b.WriteString("# e.g. \"--wait --goto {file}:3\" (empty passes just the file)\n")
+	fmt.Fprintf(&b, "editor_args = %s\n\n", strconv.Quote(cfg.EditorArgs))
+
+	b.WriteString("# Pass the wait flag to GUI editors that otherwise return as soon as their window\n")
+	b.WriteString("# opens (code, subl, atom, ...), so the entry is saved before logmd carries on\n")
+	fmt.Fprintf(&b, "editor_wait = %t\n\n", cfg.EditorWait)
+
+	b.WriteString("# Number of lines shown when a timeline entry is expanded\n")
+	fmt.Fprintf(&b, "preview_lines = %d\n\n", cfg.PreviewLines)
+
+	b.WriteString("# Where previews start: \"after_title\" (below the # heading) or \"first_content\"\n")
+	b.WriteString("# (the first line that isn't blank or a heading, skipping ## subheadings)\n")
+	fmt.Fprintf(&b, "preview_from = %s\n\n", strconv.Quote(cfg.PreviewFrom))
+
+	b.WriteString("# Show timeline previews rendered, with bold, italics and code styled, instead of raw markdown\n")
+	fmt.Fprintf(&b, "rendered_preview = %t\n\n", cfg.RenderedPreview)
+
+	b.WriteString("# Entries pgup/pgdown move in the timeline (0 pages by a screenful)\n")
+	fmt.Fprintf(&b, "page_size = %d\n\n", cfg.PageSize)
+
+	b.WriteString("# Glamour style for rendered entries (auto, dark, light, dracula, ...)\n")
+	fmt.Fprintf(&b, "style = %s\n\n", strconv.Quote(cfg.Style))
+
+	b.WriteString("# Syntax highlighting theme for code blocks, e.g. \"monokai\" (empty uses the style's own)\n")
+	b.WriteString("# Run 'logmd themes' to list the available themes\n")
+	fmt.Fprintf(&b, "code_theme = %s\n\n", strconv.Quote(cfg.CodeTheme))
+
+	b.WriteString("# Show [[YYYY-MM-DD]] references to other entries as links ('logmd links --check' verifies them)\n")
+	fmt.Fprintf(&b, "wiki_links = %t\n\n", cfg.WikiLinks)
+
+	b.WriteString("# Column width for rendered entries (0 uses the terminal width)\n")
+	fmt.Fprintf(&b, "word_wrap = %d\n\n", cfg.WordWrap)
+
+	b.WriteString("# Daily word goal; 'logmd today' shows progress after the editor closes (0 disables it)\n")
+	fmt.Fprintf(&b, "word_goal = %d\n\n", cfg.WordGoal)
+
+	b.WriteString("# How entries are stored: \"flat\" or \"nested\" (YYYY/MM/ subdirectories)\n")
+	b.WriteString("# Use 'logmd migrate --to <layout>' to move existing entries when changing it\n")
+	fmt.Fprintf(&b, "layout = %s\n\n", strconv.Quote(cfg.Layout))
+
+	b.WriteString("# Go time layout for entry filenames, e.g. \"2006_01_02\" or \"2006-01-02-Monday\"\n")
+	b.WriteString("# Existing entries are not renamed when changing it\n")
+	fmt.Fprintf(&b, "date_format = %s\n\n", strconv.Quote(cfg.DateFormat))
+
+	b.WriteString("# IANA timezone deciding which day is today, e.g. \"America/New_York\"\n")
+	b.WriteString("# Empty uses the computer's local timezone\n")
+	fmt.Fprintf(&b, "timezone = %s\n\n", strconv.Quote(cfg.Timezone))
+
+	b.WriteString("# Hour (0-23) a new journal day starts; with 4, writing at 2am adds to yesterday\n")
+	fmt.Fprintf(&b, "day_start_hour = %d\n\n", cfg.DayStartHour)
+
+	b.WriteString("# Commit each entry after today, edit or note saves it (needs a git repository)\n")
+	fmt.Fprintf(&b, "git_auto_commit = %t\n\n", cfg.GitAutoCommit)
+
+	b.WriteString("# Move deleted entries into .trash/ so 'logmd trash restore' can bring them back\n")
+	fmt.Fprintf(&b, "use_trash = %t\n\n", cfg.UseTrash)
+
+	b.WriteString("# Command run by a bare 'logmd', with any arguments, e.g. \"timeline\" or \"recent\"\n")
+	b.WriteString("# Empty shows the help\n")
+	fmt.Fprintf(&b, "default_command = %s\n\n", strconv.Quote(cfg.DefaultCommand))
+
+	b.WriteString("# Encrypt entries on disk; the passphrase comes from LOGMD_PASSPHRASE or a prompt\n")
+	b.WriteString("# Use 'logmd encrypt' to encrypt entries written before enabling it\n")
+	fmt.Fprintf(&b, "encrypt = %t\n\n", cfg.Encrypt)
+
+	b.WriteString("# Start new entries with a YAML front matter block holding these fields\n")
+	b.WriteString("# \"date\" is filled in with the entry date and \"tags\" with an empty list\n")
+	fmt.Fprintf(&b, "front_matter = %t\n", cfg.FrontMatter)
+	quotedFields := make([]string, len(cfg.FrontMatterFields))
+	for i, field := range cfg.FrontMatterFields {
+		quotedFields[i] = strconv.Quote(field)
+	}
+	fmt.Fprintf(&b, "front_matter_fields = [%s]\n", strings.Join(quotedFields, ", "))
+
+	// Tables must come after all top-level keys
+	b.WriteString("\n# Named journal directories; select one with --profile <name> or LOGMD_PROFILE\n")
+	if len(cfg.Profiles) == 0 {
+		b.WriteString("# [profiles]\n")
+		b.WriteString("# work = \"/path/to/work-journal\"\n")
+	} else {
+		b.WriteString("[profiles]\n")
+		for _, name := range cfg.ProfileNames() {
+			fmt.Fprintf(&b, "%s = %s\n", tomlKey(name), strconv.Quote(cfg.Profiles[name]))
+		}
+	}
+
+	b.WriteString("\n# Timeline keybindings by action; unlisted actions keep their defaults\n")
+	if len(cfg.KeyBindings) == 0 {
+		b.WriteString("# [keys]\n")
+		b.WriteString("# up = [\"k\", \"up\"]\n")
+		b.WriteString("# down = [\"j\", \"down\"]\n")
+		return b.String()
+	}
+
+	b.WriteString("[keys]\n")
+	actions := make([]string, 0, len(cfg.KeyBindings))
+	for action := range cfg.KeyBindings {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	for _, action := range actions {
+		quoted := make([]string, len(cfg.KeyBindings[action]))
+		for i, key := range cfg.KeyBindings[action] {
+			quoted[i] = strconv.Quote(key)
+		}
+		fmt.Fprintf(&b, "%s = [%s]\n", tomlKey(action), strings.Join(quoted, ", "))
+	}
+
+	return b.String()
+}
+
+// tomlKey returns key as written in a TOML table: bare when it only holds
+// letters, digits, underscores and dashes, and quoted otherwise, so a name
+// such as "work notes" still parses.
+// See: https://toml.io/en/v1.0.0#keys
+func tomlKey(key string) string {
+	for _, r := range key {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-') {
+			return strconv.Quote(key)
+		}
+	}
+	if key == "" {
+		return `""`
+	}
+	return key
+}
+
+// Set updates a single key in the config file at path, creating the file if
+// needed. Other keys in the file are preserved, though comments are not.
+// Learn: A fresh Viper instance without defaults or env binding only holds
+// what is in the file, so writing it back doesn't leak other sources.
+// See: https://github.com/spf13/viper#writing-config-files
+func Set(path, key, value string) error {
+	parsed, err := parseValue(key, value)
+	if err != nil {
+		return err
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("toml")
+	if err := v.ReadInConfig(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	v.Set(key, parsed)
+	logging.Debugf("write %s (set %s)", path, key)
+	if err := v.WriteConfigAs(path); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+// parseValue converts a command-line value to the type stored for key.
+func parseValue(key, value string) (any, error) {
+	switch key {
+	case "directory", "editor", "style":
+		if strings.TrimSpace(value) == "" {
+			return nil, fmt.Errorf("%s cannot be empty", key)
+		}
+		return value, nil
+	case "editor_args":
+		if _, err := SplitArgs(value); err != nil {
+			return nil, fmt.Errorf("invalid editor_args value: %w", err)
+		}
+		return strings.TrimSpace(value), nil
+	case "preview_lines":
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid preview_lines value: %s (must be a positive integer)", value)
+		}
+		return n, nil
+	case "preview_from":
+		if value != "after_title" && value != "first_content" {
+			return nil, fmt.Errorf("invalid preview_from value: %s (expected after_title or first_content)", value)
+		}
+		return value, nil
+	case "layout":
+		if value != "flat" && value != "nested" {
+			return nil, fmt.Errorf("invalid layout value: %s (expected flat or nested)", value)
+		}
+		return value, nil
+	case "date_format":
+		if strings.TrimSpace(value) == "" {
+			return nil, fmt.Errorf("%s cannot be empty", key)
+		}
+		return vault.ParseDateFormat(value)
+	case "code_theme":
+		if value != "" && !markdown.IsCodeTheme(value) {
+			return nil, fmt.Errorf("invalid code_theme value: %s (run 'logmd themes' to list them)", value)
+		}
+		return value, nil
+	case "default_command":
+		return strings.TrimSpace(value), nil
+	case "timezone":
+		if value == "" {
+			return value, nil
+		}
+		if _, err := time.LoadLocation(value); err != nil {
+			return nil, fmt.Errorf("invalid timezone value: %s (expected an IANA name such as America/New_York)", value)
+		}
+		return value, nil
+	case "day_start_hour":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 || n > 23 {
+			return nil, fmt.Errorf("invalid day_start_hour value: %s (must be an hour from 0 to 23)", value)
+		}
+		return n, nil
+	case "editor_wait", "rendered_preview", "wiki_links", "git_auto_commit", "use_trash", "encrypt", "front_matter":
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %s (expected true or false)", key, value)
+		}
+		return enabled, nil
+	case "front_matter_fields":
+		return parseFrontMatterFields(value)
+	case "page_size", "word_wrap", "word_goal":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid %s value: %s (must be a non-negative integer)", key, value)
+		}
+		return n, nil
+	default:
+		return nil, fmt.Errorf("unknown config key: %s (valid keys: %s)", key, strings.Join(Keys, ", "))
+	}
+}
+
+// frontMatterFieldPattern matches a plain YAML key usable as a front matter field.
+var frontMatterFieldPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// parseFrontMatterFields splits a comma-separated field list such as
+// "date, mood, tags", rejecting empty lists, duplicates and names that
+// would need quoting in YAML.
+func parseFrontMatterFields(value string) ([]string, error) {
+	var fields []string
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !frontMatterFieldPattern.MatchString(field) {
+			return nil, fmt.Errorf("invalid front matter field: %q (use letters, digits, _ and -)", field)
+		}
+		if slices.Contains(fields, field) {
+			return nil, fmt.Errorf("duplicate front matter field: %s", field)
+		}
+		fields = append(fields, field)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("front_matter_fields cannot be empty")
+	}
+	return fields, nil
+}
@@ -0,0 +1,188 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// withTempHome points HOME at a fresh temp directory and clears
+// XDG_CONFIG_HOME for the duration of a test.
+func withTempHome(t *testing.T) string {
+	t.Helper()
+
+	tmpDir, err := os.MkdirTemp("", "logmd-config-file-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+
+	originalHome := os.Getenv("HOME")
+	originalXDG := os.Getenv("XDG_CONFIG_HOME")
+	os.Setenv("HOME", tmpDir)
+	os.Unsetenv("XDG_CONFIG_HOME")
+	t.Cleanup(func() {
+		os.Setenv("HOME", originalHome)
+		if originalXDG != "" {
+			os.Setenv("XDG_CONFIG_HOME", originalXDG)
+		}
+		os.RemoveAll(tmpDir)
+	})
+
+	return tmpDir
+}
+
+// TestTemplateRoundTrip verifies a generated file loads back to the same values.
+func TestTemplateRoundTrip(t *testing.T) {
+	home := withTempHome(t)
+
+	want := &Config{
+		Directory:         `/journal/with "quotes"`,
+		Editor:            "code --wait",
+		EditorArgs:        `--goto "{file}:3"`,
+		EditorWait:        true,
+		PreviewLines:      9,
+		PreviewFrom:       "first_content",
+		RenderedPreview:   true,
+		PageSize:          15,
+		Style:             "dracula",
+		CodeTheme:         "monokai",
+		WikiLinks:         true,
+		WordWrap:          100,
+		WordGoal:          500,
+		Layout:            "nested",
+		DateFormat:        "2006_01_02",
+		Timezone:          "America/New_York",
+		DayStartHour:      4,
+		GitAutoCommit:     true,
+		UseTrash:          false,
+		DefaultCommand:    "list --limit 7",
+		Encrypt:           true,
+		FrontMatter:       true,
+		FrontMatterFields: []string{"date", "weather"},
+		KeyBindings:       map[string][]string{"up": {"w", "up"}, "quit": {"x"}},
+		Profiles:          map[string]string{"work": "/journal/work", "home": "/journal/home", "work notes": "/journal/notes"},
+	}
+	content := Template(want)
+	if !strings.Contains(content, `"work notes" = "/journal/notes"`) {
+		t.Error("Expected a profile name with a space to be quoted")
+	}
+	if !strings.Contains(content, "# Number of lines shown") {
+		t.Error("Expected the template to explain each setting")
+	}
+
+	if err := os.WriteFile(filepath.Join(home, FileName), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	got, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if got.Location().String() != "America/New_York" {
+		t.Errorf("Expected the timezone to be loaded, got %s", got.Location())
+	}
+	got.location = nil
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %+v, got %+v", *want, *got)
+	}
+}
+
+// TestSet verifies single keys are updated without touching the others.
+func TestSet(t *testing.T) {
+	home := withTempHome(t)
+	path := filepath.Join(home, FileName)
+
+	// Creates the file when it doesn't exist yet
+	if err := Set(path, "editor", "nano"); err != nil {
+		t.Fatalf("Set() on a missing file failed: %v", err)
+	}
+
+	content := "directory = \"/my/journal\"\ncustom_key = \"kept\"\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	if err := Set(path, "preview_lines", "12"); err != nil {
+		t.Fatalf("Set() failed: %v", err)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.PreviewLines != 12 || cfg.Directory != "/my/journal" {
+		t.Errorf("Expected preview_lines=12 and directory kept, got %+v", *cfg)
+	}
+	written, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read config file: %v", err)
+	}
+	if !strings.Contains(string(written), "custom_key") {
+		t.Errorf("Expected unrelated keys to be preserved, got:\n%s", written)
+	}
+
+	// List values are written as TOML arrays
+	if err := Set(path, "front_matter_fields", "date, energy ,tags"); err != nil {
+		t.Fatalf("Set() of a list failed: %v", err)
+	}
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if !reflect.DeepEqual(cfg.FrontMatterFields, []string{"date", "energy", "tags"}) {
+		t.Errorf("Expected front_matter_fields [date energy tags], got %v", cfg.FrontMatterFields)
+	}
+
+	// Invalid values and unknown keys are rejected before writing
+	for _, tc := range [][2]string{
+		{"preview_lines", "0"},
+		{"preview_lines", "five"},
+		{"word_wrap", "-1"},
+		{"word_goal", "-500"},
+		{"page_size", "-10"},
+		{"editor_args", `--goto "{file}`},
+		{"layout", "yearly"},
+		{"code_theme", "not-a-theme"},
+		{"editor_wait", "sometimes"},
+		{"rendered_preview", "2"},
+		{"wiki_links", "yes please"},
+		{"preview_from", "title"},
+		{"directory", " "},
+		{"date_format", "2006-01"},
+		{"date_format", "2006/01/02"},
+		{"timezone", "America/Springfield"},
+		{"day_start_hour", "24"},
+		{"day_start_hour", "-1"},
+		{"timezone", "EST5EDT/extra"},
+		{"front_matter", "maybe"},
+		{"front_matter_fields", " , "},
+		{"front_matter_fields", "date, date"},
+		{"front_matter_fields", "my field"},
+		{"colour", "red"},
+	} {
+		if err := Set(path, tc[0], tc[1]); err == nil {
+			t.Errorf("Expected error for %s=%q", tc[0], tc[1])
+		}
+	}
+}
+
+// TestLoadKeyBindings verifies the [keys] table accepts a single key or a list.
+func TestLoadKeyBindings(t *testing.T) {
+	home := withTempHome(t)
+
+	content := "[keys]\nup = \"w\"\ndown = [\"s\", \"down\"]\n"
+	if err := os.WriteFile(filepath.Join(home, FileName), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+
+	want := map[string][]string{"up": {"w"}, "down": {"s", "down"}}
+	if !reflect.DeepEqual(cfg.KeyBindings, want) {
+		t.Errorf("Expected key bindings %v, got %v", want, cfg.KeyBindings)
+	}
+}
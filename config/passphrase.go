@@ -0,0 +1,67 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// PassphraseEnv is the environment variable holding the passphrase for
+// encrypted entries. It is deliberately not a config file key.
+const PassphraseEnv = "LOGMD_PASSPHRASE"
+
+// Passphrase returns the passphrase for encrypted entries: LOGMD_PASSPHRASE
+// when set, otherwise one typed at a prompt when stdin is a terminal.
+// Learn: term.ReadPassword reads a line without echoing it.
+// See: https://pkg.go.dev/golang.org/x/term#ReadPassword
+func Passphrase() (string, error) {
+	if passphrase := os.Getenv(PassphraseEnv); passphrase != "" {
+		return passphrase, nil
+	}
+
+	return PromptPassphrase("🔒 Journal passphrase: ")
+}
+
+// NewPassphrase returns LOGMD_PASSPHRASE, or a passphrase typed twice so a
+// typo can't lock the journal. It is used while nothing is encrypted yet to
+// check a passphrase against.
+func NewPassphrase() (string, error) {
+	if passphrase := os.Getenv(PassphraseEnv); passphrase != "" {
+		return passphrase, nil
+	}
+
+	passphrase, err := PromptPassphrase("🔒 Passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	confirm, err := PromptPassphrase("🔒 Repeat passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if passphrase != confirm {
+		return "", fmt.Errorf("passphrases do not match")
+	}
+	return passphrase, nil
+}
+
+// PromptPassphrase reads a passphrase typed at prompt without echoing it.
+// It fails when stdin is not a terminal rather than waiting on a pipe.
+func PromptPassphrase(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("no passphrase is available (set %s)", PassphraseEnv)
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+	passphrase, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase: %w", err)
+	}
+	if len(passphrase) == 0 {
+		return "", errors.New("passphrase cannot be empty")
+	}
+	return string(passphrase), nil
+}
@@ -0,0 +1,265 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/spf13/viper"
+)
+
+// Problem describes one mistake found in a config file.
+type Problem struct {
+	// Key is the offending key as written in the file (lowercased by Viper)
+	Key string
+	// Message explains what is wrong with it
+	Message string
+	// Suggestion tells the user how to fix it
+	Suggestion string
+}
+
+// String formats the problem as "key: message (suggestion)".
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s (%s)", p.Key, p.Message, p.Suggestion)
+}
+
+// Validate checks the config file at path for unknown keys, values of the
+// wrong type and values out of range, returning the problems sorted by key.
+// Viper ignores keys it doesn't know when loading, so without this a typo
+// such as "previewlines" silently leaves the default in place. An error is
+// returned only when the file can't be read or isn't valid TOML.
+// Learn: Viper decodes into structs with mapstructure, matching keys to tags;
+// DecoderConfig.ErrorUnused makes keys no field claims an error.
+// See: https://pkg.go.dev/github.com/go-viper/mapstructure/v2#DecoderConfig
+func Validate(path string) ([]Problem, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("toml")
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var problems []Problem
+
+	// Unknown keys are the ones a strict decode rejects as unused
+	for _, key := range unknownKeys(decodeStrict(v)) {
+		suggestion := "remove it"
+		if known := closestKey(key); known != "" {
+			suggestion = fmt.Sprintf("did you mean %s?", known)
+		}
+		problems = append(problems, Problem{Key: key, Message: "unknown key", Suggestion: suggestion})
+	}
+
+	// Known keys are checked for type, then for range like 'config set' does
+	fields := configFields()
+	for key, raw := range v.AllSettings() {
+		field, ok := fields[key]
+		if !ok {
+			continue
+		}
+		fix := fmt.Sprintf("use 'logmd config set %s <value>' to write a valid value", key)
+		switch key {
+		case "keys":
+			fix = "list keys per action, e.g. up = [\"k\", \"up\"]"
+		case "profiles":
+			fix = "give each profile a directory, e.g. work = \"/path/to/work-journal\""
+		}
+		if expected, ok := checkType(field.Type, raw); !ok {
+			problems = append(problems, Problem{
+				Key:        key,
+				Message:    fmt.Sprintf("expected %s, got %v", expected, formatRaw(raw)),
+				Suggestion: fix,
+			})
+			continue
+		}
+		if !slices.Contains(Keys, key) {
+			continue
+		}
+		if _, err := parseValue(key, rawString(raw)); err != nil {
+			problems = append(problems, Problem{Key: key, Message: err.Error(), Suggestion: fix})
+		}
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Key < problems[j].Key
+	})
+	return problems, nil
+}
+
+// decodeStrict decodes v's settings into a Config the way Load does, but
+// fails on keys no Config field claims. The error matches ErrInvalidConfig.
+// See: https://pkg.go.dev/github.com/spf13/viper#DecoderConfigOption
+func decodeStrict(v *viper.Viper) error {
+	var config Config
+	err := v.Unmarshal(&config, func(dc *mapstructure.DecoderConfig) {
+		dc.ErrorUnused = true
+	})
+	if err != nil {
+		return &loadError{err: err}
+	}
+	return nil
+}
+
+// unknownKeys returns the keys a decodeStrict error rejects as unused.
+// Mapstructure lists them only in its message, after "has invalid keys: ",
+// and joins that with any other decode errors one per line.
+func unknownKeys(err error) []string {
+	if err == nil {
+		return nil
+	}
+
+	var keys []string
+	for _, line := range strings.Split(err.Error(), "\n") {
+		if _, list, ok := strings.Cut(line, "'' has invalid keys: "); ok {
+			keys = append(keys, strings.Split(list, ", ")...)
+		}
+	}
+	return keys
+}
+
+// configFields maps each mapstructure key of Config to its struct field.
+// Learn: Struct tags are read at runtime through reflect.StructField.Tag.
+// See: https://pkg.go.dev/reflect#StructTag.Get
+func configFields() map[string]reflect.StructField {
+	t := reflect.TypeOf(Config{})
+	fields := make(map[string]reflect.StructField, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fields[field.Tag.Get("mapstructure")] = field
+	}
+	return fields
+}
+
+// checkType reports whether a value decoded from TOML suits a field of type
+// t, along with a description of what t expects.
+func checkType(t reflect.Type, raw any) (string, bool) {
+	switch t.Kind() {
+	case reflect.String:
+		_, ok := raw.(string)
+		return "a string", ok
+	case reflect.Int:
+		_, ok := raw.(int64)
+		return "an integer", ok
+	case reflect.Bool:
+		_, ok := raw.(bool)
+		return "true or false", ok
+	case reflect.Slice:
+		return "a list of strings", isStringList(raw)
+	case reflect.Map:
+		expected := "a table of string lists"
+		if t.Elem().Kind() == reflect.String {
+			expected = "a table of strings"
+		}
+		table, ok := raw.(map[string]any)
+		if ok {
+			for _, value := range table {
+				// A [keys] action may name a single key instead of a list
+				_, single := value.(string)
+				if _, valid := checkType(t.Elem(), value); !valid && !single {
+					ok = false
+					break
+				}
+			}
+		}
+		return expected, ok
+	}
+	return t.String(), true
+}
+
+// isStringList reports whether raw is a TOML array holding only strings.
+func isStringList(raw any) bool {
+	list, ok := raw.([]any)
+	if !ok {
+		return false
+	}
+	for _, item := range list {
+		if _, ok := item.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// rawString converts a type-checked value to the form 'config set' takes.
+func rawString(raw any) string {
+	if list, ok := raw.([]any); ok {
+		items := make([]string, len(list))
+		for i, item := range list {
+			items[i] = fmt.Sprint(item)
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(raw)
+}
+
+// formatRaw describes a value of the wrong type, quoting strings so
+// preview_lines = "5" reads differently from preview_lines = 5.
+func formatRaw(raw any) string {
+	switch value := raw.(type) {
+	case string:
+		return fmt.Sprintf("string %q", value)
+	case []any:
+		return fmt.Sprintf("list %v", value)
+	case map[string]any:
+		return "a table"
+	}
+	return fmt.Sprintf("%T %v", raw, raw)
+}
+
+// closestKey returns the known key nearest to an unknown one, or "" when
+// none is close. Separators and case are ignored first, so "previewlines"
+// and "Preview-Lines" find preview_lines; otherwise a key within two edits
+// is suggested.
+func closestKey(unknown string) string {
+	normalize := func(s string) string {
+		return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(s))
+	}
+
+	fields := configFields()
+	known := make([]string, 0, len(fields))
+	for key := range fields {
+		known = append(known, key)
+	}
+	sort.Strings(known)
+
+	for _, key := range known {
+		if normalize(key) == normalize(unknown) {
+			return key
+		}
+	}
+
+	best, bestDistance := "", 3
+	for _, key := range known {
+		if d := EditDistance(normalize(unknown), normalize(key)); d < bestDistance {
+			best, bestDistance = key, d
+		}
+	}
+	return best
+}
+
+// EditDistance returns the number of single-character edits between a and
+// b, used to suggest the closest key or date to a mistyped one.
+// Learn: Keeping only the previous row makes the table O(len(b)) in memory.
+// See: https://en.wikipedia.org/wiki/Levenshtein_distance
+func EditDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr := make([]int, len(b)+1)
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev = curr
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// TestValidate tests reporting unknown keys, wrong types and bad values.
+func TestValidate(t *testing.T) {
+	home := withTempHome(t)
+	path := filepath.Join(home, FileName)
+
+	testCases := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "Valid",
+			content:  Template(&Config{Directory: "/journal", Editor: "vim", PreviewLines: 5, PreviewFrom: "after_title", Style: "auto", Layout: "flat", DateFormat: "2006-01-02", FrontMatterFields: DefaultFrontMatterFields}),
+			expected: nil,
+		},
+		{
+			name:     "UnknownKey",
+			content:  "previewlines = 8\n",
+			expected: []string{"previewlines: unknown key (did you mean preview_lines?)"},
+		},
+		{
+			name:     "Typo",
+			content:  "editr = \"code\"\n",
+			expected: []string{"editr: unknown key (did you mean editor?)"},
+		},
+		{
+			name:     "NoSuggestion",
+			content:  "colour_scheme = \"dark\"\n",
+			expected: []string{"colour_scheme: unknown key (remove it)"},
+		},
+		{
+			name:     "WrongType",
+			content:  "preview_lines = \"8\"\nencrypt = 1\n",
+			expected: []string{"encrypt: expected true or false", "preview_lines: expected an integer, got string \"8\""},
+		},
+		{
+			name:     "OutOfRange",
+			content:  "preview_lines = 0\nlayout = \"tree\"\nfront_matter_fields = [\"date\", \"date\"]\n",
+			expected: []string{"front_matter_fields: duplicate front matter field", "layout: invalid layout value: tree", "preview_lines: invalid preview_lines value: 0"},
+		},
+		{
+			name:     "UnknownAndWrongType",
+			content:  "preview_lines = \"abc\"\nstyl = \"dark\"\n",
+			expected: []string{"preview_lines: expected an integer", "styl: unknown key (did you mean style?)"},
+		},
+		{
+			name:     "SingleKey",
+			content:  "[keys]\nup = \"w\"\ndown = [\"s\", \"down\"]\n",
+			expected: nil,
+		},
+		{
+			name:     "KeysTable",
+			content:  "[keys]\nup = \"w\"\ndown = [\"s\", 2]\nquit = 3\n",
+			expected: []string{"keys: expected a table of string lists"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := os.WriteFile(path, []byte(tc.content), 0644); err != nil {
+				t.Fatalf("Failed to write config file: %v", err)
+			}
+
+			problems, err := Validate(path)
+			if err != nil {
+				t.Fatalf("Validate() failed: %v", err)
+			}
+			if len(problems) != len(tc.expected) {
+				t.Fatalf("Expected %d problems, got %v", len(tc.expected), problems)
+			}
+			for i, problem := range problems {
+				if !strings.HasPrefix(problem.String(), tc.expected[i]) {
+					t.Errorf("Problem %d: expected %q, got %q", i, tc.expected[i], problem.String())
+				}
+			}
+		})
+	}
+
+	// The strict decode behind unknown keys reports them as invalid config
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err := v.ReadConfig(strings.NewReader("previewlines = 8\nstyl = \"dark\"\n")); err != nil {
+		t.Fatalf("Failed to read config: %v", err)
+	}
+	err := decodeStrict(v)
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("Expected ErrInvalidConfig, got %v", err)
+	}
+	if keys := unknownKeys(err); strings.Join(keys, ",") != "previewlines,styl" {
+		t.Errorf("Expected both unknown keys, got %v", keys)
+	}
+
+	// Syntax errors are errors rather than problems
+	if err := os.WriteFile(path, []byte("preview_lines = \n"), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	if _, err := Validate(path); err == nil {
+		t.Error("Expected error for invalid TOML")
+	}
+}
+
+// TestEditDistance tests counting single-character edits.
+func TestEditDistance(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{"style", "style", 0},
+		{"styl", "style", 1},
+		{"stlye", "style", 2},
+		{"", "abc", 3},
+		{"2024-01-51", "2024-01-15", 2},
+	}
+	for _, tc := range testCases {
+		if got := EditDistance(tc.a, tc.b); got != tc.want {
+			t.Errorf("EditDistance(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
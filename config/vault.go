@@ -0,0 +1,77 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"logmd/vault"
+)
+
+// OpenVault returns the journal directory as a vault set up from c: layout,
+// filename date format, new-entry front matter, timezone, day start and
+// trash. With create set a missing directory is created; otherwise it is an
+// error wrapping vault.ErrNoDirectory. The passphrase isn't asked for until
+// UnlockVault is called.
+// Learn: One constructor keeps every command's vault configured the same way.
+func (c *Config) OpenVault(create bool) (*vault.Vault, error) {
+	var v *vault.Vault
+	var err error
+	if create {
+		v, err = vault.NewWithLayout(c.Directory, vault.Layout(c.Layout))
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize journal directory: %w", err)
+		}
+	} else {
+		v, err = vault.OpenWithLayout(c.Directory, vault.Layout(c.Layout))
+		if errors.Is(err, vault.ErrNoDirectory) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to open journal directory: %w", err)
+		}
+	}
+
+	format, err := vault.ParseDateFormat(c.DateFormat)
+	if err != nil {
+		return nil, err
+	}
+	v.DateFormat = format
+	if c.FrontMatter {
+		v.FrontMatter = c.FrontMatterFields
+	}
+	v.Location = c.Location()
+	v.DayStartHour = c.DayStartHour
+	v.DeletePermanently = !c.UseTrash
+	return v, nil
+}
+
+// UnlockVault sets v's passphrase when encrypt is enabled, asking for it
+// if LOGMD_PASSPHRASE isn't set. The passphrase must open the vault's
+// encrypted entries; while there are none yet a typed one is asked for
+// twice instead, so a typo never ends up encrypting new entries. Callers
+// that only sometimes read content call it once they know they will.
+func (c *Config) UnlockVault(v *vault.Vault) error {
+	if !c.Encrypt || v.Passphrase != "" {
+		return nil
+	}
+
+	encrypted, err := v.HasEncryptedEntries()
+	if err != nil {
+		return err
+	}
+
+	var passphrase string
+	if encrypted {
+		passphrase, err = Passphrase()
+	} else {
+		passphrase, err = NewPassphrase()
+	}
+	if err != nil {
+		return err
+	}
+	if err := v.CheckPassphrase(passphrase); err != nil {
+		return err
+	}
+	v.Passphrase = passphrase
+	return nil
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"logmd/vault"
+)
+
+// TestOpenVault verifies the vault picks up the journal settings.
+func TestOpenVault(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-config-vault-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	cfg := &Config{
+		Directory:         filepath.Join(tmpDir, "journal"),
+		Layout:            "nested",
+		DateFormat:        "2006_01_02",
+		DayStartHour:      4,
+		UseTrash:          false,
+		FrontMatter:       true,
+		FrontMatterFields: []string{"date"},
+	}
+
+	// A missing directory is only created when asked
+	if _, err := cfg.OpenVault(false); !errors.Is(err, vault.ErrNoDirectory) {
+		t.Errorf("Expected ErrNoDirectory, got %v", err)
+	}
+	v, err := cfg.OpenVault(true)
+	if err != nil {
+		t.Fatalf("OpenVault(true) failed: %v", err)
+	}
+	if _, err := cfg.OpenVault(false); err != nil {
+		t.Errorf("Expected the created directory to open, got %v", err)
+	}
+
+	if v.Layout != vault.LayoutNested || v.DateFormat != "2006_01_02" || v.DayStartHour != 4 || !v.DeletePermanently {
+		t.Errorf("Expected the settings applied, got %+v", v)
+	}
+	if len(v.FrontMatter) != 1 || v.Location == nil {
+		t.Errorf("Expected front matter and a location, got %v, %v", v.FrontMatter, v.Location)
+	}
+
+	cfg.DateFormat = "2006-01"
+	if _, err := cfg.OpenVault(false); err == nil {
+		t.Error("Expected error for an invalid date format")
+	}
+}
+
+// TestUnlockVault verifies the passphrase is only set for encrypted journals.
+func TestUnlockVault(t *testing.T) {
+	original, hadPassphrase := os.LookupEnv(PassphraseEnv)
+	defer func() {
+		if hadPassphrase {
+			os.Setenv(PassphraseEnv, original)
+		} else {
+			os.Unsetenv(PassphraseEnv)
+		}
+	}()
+	os.Setenv(PassphraseEnv, "secret")
+
+	tmpDir, err := os.MkdirTemp("", "logmd-config-vault-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	if err := (&Config{}).UnlockVault(v); err != nil || v.Passphrase != "" {
+		t.Errorf("Expected no passphrase without encrypt, got %q, %v", v.Passphrase, err)
+	}
+	if err := (&Config{Encrypt: true}).UnlockVault(v); err != nil || v.Passphrase != "secret" {
+		t.Errorf("Expected the passphrase from %s, got %q, %v", PassphraseEnv, v.Passphrase, err)
+	}
+
+	// Once an entry is encrypted, another passphrase is refused
+	if err := v.WriteEntry("2024-01-15", []byte("# Secret\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+	os.Setenv(PassphraseEnv, "secert")
+	v.Passphrase = ""
+	if err := (&Config{Encrypt: true}).UnlockVault(v); !errors.Is(err, vault.ErrDecrypt) || v.Passphrase != "" {
+		t.Errorf("Expected ErrDecrypt and no passphrase for a mistyped one, got %q, %v", v.Passphrase, err)
+	}
+}
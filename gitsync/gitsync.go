@@ -0,0 +1,72 @@
+// Package gitsync records journal changes in git for logmd.
+// Like the clipboard package it shells out to the git command-line tool
+// rather than linking a git implementation.
+//
+// Learn: Small interfaces let callers swap in fakes for tests.
+// See: https://go.dev/doc/effective_go#interfaces
+package gitsync
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// ErrNotRepository is returned when the directory isn't inside a git work tree.
+var ErrNotRepository = errors.New("not a git repository")
+
+// Committer records a change to a single file in version control.
+type Committer interface {
+	// Commit stages path and commits it in the repository containing dir.
+	// Committing a file without changes is not an error.
+	Commit(dir, path, message string) error
+}
+
+// Git is a Committer backed by the git command-line tool.
+type Git struct{}
+
+// Commit runs git add and git commit for path inside dir. Only path is
+// committed, so other staged changes in the repository are left alone.
+func (Git) Commit(dir, path, message string) error {
+	// Step 1: Make sure dir is a git work tree
+	if _, err := runGit(dir, "rev-parse", "--is-inside-work-tree"); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return fmt.Errorf("failed to run git: %w", err)
+		}
+		return ErrNotRepository
+	}
+
+	// Step 2: Stage the file
+	if _, err := runGit(dir, "add", "--", path); err != nil {
+		return fmt.Errorf("failed to stage %s: %w", path, err)
+	}
+
+	// Step 3: Skip the commit when nothing changed (exit status 0 means no diff)
+	if _, err := runGit(dir, "diff", "--cached", "--quiet", "--", path); err == nil {
+		return nil
+	}
+
+	// Step 4: Commit just this file
+	if _, err := runGit(dir, "commit", "--quiet", "-m", message, "--", path); err != nil {
+		return fmt.Errorf("failed to commit %s: %w", path, err)
+	}
+	return nil
+}
+
+// runGit runs git with args in dir, including its stderr in any error.
+func runGit(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("%w: %s", err, message)
+		}
+		return "", err
+	}
+	return stdout.String(), nil
+}
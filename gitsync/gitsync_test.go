@@ -0,0 +1,118 @@
+package gitsync
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newRepo creates a temporary git repository with a committer identity.
+func newRepo(t *testing.T) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir, err := os.MkdirTemp("", "logmd-gitsync-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"config", "user.name", "Test"},
+		{"config", "user.email", "test@example.com"},
+	} {
+		if _, err := runGit(dir, args...); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+	return dir
+}
+
+// commitCount returns the number of commits on the current branch.
+func commitCount(t *testing.T, dir string) string {
+	t.Helper()
+	out, err := runGit(dir, "rev-list", "--count", "HEAD")
+	if err != nil {
+		return "0"
+	}
+	return strings.TrimSpace(out)
+}
+
+// TestGitCommit verifies entries are committed once per change.
+func TestGitCommit(t *testing.T) {
+	dir := newRepo(t)
+	path := filepath.Join(dir, "2024-01-15.md")
+
+	if err := os.WriteFile(path, []byte("# Monday\n"), 0644); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+	if err := (Git{}).Commit(dir, path, "journal: 2024-01-15"); err != nil {
+		t.Fatalf("Commit() failed: %v", err)
+	}
+
+	message, err := runGit(dir, "log", "-1", "--format=%s")
+	if err != nil {
+		t.Fatalf("git log failed: %v", err)
+	}
+	if strings.TrimSpace(message) != "journal: 2024-01-15" {
+		t.Errorf("Expected commit message %q, got %q", "journal: 2024-01-15", message)
+	}
+
+	// An unchanged file is not an error and adds no commit
+	if err := (Git{}).Commit(dir, path, "journal: 2024-01-15"); err != nil {
+		t.Errorf("Commit() without changes failed: %v", err)
+	}
+	if count := commitCount(t, dir); count != "1" {
+		t.Errorf("Expected 1 commit, got %s", count)
+	}
+
+	// Other staged files are left out of the commit
+	other := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(other, []byte("scratch"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if _, err := runGit(dir, "add", other); err != nil {
+		t.Fatalf("git add failed: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("# Monday\n\nMore.\n"), 0644); err != nil {
+		t.Fatalf("Failed to update entry: %v", err)
+	}
+	if err := (Git{}).Commit(dir, path, "journal: 2024-01-15"); err != nil {
+		t.Fatalf("Commit() failed: %v", err)
+	}
+	files, err := runGit(dir, "show", "--name-only", "--format=", "HEAD")
+	if err != nil {
+		t.Fatalf("git show failed: %v", err)
+	}
+	if strings.TrimSpace(files) != "2024-01-15.md" {
+		t.Errorf("Expected only the entry in the commit, got %q", files)
+	}
+}
+
+// TestGitCommitNotRepository verifies the sentinel error outside a repository.
+func TestGitCommitNotRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir, err := os.MkdirTemp("", "logmd-gitsync-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Keep git from finding a repository in a parent directory
+	t.Setenv("GIT_CEILING_DIRECTORIES", filepath.Dir(dir))
+
+	err = (Git{}).Commit(dir, filepath.Join(dir, "2024-01-15.md"), "journal: 2024-01-15")
+	if !errors.Is(err, ErrNotRepository) {
+		t.Errorf("Expected ErrNotRepository, got %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+// Package logging is logmd's verbose output: notes on which config file
+// was read, what overrode it and which files were touched, written to
+// stderr only when asked for with --verbose. By default nothing is logged.
+//
+// Learn: A *log.Logger is safe for concurrent use, so packages can share one.
+// See: https://pkg.go.dev/log#Logger
+package logging
+
+import (
+	"io"
+	"log"
+	"os"
+)
+
+// Level selects how much is logged. Each level includes those before it.
+type Level int
+
+const (
+	// LevelQuiet logs nothing; it is the default
+	LevelQuiet Level = iota
+	// LevelInfo logs how configuration was resolved
+	LevelInfo
+	// LevelDebug also logs each filesystem operation
+	LevelDebug
+)
+
+var (
+	// level is the most detailed level that is written
+	level = LevelQuiet
+	// logger writes the messages, prefixed so they stand apart from output
+	logger = log.New(os.Stderr, "logmd: ", 0)
+)
+
+// SetLevel sets how much is logged from now on.
+func SetLevel(l Level) {
+	level = l
+}
+
+// SetOutput redirects log messages, e.g. to a buffer in tests.
+func SetOutput(w io.Writer) {
+	logger.SetOutput(w)
+}
+
+// Enabled reports whether messages at l are written, for callers that
+// would do extra work to build one.
+func Enabled(l Level) bool {
+	return l != LevelQuiet && l <= level
+}
+
+// Infof logs a message about how configuration was resolved.
+func Infof(format string, args ...any) {
+	logf(LevelInfo, "info: "+format, args...)
+}
+
+// Debugf logs a message about a filesystem operation.
+func Debugf(format string, args ...any) {
+	logf(LevelDebug, "debug: "+format, args...)
+}
+
+// logf writes the message when l is enabled.
+func logf(l Level, format string, args ...any) {
+	if Enabled(l) {
+		logger.Printf(format, args...)
+	}
+}
@@ -0,0 +1,43 @@
+package logging
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestLevels verifies each level writes its own messages and those before it.
+func TestLevels(t *testing.T) {
+	var buf bytes.Buffer
+	SetOutput(&buf)
+	defer SetOutput(os.Stderr)
+	defer SetLevel(LevelQuiet)
+
+	log := func() string {
+		buf.Reset()
+		Infof("config file: %s", "/home/me/.logmdconfig")
+		Debugf("write %s", "2024-01-15.md")
+		return buf.String()
+	}
+
+	SetLevel(LevelQuiet)
+	if got := log(); got != "" {
+		t.Errorf("Expected nothing logged by default, got %q", got)
+	}
+
+	SetLevel(LevelInfo)
+	if got := log(); got != "logmd: info: config file: /home/me/.logmdconfig\n" {
+		t.Errorf("Expected only the info message, got %q", got)
+	}
+
+	SetLevel(LevelDebug)
+	got := log()
+	if !strings.Contains(got, "info: config file") || !strings.Contains(got, "logmd: debug: write 2024-01-15.md\n") {
+		t.Errorf("Expected both messages, got %q", got)
+	}
+
+	if Enabled(LevelQuiet) {
+		t.Error("Expected the quiet level never to count as enabled")
+	}
+}
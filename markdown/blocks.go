@@ -0,0 +1,61 @@
+package markdown
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Block is a run of markdown source between blank lines, such as a
+// paragraph, heading, list or fenced code block.
+type Block struct {
+	// Line is the 1-based line in the original content where the block starts
+	Line int
+	// Source is the block's markdown without a trailing newline
+	Source []byte
+}
+
+// SplitBlocks splits content into blocks at blank lines, keeping fenced code
+// blocks whole even when they contain blank lines. Front matter is dropped,
+// but line numbers still count it so they match the file on disk.
+// Learn: Blocks rendered one at a time can be labelled with where they start,
+// since reflowing only ever moves text within a block.
+func SplitBlocks(content []byte) []Block {
+	stripped := StripFrontMatter(content)
+	offset := bytes.Count(content[:len(content)-len(stripped)], []byte("\n"))
+
+	var blocks []Block
+	var current []string
+	start := 0
+	fence := ""
+
+	flush := func() {
+		if len(current) > 0 {
+			blocks = append(blocks, Block{Line: start, Source: []byte(strings.Join(current, "\n"))})
+			current = nil
+		}
+	}
+
+	for i, line := range strings.Split(string(stripped), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if fence == "" && trimmed == "" {
+			flush()
+			continue
+		}
+		if len(current) == 0 {
+			start = offset + i + 1
+		}
+		current = append(current, line)
+
+		// Track fences so blank lines inside code don't split the block
+		switch {
+		case fence == "" && (strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")):
+			fence = trimmed[:3]
+		case fence != "" && strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "":
+			fence = ""
+		}
+	}
+	flush()
+
+	return blocks
+}
@@ -0,0 +1,54 @@
+package markdown
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestSplitBlocks tests splitting markdown into blocks with their source lines.
+func TestSplitBlocks(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []Block
+	}{
+		{
+			name:     "Empty",
+			input:    "",
+			expected: nil,
+		},
+		{
+			name:  "Paragraphs",
+			input: "# Title\n\nfirst line\nsecond line\n\n\n- one\n- two\n",
+			expected: []Block{
+				{Line: 1, Source: []byte("# Title")},
+				{Line: 3, Source: []byte("first line\nsecond line")},
+				{Line: 7, Source: []byte("- one\n- two")},
+			},
+		},
+		{
+			name:  "FenceKeepsBlankLines",
+			input: "```go\nfunc a() {}\n\nfunc b() {}\n```\n\nafter",
+			expected: []Block{
+				{Line: 1, Source: []byte("```go\nfunc a() {}\n\nfunc b() {}\n```")},
+				{Line: 7, Source: []byte("after")},
+			},
+		},
+		{
+			name:  "FrontMatterCounted",
+			input: "---\ndate: 2024-01-15\n---\n# Title\n\nBody",
+			expected: []Block{
+				{Line: 4, Source: []byte("# Title")},
+				{Line: 6, Source: []byte("Body")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := SplitBlocks([]byte(tc.input)); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
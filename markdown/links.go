@@ -0,0 +1,60 @@
+package markdown
+
+import (
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// Link is a link or image found in an entry.
+type Link struct {
+	// Destination is the URL or path the link points at, as written
+	Destination string
+	// Text is the link text, or an image's alt text, without markup
+	Text string
+	// Image is true for ![alt](src) images
+	Image bool
+	// Wiki is true for [[YYYY-MM-DD]] references, whose Destination and
+	// Text are both the referenced date
+	Wiki bool
+}
+
+// ExtractLinks returns the links, images and autolinks in markdown in
+// document order, using the renderer's goldmark parser so links inside
+// code are ignored and bare URLs count as GFM autolinks. Front matter is
+// skipped, as in Render. With Options.WikiLinks, [[YYYY-MM-DD]]
+// references are included too.
+// See: https://pkg.go.dev/github.com/yuin/goldmark/ast#Link
+func (r *Renderer) ExtractLinks(markdown []byte) []Link {
+	source := StripFrontMatter(markdown)
+	doc := r.goldmarkParser.Parser().Parse(text.NewReader(source))
+
+	var links []Link
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node := n.(type) {
+		case *ast.Link:
+			links = append(links, Link{
+				Destination: string(node.Destination),
+				Text:        strings.TrimSpace(plainText(node, source)),
+			})
+		case *ast.Image:
+			links = append(links, Link{
+				Destination: string(node.Destination),
+				Text:        strings.TrimSpace(plainText(node, source)),
+				Image:       true,
+			})
+		case *ast.AutoLink:
+			url := string(node.URL(source))
+			links = append(links, Link{Destination: url, Text: string(node.Label(source))})
+		case *WikiLink:
+			links = append(links, Link{Destination: node.Date, Text: node.Date, Wiki: true})
+		}
+		return ast.WalkContinue, nil
+	})
+	return links
+}
@@ -0,0 +1,45 @@
+package markdown
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestExtractLinks tests collecting links, images and autolinks.
+func TestExtractLinks(t *testing.T) {
+	renderer, err := NewRenderer(Options{Style: "notty"})
+	if err != nil {
+		t.Fatalf("NewRenderer() failed: %v", err)
+	}
+
+	content := `---
+source: https://front.matter
+---
+# 2024-01-15
+
+Read [the **docs**](https://go.dev/doc/ "Go docs") and [yesterday](2024-01-14.md).
+
+![cat](photos/cat.jpg) and <https://example.com/auto> or https://bare.example.com
+
+See [morning](#morning). ` + "`[not](a-link.md)`" + `
+
+` + "```\n[also not](code.md)\n```\n"
+
+	expected := []Link{
+		{Destination: "https://go.dev/doc/", Text: "the docs"},
+		{Destination: "2024-01-14.md", Text: "yesterday"},
+		{Destination: "photos/cat.jpg", Text: "cat", Image: true},
+		{Destination: "https://example.com/auto", Text: "https://example.com/auto"},
+		{Destination: "https://bare.example.com", Text: "https://bare.example.com"},
+		{Destination: "#morning", Text: "morning"},
+	}
+
+	got := renderer.ExtractLinks([]byte(content))
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("ExtractLinks() =\n%+v\nexpected\n%+v", got, expected)
+	}
+
+	if links := renderer.ExtractLinks([]byte("# No links\n")); len(links) != 0 {
+		t.Errorf("Expected no links, got %+v", links)
+	}
+}
@@ -0,0 +1,54 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// trailingPadding matches the styled spaces glamour fills each line out to
+// the wrap width with, along with the escape codes between them.
+var trailingPadding = regexp.MustCompile(`(?:\x1b\[[0-9;]*m| )+$`)
+
+// resetStyle ends whatever style a line left open.
+const resetStyle = "\x1b[0m"
+
+// RenderPreview renders a snippet of an entry, such as the first lines a
+// timeline preview shows, and returns its non-blank lines. The margin and
+// padding glamour adds around each line are removed, so the caller can
+// indent and wrap the lines itself. A snippet cut off partway through a
+// construct still renders: an unclosed code fence runs to the end of the
+// snippet, and a cut table or list shows the rows it has.
+// Learn: ansi.TruncateLeft drops visible cells while keeping escape codes intact.
+// See: https://pkg.go.dev/github.com/charmbracelet/x/ansi#TruncateLeft
+func (r *Renderer) RenderPreview(snippet []byte) ([]string, error) {
+	rendered, err := r.Render(snippet)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	margin := -1
+	for _, line := range strings.Split(rendered, "\n") {
+		line = trailingPadding.ReplaceAllString(line, "")
+		plain := ansi.Strip(line)
+		if strings.TrimSpace(plain) == "" {
+			continue
+		}
+		indent := len(plain) - len(strings.TrimLeft(plain, " "))
+		if margin < 0 || indent < margin {
+			margin = indent
+		}
+		if strings.Contains(line, "\x1b[") {
+			line += resetStyle
+		}
+		lines = append(lines, line)
+	}
+
+	// The document margin indents every line alike; nesting is kept
+	for i, line := range lines {
+		lines[i] = ansi.TruncateLeft(line, margin, "")
+	}
+	return lines, nil
+}
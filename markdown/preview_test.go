@@ -0,0 +1,60 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// TestRenderPreview tests rendering a snippet into trimmed lines.
+func TestRenderPreview(t *testing.T) {
+	renderer, err := NewRenderer(Options{Style: "notty", WordWrap: 40})
+	if err != nil {
+		t.Fatalf("NewRenderer() failed: %v", err)
+	}
+
+	// The snippet ends inside a code block that the full entry closes later
+	snippet := "Some **bold** and `code` here\n\n- item one\n  - nested\n\n```go\nx := 1"
+	lines, err := renderer.RenderPreview([]byte(snippet))
+	if err != nil {
+		t.Fatalf("RenderPreview() failed: %v", err)
+	}
+
+	expected := []string{"Some **bold** and code here", "• item one", "    • nested", "  x := 1"}
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d lines, got %d: %q", len(expected), len(lines), lines)
+	}
+	for i, line := range lines {
+		if line != expected[i] {
+			t.Errorf("Line %d: expected %q, got %q", i, expected[i], line)
+		}
+	}
+}
+
+// TestRenderPreviewStyled tests that styled lines lose glamour's margin and
+// padding but keep their escape codes.
+func TestRenderPreviewStyled(t *testing.T) {
+	renderer, err := NewRenderer(Options{Style: "dark", WordWrap: 60})
+	if err != nil {
+		t.Fatalf("NewRenderer() failed: %v", err)
+	}
+
+	lines, err := renderer.RenderPreview([]byte("Some **bold** text\n> a quote"))
+	if err != nil {
+		t.Fatalf("RenderPreview() failed: %v", err)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %q", lines)
+	}
+	if !strings.Contains(lines[0], "\x1b[") || !strings.HasSuffix(lines[0], "\x1b[0m") {
+		t.Errorf("Expected a styled line ending in a reset, got %q", lines[0])
+	}
+	if plain := ansi.Strip(lines[0]); plain != "Some bold text" {
+		t.Errorf("Expected the margin and padding trimmed, got %q", plain)
+	}
+
+	if lines, _ := renderer.RenderPreview([]byte("\n\n")); len(lines) != 0 {
+		t.Errorf("Expected no lines for a blank snippet, got %q", lines)
+	}
+}
@@ -0,0 +1,31 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WordsPerMinute is the reading speed used for reading time estimates.
+const WordsPerMinute = 200
+
+// WordCount returns the number of words in markdown content, counting only
+// the prose left after StripMarkdown so syntax like "##" or "**" is ignored.
+// See: https://pkg.go.dev/strings#Fields
+func WordCount(content []byte) int {
+	return len(strings.Fields(StripMarkdown(content)))
+}
+
+// ReadingMinutes estimates how long words take to read at WordsPerMinute,
+// rounding up so any non-empty text takes at least a minute.
+func ReadingMinutes(words int) int {
+	return (words + WordsPerMinute - 1) / WordsPerMinute
+}
+
+// FormatReadingTime renders the reading time for words, e.g. "3 min".
+// Only empty text reads as "<1 min".
+func FormatReadingTime(words int) string {
+	if words <= 0 {
+		return "<1 min"
+	}
+	return fmt.Sprintf("%d min", ReadingMinutes(words))
+}
@@ -0,0 +1,42 @@
+package markdown
+
+import "testing"
+
+// TestWordCount tests that markdown syntax isn't counted as words.
+func TestWordCount(t *testing.T) {
+	tests := []struct {
+		content  string
+		expected int
+	}{
+		{"", 0},
+		{"# 2024-01-15\n\n", 1},
+		{"## Morning\n\n- **shipped** the `release`\n- [notes](notes.md)\n", 5},
+		{"```go\nfmt.Println(\"hi\")\n```\n", 1},
+	}
+
+	for _, tt := range tests {
+		if got := WordCount([]byte(tt.content)); got != tt.expected {
+			t.Errorf("WordCount(%q) = %d, expected %d", tt.content, got, tt.expected)
+		}
+	}
+}
+
+// TestFormatReadingTime tests rounding up to whole minutes.
+func TestFormatReadingTime(t *testing.T) {
+	tests := []struct {
+		words    int
+		expected string
+	}{
+		{0, "<1 min"},
+		{1, "1 min"},
+		{200, "1 min"},
+		{201, "2 min"},
+		{1000, "5 min"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatReadingTime(tt.words); got != tt.expected {
+			t.Errorf("FormatReadingTime(%d) = %q, expected %q", tt.words, got, tt.expected)
+		}
+	}
+}
@@ -8,12 +8,21 @@ package markdown
 
 import (
 	"bytes"
+	"fmt"
+	"os"
+	"strings"
 
 	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/glamour/ansi"
+	"github.com/charmbracelet/glamour/styles"
+	"github.com/muesli/termenv"
 	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/extension"
 	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"golang.org/x/term"
 )
 
 // Renderer handles markdown to ANSI conversion for terminal display.
@@ -22,30 +31,62 @@ import (
 type Renderer struct {
 	glamourRenderer *glamour.TermRenderer
 	goldmarkParser  goldmark.Markdown
+	wikiLinks       bool
+}
+
+// DefaultWordWrap is the column width used when Options.WordWrap is unset.
+const DefaultWordWrap = 80
+
+// Options configures how a Renderer styles its output.
+// The zero value selects glamour's auto style and the default word wrap.
+// Learn: Options structs keep constructor signatures stable as settings grow.
+// See: https://go.dev/doc/effective_go#composite_literals
+type Options struct {
+	// Style is a glamour standard style name such as "dark", "light",
+	// "notty" or "dracula". Empty or "auto" detects the terminal background.
+	Style string
+	// WordWrap is the column at which rendered text wraps (0 uses DefaultWordWrap)
+	WordWrap int
+	// CodeTheme is a chroma theme from CodeThemes used to highlight code
+	// blocks. Empty keeps the style's own highlighting.
+	CodeTheme string
+	// WikiLinks enables [[YYYY-MM-DD]] references to other entries, see
+	// the WikiLinks extension
+	WikiLinks bool
 }
 
 // NewRenderer creates a new markdown renderer with configured styling.
-// Uses glamour's auto style detection for optimal terminal appearance.
+// Unknown style names fall back to glamour's auto style, and unknown code
+// themes to the style's own highlighting, with a warning.
 // Learn: Constructor functions should validate inputs and return configured objects.
 // See: https://dave.cheney.net/2014/10/17/functional-options-for-friendly-apis
-func NewRenderer() (*Renderer, error) {
+func NewRenderer(opts Options) (*Renderer, error) {
+	wordWrap := opts.WordWrap
+	if wordWrap <= 0 {
+		wordWrap = DefaultWordWrap
+	}
+
 	// Configure glamour for terminal rendering
 	glamourRenderer, err := glamour.NewTermRenderer(
-		glamour.WithAutoStyle(),
-		glamour.WithWordWrap(80),
+		styleOption(opts.Style, opts.CodeTheme),
+		glamour.WithWordWrap(wordWrap),
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	// Configure goldmark for markdown parsing
+	extensions := []goldmark.Extender{
+		extension.GFM,
+		extension.Table,
+		extension.Strikethrough,
+		extension.TaskList,
+	}
+	if opts.WikiLinks {
+		extensions = append(extensions, WikiLinks)
+	}
 	goldmarkParser := goldmark.New(
-		goldmark.WithExtensions(
-			extension.GFM,
-			extension.Table,
-			extension.Strikethrough,
-			extension.TaskList,
-		),
+		goldmark.WithExtensions(extensions...),
 		goldmark.WithParserOptions(
 			parser.WithAutoHeadingID(),
 		),
@@ -57,34 +98,123 @@ func NewRenderer() (*Renderer, error) {
 	return &Renderer{
 		glamourRenderer: glamourRenderer,
 		goldmarkParser:  goldmarkParser,
+		wikiLinks:       opts.WikiLinks,
 	}, nil
 }
 
+// styleOption resolves a configured style name and code theme to a glamour option.
+// Learn: Falling back to a sane default keeps a typo in config from breaking output.
+func styleOption(style, codeTheme string) glamour.TermRendererOption {
+	if codeTheme != "" && !IsCodeTheme(codeTheme) {
+		fmt.Fprintf(os.Stderr, "Warning: unknown code theme %q, using the style's default (see 'logmd themes')\n", codeTheme)
+		codeTheme = ""
+	}
+
+	if style != "" && style != styles.AutoStyle {
+		if _, ok := styles.DefaultStyles[style]; !ok {
+			fmt.Fprintf(os.Stderr, "Warning: unknown style %q, falling back to auto\n", style)
+			style = styles.AutoStyle
+		}
+	}
+
+	if codeTheme == "" {
+		if style == "" || style == styles.AutoStyle {
+			return glamour.WithAutoStyle()
+		}
+		return glamour.WithStandardStyle(style)
+	}
+	return glamour.WithStyles(withCodeTheme(style, codeTheme))
+}
+
+// withCodeTheme returns a copy of the named glamour style whose code blocks
+// are highlighted with codeTheme. The plain notty and ascii styles are left
+// unhighlighted so their output stays free of escape codes.
+// Learn: glamour only uses CodeBlock.Theme when the style has no Chroma rules.
+// See: https://pkg.go.dev/github.com/charmbracelet/glamour/ansi#StyleCodeBlock
+func withCodeTheme(style, codeTheme string) ansi.StyleConfig {
+	if style == "" || style == styles.AutoStyle {
+		style = autoStyle()
+	}
+
+	config := *styles.DefaultStyles[style]
+	if style == styles.NoTTYStyle || style == styles.AsciiStyle {
+		return config
+	}
+	config.CodeBlock.Chroma = nil
+	config.CodeBlock.Theme = codeTheme
+	return config
+}
+
+// autoStyle picks the standard style glamour's auto style would use: notty
+// when stdout isn't a terminal, otherwise dark or light to match the
+// terminal's background.
+func autoStyle() string {
+	if !term.IsTerminal(int(os.Stdout.Fd())) {
+		return styles.NoTTYStyle
+	}
+	if termenv.HasDarkBackground() {
+		return styles.DarkStyle
+	}
+	return styles.LightStyle
+}
+
 // Render converts markdown bytes to ANSI-formatted string for terminal display.
-// The input should be raw markdown content read from a journal file.
+// The input should be raw markdown content read from a journal file; any
+// front matter is dropped rather than shown as a rule and paragraph. With
+// Options.WikiLinks, [[YYYY-MM-DD]] references are shown as styled link text.
 // Learn: Methods that can fail should return (result, error) tuple.
 // See: https://go.dev/blog/error-handling-and-go
 func (r *Renderer) Render(markdown []byte) (string, error) {
+	source := StripFrontMatter(markdown)
+	// glamour parses with its own goldmark, so wiki links become plain links first
+	if r.wikiLinks {
+		source = r.rewriteWikiLinks(source)
+	}
+
 	// Use glamour to render markdown with ANSI escape codes
-	rendered, err := r.glamourRenderer.Render(string(markdown))
+	rendered, err := r.glamourRenderer.Render(string(source))
 	if err != nil {
 		return "", err
 	}
 	return rendered, nil
 }
 
+// RenderHTML converts markdown bytes to an HTML fragment for embedding in a
+// larger document, such as an export or a static site page. It uses the same
+// goldmark configuration as parsing (GFM, tables, strikethrough, task lists),
+// so raw HTML in an entry is passed through as written. Front matter is
+// dropped rather than rendered as a rule and paragraph. With
+// Options.WikiLinks, [[YYYY-MM-DD]] references link to #YYYY-MM-DD.
+// Learn: Without html.WithUnsafe goldmark replaces raw HTML with a comment.
+// See: https://github.com/yuin/goldmark#html-renderer-options
+func (r *Renderer) RenderHTML(markdown []byte) (string, error) {
+	var buf bytes.Buffer
+	if err := r.goldmarkParser.Convert(StripFrontMatter(markdown), &buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 // ExtractFirstHeading parses markdown and returns the first heading after front matter.
 // Returns "(untitled)" if no heading is found after YAML front matter.
 // Learn: Parsing often requires state machines or careful string processing.
 func ExtractFirstHeading(markdown []byte) string {
-	var buf bytes.Buffer
-	if err := goldmark.New().Convert(markdown, &buf); err != nil {
+	source := StripFrontMatter(markdown)
+	doc := goldmark.New().Parser().Parse(text.NewReader(source))
+
+	title := ""
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if heading, ok := n.(*ast.Heading); ok && entering {
+			title = strings.TrimSpace(plainText(heading, source))
+			return ast.WalkStop, nil
+		}
+		return ast.WalkContinue, nil
+	})
+
+	if title == "" {
 		return "(untitled)"
 	}
-
-	// TODO: Implement proper heading extraction after front matter
-	// For Phase 0, return placeholder
-	return "(untitled)"
+	return title
 }
 
 // StripFrontMatter removes YAML front matter from markdown content.
@@ -3,18 +3,20 @@ package markdown
 import (
 	"strings"
 	"testing"
+
+	"github.com/charmbracelet/x/ansi"
 )
 
 // TestNewRenderer tests the renderer constructor.
 // Learn: Constructor tests should verify the object is properly initialized.
 func TestNewRenderer(t *testing.T) {
-	renderer, err := NewRenderer()
+	renderer, err := NewRenderer(Options{})
 	if err != nil {
-		t.Fatalf("NewRenderer() failed: %v", err)
+		t.Fatalf("NewRenderer(Options{}) failed: %v", err)
 	}
 
 	if renderer == nil {
-		t.Error("NewRenderer() returned nil renderer")
+		t.Error("NewRenderer(Options{}) returned nil renderer")
 	}
 
 	if renderer.glamourRenderer == nil {
@@ -26,9 +28,41 @@ func TestNewRenderer(t *testing.T) {
 	}
 }
 
+// TestNewRendererWithStyles tests that style options are accepted or fall back.
+func TestNewRendererWithStyles(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts Options
+	}{
+		{name: "Auto", opts: Options{Style: "auto"}},
+		{name: "Dark", opts: Options{Style: "dark"}},
+		{name: "NoTTY", opts: Options{Style: "notty", WordWrap: 40}},
+		{name: "Dracula", opts: Options{Style: "dracula"}},
+		{name: "UnknownFallsBack", opts: Options{Style: "not-a-style"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			renderer, err := NewRenderer(tc.opts)
+			if err != nil {
+				t.Fatalf("NewRenderer(%+v) failed: %v", tc.opts, err)
+			}
+
+			rendered, err := renderer.Render([]byte("# Heading\n\nBody text."))
+			if err != nil {
+				t.Fatalf("Render() failed: %v", err)
+			}
+
+			if !strings.Contains(ansi.Strip(rendered), "Body text.") {
+				t.Errorf("Expected rendered output to contain body text, got %q", rendered)
+			}
+		})
+	}
+}
+
 // TestRenderBasicMarkdown tests rendering of basic markdown elements.
 func TestRenderBasicMarkdown(t *testing.T) {
-	renderer, err := NewRenderer()
+	renderer, err := NewRenderer(Options{})
 	if err != nil {
 		t.Fatalf("Failed to create renderer: %v", err)
 	}
@@ -123,7 +157,7 @@ func TestRenderBasicMarkdown(t *testing.T) {
 
 // TestRenderComplexMarkdown tests rendering of complex markdown documents.
 func TestRenderComplexMarkdown(t *testing.T) {
-	renderer, err := NewRenderer()
+	renderer, err := NewRenderer(Options{})
 	if err != nil {
 		t.Fatalf("Failed to create renderer: %v", err)
 	}
@@ -215,7 +249,7 @@ That's all for today! 🚀`
 
 // TestRenderEmptyContent tests rendering of empty or whitespace-only content.
 func TestRenderEmptyContent(t *testing.T) {
-	renderer, err := NewRenderer()
+	renderer, err := NewRenderer(Options{})
 	if err != nil {
 		t.Fatalf("Failed to create renderer: %v", err)
 	}
@@ -254,7 +288,7 @@ func TestRenderEmptyContent(t *testing.T) {
 
 // TestRenderWithSpecialCharacters tests rendering with special characters.
 func TestRenderWithSpecialCharacters(t *testing.T) {
-	renderer, err := NewRenderer()
+	renderer, err := NewRenderer(Options{})
 	if err != nil {
 		t.Fatalf("Failed to create renderer: %v", err)
 	}
@@ -302,7 +336,7 @@ Special punctuation: ¡Hola! ¿Cómo estás?`
 
 // TestRenderJournalEntryFormat tests rendering of typical journal entry format.
 func TestRenderJournalEntryFormat(t *testing.T) {
-	renderer, err := NewRenderer()
+	renderer, err := NewRenderer(Options{})
 	if err != nil {
 		t.Fatalf("Failed to create renderer: %v", err)
 	}
@@ -380,3 +414,96 @@ Energy: 🔋 High`
 		}
 	}
 }
+
+// TestRenderFrontMatter tests front matter is left out of terminal output.
+func TestRenderFrontMatter(t *testing.T) {
+	renderer, err := NewRenderer(Options{Style: "notty"})
+	if err != nil {
+		t.Fatalf("NewRenderer() failed: %v", err)
+	}
+
+	output, err := renderer.Render([]byte("---\ndate: 2024-01-15\nmood: calm\n---\n\n# 2024-01-15\n\nA quiet day.\n"))
+	if err != nil {
+		t.Fatalf("Render() failed: %v", err)
+	}
+	if !strings.Contains(output, "2024-01-15") || !strings.Contains(output, "A quiet day.") {
+		t.Errorf("Expected the entry to be rendered, got:\n%s", output)
+	}
+	if strings.Contains(output, "mood") {
+		t.Errorf("Expected front matter to be dropped, got:\n%s", output)
+	}
+}
+
+// TestRenderHTML tests the HTML fragment output and its goldmark extensions.
+func TestRenderHTML(t *testing.T) {
+	renderer, err := NewRenderer(Options{})
+	if err != nil {
+		t.Fatalf("NewRenderer(Options{}) failed: %v", err)
+	}
+
+	input := `---
+title: hidden
+---
+# Daily Log
+
+| Task | Done |
+|------|------|
+| Run  | yes  |
+
+~~cancelled~~ plans
+
+- [x] finished
+- [ ] pending
+
+<kbd>Ctrl</kbd>
+`
+
+	output, err := renderer.RenderHTML([]byte(input))
+	if err != nil {
+		t.Fatalf("RenderHTML() failed: %v", err)
+	}
+
+	expected := []string{
+		`<h1 id="daily-log">Daily Log</h1>`,
+		"<table>",
+		"<del>cancelled</del>",
+		`<input checked="" disabled="" type="checkbox"`,
+		"<kbd>Ctrl</kbd>", // Raw HTML passes through unescaped
+	}
+	for _, want := range expected {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected HTML to contain %q, got:\n%s", want, output)
+		}
+	}
+
+	// A fragment has no document wrapper and no front matter
+	for _, unwanted := range []string{"<html", "<body", "title: hidden", "<hr"} {
+		if strings.Contains(output, unwanted) {
+			t.Errorf("Expected HTML fragment without %q, got:\n%s", unwanted, output)
+		}
+	}
+}
+
+// TestExtractFirstHeading tests heading extraction after front matter.
+func TestExtractFirstHeading(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "Empty", input: "", expected: "(untitled)"},
+		{name: "NoHeading", input: "Just some text.", expected: "(untitled)"},
+		{name: "SimpleHeading", input: "# Daily Journal\n\nBody", expected: "Daily Journal"},
+		{name: "InlineFormatting", input: "## A **bold** `day`", expected: "A bold day"},
+		{name: "AfterText", input: "Intro\n\n# Later Heading", expected: "Later Heading"},
+		{name: "AfterFrontMatter", input: "---\ntitle: meta\n---\n# Real Title", expected: "Real Title"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ExtractFirstHeading([]byte(tc.input)); got != tc.expected {
+				t.Errorf("ExtractFirstHeading(%q) = %q, expected %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package markdown
+
+import (
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// StripMarkdown converts markdown into plain prose with all syntax removed.
+// Front matter is dropped, headings and list items become plain lines, code
+// blocks keep their content without fences, and tables become space-separated
+// rows. The result ends with a single newline (or is empty).
+// Learn: Walking the parsed AST is more robust than regex for stripping syntax.
+// See: https://pkg.go.dev/github.com/yuin/goldmark/ast#Walk
+func StripMarkdown(content []byte) string {
+	source := StripFrontMatter(content)
+	md := goldmark.New(goldmark.WithExtensions(extension.GFM))
+	doc := md.Parser().Parse(text.NewReader(source))
+
+	var b strings.Builder
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			return writePlainNode(&b, n, source), nil
+		}
+
+		switch n.(type) {
+		case *ast.Paragraph, *ast.TextBlock, *ast.Heading, *east.TableHeader, *east.TableRow:
+			endLine(&b)
+		case *east.TableCell:
+			if n.NextSibling() != nil {
+				b.WriteString(" ")
+			}
+		}
+
+		// Separate top-level blocks with a blank line
+		if n.Parent() != nil && n.Parent().Kind() == ast.KindDocument {
+			endLine(&b)
+			if s := b.String(); s != "" && !strings.HasSuffix(s, "\n\n") {
+				b.WriteString("\n")
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	result := strings.TrimSpace(b.String())
+	if result == "" {
+		return ""
+	}
+	return result + "\n"
+}
+
+// writePlainNode writes the text carried by a single node as it is entered.
+// Returns WalkSkipChildren for nodes whose content is fully handled here.
+func writePlainNode(b *strings.Builder, n ast.Node, source []byte) ast.WalkStatus {
+	switch node := n.(type) {
+	case *ast.Text:
+		b.Write(node.Segment.Value(source))
+		if node.HardLineBreak() {
+			b.WriteString("\n")
+		} else if node.SoftLineBreak() {
+			b.WriteString(" ")
+		}
+	case *ast.String:
+		b.Write(node.Value)
+	case *ast.AutoLink:
+		b.Write(node.URL(source))
+	case *ast.CodeBlock, *ast.FencedCodeBlock:
+		lines := n.Lines()
+		for i := 0; i < lines.Len(); i++ {
+			segment := lines.At(i)
+			b.Write(segment.Value(source))
+		}
+		return ast.WalkSkipChildren
+	case *ast.RawHTML, *ast.HTMLBlock, *ast.ThematicBreak:
+		return ast.WalkSkipChildren
+	}
+	return ast.WalkContinue
+}
+
+// plainText returns the text content of a single node and its descendants.
+func plainText(n ast.Node, source []byte) string {
+	var b strings.Builder
+	_ = ast.Walk(n, func(child ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		return writePlainNode(&b, child, source), nil
+	})
+	return b.String()
+}
+
+// endLine terminates the current line unless the output already ends with one.
+func endLine(b *strings.Builder) {
+	s := b.String()
+	if s != "" && !strings.HasSuffix(s, "\n") {
+		b.WriteString("\n")
+	}
+}
@@ -0,0 +1,64 @@
+package markdown
+
+import (
+	"testing"
+)
+
+// TestStripMarkdown tests conversion of markdown into syntax-free prose.
+func TestStripMarkdown(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Empty",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "HeadingAndInline",
+			input:    "# Title\n\nSome **bold**, *italic*, `code` and [a link](https://example.com).",
+			expected: "Title\n\nSome bold, italic, code and a link.\n",
+		},
+		{
+			name:     "SoftBreaksJoinLines",
+			input:    "first line\nsecond line",
+			expected: "first line second line\n",
+		},
+		{
+			name:     "ListsBecomeLines",
+			input:    "- one\n- two\n  - nested\n- [x] done\n\n1. first\n2. second",
+			expected: "one\ntwo\nnested\ndone\n\nfirst\nsecond\n",
+		},
+		{
+			name:     "CodeFenceKeepsContent",
+			input:    "```go\nfunc main() {}\n```",
+			expected: "func main() {}\n",
+		},
+		{
+			name:     "FrontMatterDropped",
+			input:    "---\ntitle: Test\n---\n# Heading\n\nBody",
+			expected: "Heading\n\nBody\n",
+		},
+		{
+			name:     "TableRows",
+			input:    "| A | B |\n|---|---|\n| 1 | 2 |",
+			expected: "A B\n1 2\n",
+		},
+		{
+			name:     "HTMLAndRulesDropped",
+			input:    "Before\n\n<div>html</div>\n\n---\n\nAfter ~~old~~ <https://auto.link>",
+			expected: "Before\n\nAfter old https://auto.link\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := StripMarkdown([]byte(tc.input))
+			if result != tc.expected {
+				t.Errorf("StripMarkdown(%q) = %q, expected %q", tc.input, result, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package markdown
+
+import (
+	"slices"
+
+	chromastyles "github.com/alecthomas/chroma/v2/styles"
+)
+
+// codeThemes is a snapshot of the chroma styles registered at startup.
+// glamour registers its own "charm" style the first time it highlights a
+// code block, so listing the registry later would vary from run to run.
+var codeThemes = chromastyles.Names()
+
+// CodeThemes returns the names of the syntax highlighting themes that
+// Options.CodeTheme accepts, sorted alphabetically.
+// Learn: chroma ships its themes as XML files compiled into a registry.
+// See: https://xyproto.github.io/splash/docs/
+func CodeThemes() []string {
+	return slices.Clone(codeThemes)
+}
+
+// IsCodeTheme reports whether name is a known syntax highlighting theme.
+func IsCodeTheme(name string) bool {
+	_, found := slices.BinarySearch(codeThemes, name)
+	return found
+}
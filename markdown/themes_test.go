@@ -0,0 +1,59 @@
+package markdown
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestCodeThemes tests listing and looking up chroma themes.
+func TestCodeThemes(t *testing.T) {
+	themes := CodeThemes()
+	if !slices.IsSorted(themes) {
+		t.Error("Expected themes to be sorted")
+	}
+	for _, name := range []string{"monokai", "github", "dracula"} {
+		if !slices.Contains(themes, name) || !IsCodeTheme(name) {
+			t.Errorf("Expected %q to be a known theme", name)
+		}
+	}
+	if IsCodeTheme("not-a-theme") || IsCodeTheme("") {
+		t.Error("Expected unknown names not to be themes")
+	}
+
+	// Callers can't change the list
+	themes[0] = "changed"
+	if CodeThemes()[0] == "changed" {
+		t.Error("Expected CodeThemes to return a copy")
+	}
+}
+
+// TestRenderCodeTheme tests that the code theme changes how code blocks are
+// highlighted, except in the plain notty style.
+func TestRenderCodeTheme(t *testing.T) {
+	source := []byte("```go\nfunc main() { fmt.Println(\"hi\") }\n```\n")
+	render := func(opts Options) string {
+		renderer, err := NewRenderer(opts)
+		if err != nil {
+			t.Fatalf("NewRenderer(%+v) failed: %v", opts, err)
+		}
+		rendered, err := renderer.Render(source)
+		if err != nil {
+			t.Fatalf("Render() failed: %v", err)
+		}
+		return rendered
+	}
+
+	styleDefault := render(Options{Style: "dark"})
+	monokai := render(Options{Style: "dark", CodeTheme: "monokai"})
+	github := render(Options{Style: "dark", CodeTheme: "github"})
+	if monokai == styleDefault || monokai == github {
+		t.Error("Expected each code theme to highlight code differently")
+	}
+
+	if got := render(Options{Style: "dark", CodeTheme: "not-a-theme"}); got != styleDefault {
+		t.Error("Expected an unknown code theme to fall back to the style's highlighting")
+	}
+	if got := render(Options{Style: "notty", CodeTheme: "monokai"}); got != render(Options{Style: "notty"}) {
+		t.Error("Expected the notty style to ignore the code theme")
+	}
+}
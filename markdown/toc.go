@@ -0,0 +1,52 @@
+package markdown
+
+import (
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// TOCEntry is one heading in an entry's table of contents.
+type TOCEntry struct {
+	// Level is the heading level, 1 for "#" through 6 for "######"
+	Level int
+	// Text is the heading's plain text without markup
+	Text string
+	// ID is the anchor goldmark generates for the heading, e.g. "morning-run"
+	ID string
+}
+
+// TableOfContents returns the headings of markdown in document order, using
+// the renderer's goldmark parser so IDs match the anchors RenderHTML writes.
+// Front matter is skipped, as in Render.
+// Learn: ast.Walk visits every node depth-first, entering before children.
+// See: https://pkg.go.dev/github.com/yuin/goldmark/ast#Walk
+func (r *Renderer) TableOfContents(markdown []byte) ([]TOCEntry, error) {
+	source := StripFrontMatter(markdown)
+	doc := r.goldmarkParser.Parser().Parse(text.NewReader(source))
+
+	var entries []TOCEntry
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		entry := TOCEntry{
+			Level: heading.Level,
+			Text:  strings.TrimSpace(plainText(heading, source)),
+		}
+		if id, ok := heading.AttributeString("id"); ok {
+			if b, ok := id.([]byte); ok {
+				entry.ID = string(b)
+			}
+		}
+		entries = append(entries, entry)
+		return ast.WalkSkipChildren, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
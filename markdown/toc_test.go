@@ -0,0 +1,73 @@
+package markdown
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestTableOfContents tests collecting headings with their levels and IDs.
+func TestTableOfContents(t *testing.T) {
+	renderer, err := NewRenderer(Options{Style: "notty"})
+	if err != nil {
+		t.Fatalf("NewRenderer() failed: %v", err)
+	}
+
+	testCases := []struct {
+		name     string
+		content  string
+		expected []TOCEntry
+	}{
+		{
+			name:     "NoHeadings",
+			content:  "Just a paragraph.\n",
+			expected: nil,
+		},
+		{
+			name: "NestedHeadings",
+			content: `# 2024-01-15
+
+## Morning **run**
+
+Five miles.
+
+### Splits
+
+## Work
+
+` + "```\n# not a heading\n```\n",
+			expected: []TOCEntry{
+				{Level: 1, Text: "2024-01-15", ID: "2024-01-15"},
+				{Level: 2, Text: "Morning run", ID: "morning-run"},
+				{Level: 3, Text: "Splits", ID: "splits"},
+				{Level: 2, Text: "Work", ID: "work"},
+			},
+		},
+		{
+			name:    "DuplicateHeadings",
+			content: "## Notes\n\n## Notes\n",
+			expected: []TOCEntry{
+				{Level: 2, Text: "Notes", ID: "notes"},
+				{Level: 2, Text: "Notes", ID: "notes-1"},
+			},
+		},
+		{
+			name:    "FrontMatter",
+			content: "---\nmood: good\n---\n# Title\n",
+			expected: []TOCEntry{
+				{Level: 1, Text: "Title", ID: "title"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := renderer.TableOfContents([]byte(tc.content))
+			if err != nil {
+				t.Fatalf("TableOfContents() failed: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("TableOfContents() = %+v, expected %+v", got, tc.expected)
+			}
+		})
+	}
+}
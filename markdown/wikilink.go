@@ -0,0 +1,116 @@
+package markdown
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// KindWikiLink is the goldmark node kind of a WikiLink.
+var KindWikiLink = ast.NewNodeKind("WikiLink")
+
+// WikiLink is a [[YYYY-MM-DD]] reference to another entry.
+type WikiLink struct {
+	ast.BaseInline
+	// Date is the referenced entry's date in YYYY-MM-DD format
+	Date string
+	// Segment is where the whole [[...]] reference sits in the source
+	Segment text.Segment
+}
+
+// Kind implements ast.Node.
+func (n *WikiLink) Kind() ast.NodeKind {
+	return KindWikiLink
+}
+
+// Dump implements ast.Node.
+func (n *WikiLink) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Date": n.Date}, nil)
+}
+
+// wikiLinkLength is the length of "[[YYYY-MM-DD]]".
+const wikiLinkLength = len("[[2006-01-02]]")
+
+// wikiLinkParser parses [[YYYY-MM-DD]] references. Anything else starting
+// with "[" is left to the standard link parser.
+type wikiLinkParser struct{}
+
+// Trigger implements parser.InlineParser.
+func (wikiLinkParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+// Parse implements parser.InlineParser.
+func (wikiLinkParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, segment := block.PeekLine()
+	if len(line) < wikiLinkLength || !bytes.HasPrefix(line, []byte("[[")) || !bytes.HasPrefix(line[12:], []byte("]]")) {
+		return nil
+	}
+	date := string(line[2:12])
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return nil
+	}
+
+	block.Advance(wikiLinkLength)
+	return &WikiLink{Date: date, Segment: text.NewSegment(segment.Start, segment.Start+wikiLinkLength)}
+}
+
+// wikiLinkHTMLRenderer renders a WikiLink as a link to the entry's
+// <article id="YYYY-MM-DD"> in exported HTML.
+type wikiLinkHTMLRenderer struct{}
+
+// RegisterFuncs implements renderer.NodeRenderer.
+func (wikiLinkHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindWikiLink, func(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			date := n.(*WikiLink).Date
+			_, _ = w.WriteString(`<a class="wikilink" href="#` + date + `">` + date + `</a>`)
+		}
+		return ast.WalkSkipChildren, nil
+	})
+}
+
+// WikiLinks is a goldmark extension for [[YYYY-MM-DD]] references between
+// entries. Dates that aren't real, such as [[2024-02-30]], stay plain text.
+// Learn: goldmark extensions add parsers and renderers at a priority; lower runs first.
+// See: https://github.com/yuin/goldmark#adding-extensions
+var WikiLinks goldmark.Extender = wikiLinks{}
+
+type wikiLinks struct{}
+
+// Extend implements goldmark.Extender. The parser runs before the standard
+// link parser (priority 200), which would otherwise claim the brackets.
+func (wikiLinks) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(wikiLinkParser{}, 199),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(wikiLinkHTMLRenderer{}, 500),
+	))
+}
+
+// rewriteWikiLinks replaces each [[YYYY-MM-DD]] reference found by parsing
+// source with an anchor-only link, [YYYY-MM-DD](#YYYY-MM-DD), which glamour
+// renders as styled link text without a URL after it.
+func (r *Renderer) rewriteWikiLinks(source []byte) []byte {
+	doc := r.goldmarkParser.Parser().Parse(text.NewReader(source))
+
+	var out bytes.Buffer
+	last := 0
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if link, ok := n.(*WikiLink); ok && entering {
+			out.Write(source[last:link.Segment.Start])
+			out.WriteString("[" + link.Date + "](#" + link.Date + ")")
+			last = link.Segment.Stop
+		}
+		return ast.WalkContinue, nil
+	})
+	out.Write(source[last:])
+	return out.Bytes()
+}
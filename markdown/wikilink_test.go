@@ -0,0 +1,89 @@
+package markdown
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestExtractWikiLinks tests that [[YYYY-MM-DD]] references are links only
+// when the extension is enabled, and only for real dates outside code.
+func TestExtractWikiLinks(t *testing.T) {
+	content := "See [[2024-01-14]] and [yesterday](2024-01-14.md).\n\n" +
+		"Not [[2024-02-30]], [[someday]] or `[[2024-01-13]]`.\n\n" +
+		"- [[2024-01-12]]\n"
+
+	renderer, err := NewRenderer(Options{Style: "notty", WikiLinks: true})
+	if err != nil {
+		t.Fatalf("NewRenderer() failed: %v", err)
+	}
+	expected := []Link{
+		{Destination: "2024-01-14", Text: "2024-01-14", Wiki: true},
+		{Destination: "2024-01-14.md", Text: "yesterday"},
+		{Destination: "2024-01-12", Text: "2024-01-12", Wiki: true},
+	}
+	if got := renderer.ExtractLinks([]byte(content)); !reflect.DeepEqual(got, expected) {
+		t.Errorf("ExtractLinks() =\n%+v\nexpected\n%+v", got, expected)
+	}
+
+	plain, err := NewRenderer(Options{Style: "notty"})
+	if err != nil {
+		t.Fatalf("NewRenderer() failed: %v", err)
+	}
+	expected = []Link{{Destination: "2024-01-14.md", Text: "yesterday"}}
+	if got := plain.ExtractLinks([]byte(content)); !reflect.DeepEqual(got, expected) {
+		t.Errorf("ExtractLinks() without wiki links =\n%+v\nexpected\n%+v", got, expected)
+	}
+}
+
+// TestRewriteWikiLinks tests turning wiki links into anchor-only links
+// while leaving code and other brackets untouched.
+func TestRewriteWikiLinks(t *testing.T) {
+	renderer, err := NewRenderer(Options{Style: "notty", WikiLinks: true})
+	if err != nil {
+		t.Fatalf("NewRenderer() failed: %v", err)
+	}
+
+	source := "# Day\n\nAfter [[2024-01-14]], not [[2024-13-01]].\n\n```\n[[2024-01-14]]\n```\n"
+	expected := "# Day\n\nAfter [2024-01-14](#2024-01-14), not [[2024-13-01]].\n\n```\n[[2024-01-14]]\n```\n"
+	if got := string(renderer.rewriteWikiLinks([]byte(source))); got != expected {
+		t.Errorf("rewriteWikiLinks() =\n%q\nexpected\n%q", got, expected)
+	}
+}
+
+// TestRenderWikiLinks tests the terminal and HTML output of wiki links.
+func TestRenderWikiLinks(t *testing.T) {
+	content := []byte("Continued from [[2024-01-14]].\n")
+
+	renderer, err := NewRenderer(Options{Style: "notty", WikiLinks: true})
+	if err != nil {
+		t.Fatalf("NewRenderer() failed: %v", err)
+	}
+	rendered, err := renderer.Render(content)
+	if err != nil {
+		t.Fatalf("Render() failed: %v", err)
+	}
+	if !strings.Contains(rendered, "Continued from 2024-01-14.") || strings.Contains(rendered, "[[") {
+		t.Errorf("Expected the wiki link rendered as its date, got %q", rendered)
+	}
+
+	html, err := renderer.RenderHTML(content)
+	if err != nil {
+		t.Fatalf("RenderHTML() failed: %v", err)
+	}
+	if !strings.Contains(html, `<a class="wikilink" href="#2024-01-14">2024-01-14</a>`) {
+		t.Errorf("Expected an HTML link to the entry, got %q", html)
+	}
+
+	plain, err := NewRenderer(Options{Style: "notty"})
+	if err != nil {
+		t.Fatalf("NewRenderer() failed: %v", err)
+	}
+	rendered, err = plain.Render(content)
+	if err != nil {
+		t.Fatalf("Render() failed: %v", err)
+	}
+	if !strings.Contains(rendered, "[[2024-01-14]]") {
+		t.Errorf("Expected wiki links left as written when disabled, got %q", rendered)
+	}
+}
@@ -0,0 +1,47 @@
+// Package prompt asks the user to confirm actions for logmd, so every
+// command and the assist subcommands share one y/N prompt and one --yes.
+//
+// Learn: A tiny shared package breaks the import cycle between cmd and its subcommand packages.
+// See: https://go.dev/doc/effective_go#package-names
+package prompt
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// AssumeYes answers yes to every confirmation; the global --yes flag sets it.
+var AssumeYes bool
+
+// Confirm asks question on w and reads a y/N answer from r: "y" or "yes"
+// in any case is yes, and anything else, including just Enter, is no.
+// With AssumeYes it returns true without asking. Input that ends before an
+// answer is an error, so a script that pipes nothing in fails rather than
+// quietly doing nothing.
+// Learn: Taking the reader as a parameter lets tests answer with strings.NewReader.
+// See: https://pkg.go.dev/bufio#Reader.ReadString
+func Confirm(r io.Reader, w io.Writer, question string) (bool, error) {
+	if AssumeYes {
+		return true, nil
+	}
+
+	fmt.Fprintf(w, "%s [y/N] ", question)
+	answer, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || answer == "") {
+		fmt.Fprintln(w)
+		if errors.Is(err, io.EOF) {
+			return false, fmt.Errorf("no answer to confirm with (use --yes to skip the prompt)")
+		}
+		return false, fmt.Errorf("failed to read answer: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,55 @@
+package prompt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestConfirm tests reading yes/no answers.
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"y\n", true},
+		{"Yes\n", true},
+		{"  YES  \n", true},
+		{"y", true},
+		{"n\n", false},
+		{"\n", false},
+		{"yep\n", false},
+		{"no\n", false},
+	}
+
+	for _, tt := range tests {
+		var out bytes.Buffer
+		got, err := Confirm(strings.NewReader(tt.input), &out, "Delete it?")
+		if err != nil {
+			t.Errorf("Confirm(%q) failed: %v", tt.input, err)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("Confirm(%q) = %t, expected %t", tt.input, got, tt.expected)
+		}
+		if out.String() != "Delete it? [y/N] " {
+			t.Errorf("Expected the question with its choices, got %q", out.String())
+		}
+	}
+
+	// No answer at all is an error rather than a silent no
+	if _, err := Confirm(strings.NewReader(""), &bytes.Buffer{}, "Delete it?"); err == nil || !strings.Contains(err.Error(), "--yes") {
+		t.Errorf("Expected an error suggesting --yes, got %v", err)
+	}
+
+	// --yes answers without asking or reading
+	AssumeYes = true
+	defer func() { AssumeYes = false }()
+	var out bytes.Buffer
+	if ok, err := Confirm(strings.NewReader(""), &out, "Delete it?"); err != nil || !ok {
+		t.Errorf("Expected --yes to confirm, got %t, %v", ok, err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("Expected no prompt with --yes, got %q", out.String())
+	}
+}
@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Bindings returns the keybindings in the order they are listed in the help
+// overlay.
+func (k KeyMap) Bindings() []key.Binding {
+	return []key.Binding{
+		k.Up, k.Down, k.PageUp, k.PageDown, k.Home, k.End,
+		k.Toggle, k.Read, k.Copy, k.Edit, k.Search, k.Jump, k.Order,
+		k.Help, k.Quit,
+	}
+}
+
+// shortcut is one item in the help line under a pane: the keys, taken
+// from a binding's help text so they follow [keys] overrides, and a short
+// label for the action.
+type shortcut struct {
+	keys  string
+	label string
+}
+
+// timelineShortcuts lists the actions named in the timeline's help line.
+func (k KeyMap) timelineShortcuts() []shortcut {
+	return []shortcut{
+		{k.Up.Help().Key, "up"}, {k.Down.Help().Key, "down"}, {k.Toggle.Help().Key, "toggle"},
+		{k.Read.Help().Key, "view"}, {k.Copy.Help().Key, "copy"}, {k.Search.Help().Key, "search"},
+		{k.Jump.Help().Key, "go to"}, {k.Order.Help().Key, "order"}, {k.Edit.Help().Key, "edit"},
+		{k.Help.Help().Key, "help"}, {k.Quit.Help().Key, "quit"},
+	}
+}
+
+// readerShortcuts lists the actions named in the reader pane's help line.
+func (k KeyMap) readerShortcuts() []shortcut {
+	return []shortcut{
+		{k.Up.Help().Key, "up"}, {k.Down.Help().Key, "down"},
+		{k.PageUp.Help().Key + "/" + k.PageDown.Help().Key, "page"}, {"esc", "back"},
+	}
+}
+
+// shortHelp renders shortcuts as a one-line help, e.g. "↑/k up • q quit".
+func shortHelp(shortcuts []shortcut) string {
+	parts := make([]string, 0, len(shortcuts))
+	for _, s := range shortcuts {
+		parts = append(parts, s.keys+" "+s.label)
+	}
+	return strings.Join(parts, " • ")
+}
+
+// handleHelpKey processes keyboard input while the help overlay is open.
+// Only closing the overlay and quitting are handled.
+func (m Model) handleHelpKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		m.quitting = true
+		return m, tea.Quit
+
+	case "esc":
+		m.showingHelp = false
+	}
+
+	if key.Matches(msg, m.keys.Help) {
+		m.showingHelp = false
+	}
+
+	return m, nil
+}
+
+// helpView renders the full-screen help overlay. The rows come from the
+// help text of the model's KeyMap so the overlay can't drift from the bindings.
+// See: https://pkg.go.dev/github.com/charmbracelet/bubbles/key#Binding.Help
+func (m Model) helpView() string {
+	bindings := m.keys.Bindings()
+
+	// Align descriptions on the widest key label
+	width := 0
+	for _, binding := range bindings {
+		width = max(width, len([]rune(binding.Help().Key)))
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("⌨️  Keybindings"))
+	b.WriteString("\n\n")
+	for _, binding := range bindings {
+		help := binding.Help()
+		padding := strings.Repeat(" ", width-len([]rune(help.Key)))
+		b.WriteString(fmt.Sprintf("  %s%s  %s\n", dateStyle.Render(help.Key), padding, help.Desc))
+	}
+	b.WriteString(helpStyle.Render("? or esc to close"))
+
+	return b.String()
+}
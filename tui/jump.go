@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// startJump opens the jump-to-date prompt.
+func (m Model) startJump() (tea.Model, tea.Cmd) {
+	m.jumping = true
+	m.jumpInput.SetValue("")
+	return m, m.jumpInput.Focus()
+}
+
+// handleJumpKey processes keyboard input while the jump prompt has focus.
+// Enter jumps to the typed date, esc cancels.
+func (m Model) handleJumpKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		m.quitting = true
+		return m, tea.Quit
+
+	case "esc":
+		m.jumping = false
+		m.jumpInput.Blur()
+		return m, nil
+
+	case "enter":
+		m.jumping = false
+		m.jumpInput.Blur()
+		m.jumpToDate(strings.TrimSpace(m.jumpInput.Value()))
+		return m, m.previewCmd()
+	}
+
+	var cmd tea.Cmd
+	m.jumpInput, cmd = m.jumpInput.Update(msg)
+	return m, cmd
+}
+
+// jumpToDate moves the cursor to the entry for date, or the nearest shown
+// entry when there is none for that exact day. Invalid dates leave the cursor
+// where it is and set a status message instead.
+func (m *Model) jumpToDate(date string) {
+	target, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		m.status = fmt.Sprintf("invalid date %q (expected YYYY-MM-DD)", date)
+		return
+	}
+
+	index := nearestEntry(m.entries, target)
+	if index < 0 {
+		m.status = "no entries to jump to"
+		return
+	}
+
+	m.cursor = index
+	m.adjustScroll()
+	if m.entries[index].Date != date {
+		m.status = fmt.Sprintf("no entry for %s, showing nearest (%s)", date, m.entries[index].Date)
+	}
+}
+
+// nearestEntry returns the index of the entry closest in time to target,
+// preferring the later entry on a tie, or -1 if no entry has a valid date.
+func nearestEntry(entries []Entry, target time.Time) int {
+	best := -1
+	var bestDistance time.Duration
+
+	for i, entry := range entries {
+		date, err := time.Parse("2006-01-02", entry.Date)
+		if err != nil {
+			continue
+		}
+
+		distance := date.Sub(target)
+		if distance < 0 {
+			distance = -distance
+		}
+
+		if best < 0 || distance < bestDistance || (distance == bestDistance && entry.Date > entries[best].Date) {
+			best = i
+			bestDistance = distance
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// keyAction names a binding in a KeyMap for configuration.
+type keyAction struct {
+	name    string
+	binding *key.Binding
+}
+
+// actions returns the KeyMap's bindings with the names used in the [keys]
+// config table, in help overlay order.
+func (k *KeyMap) actions() []keyAction {
+	return []keyAction{
+		{"up", &k.Up}, {"down", &k.Down}, {"page_up", &k.PageUp}, {"page_down", &k.PageDown},
+		{"home", &k.Home}, {"end", &k.End}, {"toggle", &k.Toggle}, {"read", &k.Read},
+		{"copy", &k.Copy}, {"edit", &k.Edit}, {"search", &k.Search}, {"jump", &k.Jump},
+		{"order", &k.Order}, {"help", &k.Help}, {"quit", &k.Quit},
+	}
+}
+
+// WithOverrides returns a copy of the KeyMap with the keys for each named
+// action replaced, e.g. {"up": {"w"}}. Actions that aren't listed keep their
+// keys. The help text shows the new keys so the overlay stays accurate.
+func (k KeyMap) WithOverrides(overrides map[string][]string) (KeyMap, error) {
+	byName := make(map[string]*key.Binding)
+	var names []string
+	for _, action := range k.actions() {
+		byName[action.name] = action.binding
+		names = append(names, action.name)
+	}
+
+	for name, keys := range overrides {
+		binding, ok := byName[name]
+		if !ok {
+			return k, fmt.Errorf("unknown key action: %s (valid actions: %s)", name, strings.Join(names, ", "))
+		}
+		if len(keys) == 0 {
+			return k, fmt.Errorf("no keys given for action %s", name)
+		}
+
+		binding.SetKeys(keys...)
+		binding.SetHelp(strings.Join(keys, "/"), binding.Help().Desc)
+	}
+
+	return k, nil
+}
+
+// Conflicts describes every key bound to more than one action. Pressing
+// such a key only ever triggers one of them.
+func (k KeyMap) Conflicts() []string {
+	owners := make(map[string][]string)
+	for _, action := range k.actions() {
+		for _, name := range action.binding.Keys() {
+			owners[name] = append(owners[name], action.name)
+		}
+	}
+
+	var conflicts []string
+	for name, actions := range owners {
+		if len(actions) > 1 {
+			conflicts = append(conflicts, fmt.Sprintf("key %q is bound to %s", name, strings.Join(actions, " and ")))
+		}
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
@@ -8,11 +8,12 @@ package tui
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"logmd/markdown"
 	"logmd/vault"
 )
 
@@ -26,22 +27,74 @@ type Entry struct {
 	Path string
 	// Title is extracted from the first heading or "(untitled)"
 	Title string
-	// Preview contains the first few lines for expanded view
+	// Preview contains the lines read for the expanded view; it grows as
+	// the entry is expanded further
 	Preview []string
-	// Expanded indicates whether this entry is currently expanded
-	Expanded bool
+	// Rendered holds Preview styled as markdown, one string per non-blank
+	// rendered line, when rendered previews are on (nil shows Preview)
+	Rendered []string
+	// Complete is true when Preview holds the rest of the entry
+	Complete bool
+	// Expansion is how far the entry is expanded, from ExpansionCollapsed
+	// to ExpansionFull
+	Expansion int
+	// Pending is true until Title and Preview have been read from the file
+	Pending bool
+	// Today marks the row pinned to the top of the timeline for today's date
+	Today bool
+	// Unwritten is true for a pinned today row whose entry doesn't exist yet
+	Unwritten bool
+}
+
+// Expansion levels of a timeline entry. Toggling an entry steps through
+// them in order and then collapses it again.
+const (
+	// ExpansionCollapsed shows only the date and title
+	ExpansionCollapsed = iota
+	// ExpansionPreview shows preview_lines lines
+	ExpansionPreview
+	// ExpansionMore shows three times as many lines
+	ExpansionMore
+	// ExpansionFull shows the whole entry
+	ExpansionFull
+)
+
+// allPreviewLines is a preview limit that reads the whole entry.
+const allPreviewLines = -1
+
+// previewLimit returns how many preview lines an entry expanded to
+// expansion shows, or allPreviewLines for the whole entry.
+func previewLimit(expansion, previewLines int) int {
+	switch expansion {
+	case ExpansionCollapsed:
+		return 0
+	case ExpansionPreview:
+		return previewLines
+	case ExpansionMore:
+		return previewLines * 3
+	default:
+		return allPreviewLines
+	}
 }
 
 // Model holds the state for the timeline TUI.
 // Learn: Bubble Tea models contain all the state needed for the interface.
 // See: https://github.com/charmbracelet/bubbletea/blob/master/examples/simple/main.go
 type Model struct {
-	// entries contains all journal entries loaded from the vault
+	// allEntries contains every journal entry loaded from the vault
+	allEntries []Entry
+	// entries contains the entries currently shown (allEntries after filtering)
 	entries []Entry
 	// cursor tracks the currently selected entry index
 	cursor int
 	// viewport height for scrolling calculations
 	viewportHeight int
+	// width is the terminal width used for wrapping previews (0 disables wrapping)
+	width int
+	// height is the terminal height (0 until the first WindowSizeMsg)
+	height int
+	// pageSize is how many entries pgup/pgdown move (0 means a screenful)
+	pageSize int
 	// scrollOffset for handling long lists
 	scrollOffset int
 	// quitting indicates the user wants to exit
@@ -52,8 +105,66 @@ type Model struct {
 	err error
 	// vaultDir is the directory containing journal entries
 	vaultDir string
+	// layout is how entries are arranged in vaultDir (empty means flat)
+	layout vault.Layout
+	// dateFormat is the time layout of entry filenames (empty means YYYY-MM-DD)
+	dateFormat string
+	// passphrase decrypts encrypted entries (empty when encryption is off)
+	passphrase string
+	// frontMatter lists the front matter fields new entries start with
+	frontMatter []string
+	// store, when set, replaces the vault on disk as the source of entries
+	store vault.Store
+	// today is the date pinned to the top of the timeline (empty disables pinning)
+	today string
 	// previewLines is the number of lines to show in previews
 	previewLines int
+	// previewFrom is where previews start (empty means after the title)
+	previewFrom PreviewFrom
+	// renderedPreview styles previews as markdown instead of showing raw lines
+	renderedPreview bool
+	// renderers render previews at the current width; replaced on resize
+	renderers *previewRenderers
+	// searching indicates the search input has focus
+	searching bool
+	// searchInput holds the search query being typed
+	searchInput textinput.Model
+	// filter is the applied search query (empty shows all entries)
+	filter string
+	// editor is the command used to open entries for editing
+	editor string
+	// editorArgs are the editor's arguments with a {file} placeholder
+	editorArgs string
+	// reading indicates the full-entry reader pane is open
+	reading bool
+	// readerDate is the date of the entry shown in the reader pane
+	readerDate string
+	// readerLines holds the rendered entry split into lines
+	readerLines []string
+	// readerScroll is the first visible line in the reader pane
+	readerScroll int
+	// jumping indicates the jump-to-date prompt has focus
+	jumping bool
+	// jumpInput holds the date being typed in the jump prompt
+	jumpInput textinput.Model
+	// status is a transient message shown in place of the help line
+	status string
+	// oldestFirst reverses the default newest-first ordering
+	oldestFirst bool
+	// showingHelp indicates the keybinding overlay is open
+	showingHelp bool
+	// style is the glamour style used by the reader pane (empty means auto)
+	style string
+	// codeTheme is the chroma theme for code blocks in the reader pane
+	// (empty keeps the style's own highlighting)
+	codeTheme string
+	// wikiLinks shows [[YYYY-MM-DD]] references as links in the reader pane
+	wikiLinks bool
+	// keys holds the keybindings used by the update loop and help overlay
+	keys KeyMap
+	// requested holds the dates whose previews have been asked for since the
+	// last reload, so scrolling doesn't queue the same file twice
+	requested map[string]bool
 }
 
 // KeyMap defines keybindings for the timeline interface.
@@ -66,6 +177,15 @@ type KeyMap struct {
 	Quit     key.Binding
 	PageUp   key.Binding
 	PageDown key.Binding
+	Search   key.Binding
+	Edit     key.Binding
+	Read     key.Binding
+	Copy     key.Binding
+	Jump     key.Binding
+	Order    key.Binding
+	Home     key.Binding
+	End      key.Binding
+	Help     key.Binding
 }
 
 // DefaultKeyMap returns the default keybindings for timeline navigation.
@@ -81,7 +201,7 @@ func DefaultKeyMap() KeyMap {
 		),
 		Toggle: key.NewBinding(
 			key.WithKeys("enter", " "),
-			key.WithHelp("enter/space", "toggle expand"),
+			key.WithHelp("enter/space", "expand more/collapse"),
 		),
 		Quit: key.NewBinding(
 			key.WithKeys("q", "ctrl+c"),
@@ -95,13 +215,70 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("pgdown"),
 			key.WithHelp("pgdown", "page down"),
 		),
+		Search: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "search"),
+		),
+		Edit: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit entry"),
+		),
+		Read: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "view full entry"),
+		),
+		Copy: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "copy entry"),
+		),
+		Jump: key.NewBinding(
+			key.WithKeys("g"),
+			key.WithHelp("g", "go to date"),
+		),
+		Order: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "toggle sort order"),
+		),
+		Home: key.NewBinding(
+			key.WithKeys("home"),
+			key.WithHelp("home", "first entry"),
+		),
+		End: key.NewBinding(
+			key.WithKeys("end"),
+			key.WithHelp("end", "last entry"),
+		),
+		Help: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "toggle help"),
+		),
 	}
 }
 
+// PreviewFrom controls where an entry's timeline preview starts.
+type PreviewFrom string
+
+const (
+	// PreviewAfterTitle starts the preview after the first "# " heading (the default)
+	PreviewAfterTitle PreviewFrom = "after_title"
+	// PreviewFirstContent starts the preview at the first line that is
+	// neither blank nor a heading, so "## " sections show their text
+	PreviewFirstContent PreviewFrom = "first_content"
+)
+
 // NewModel creates a new timeline model with the specified vault directory and preview lines.
 // Learn: Constructor functions should accept necessary configuration parameters.
 func NewModel(vaultDir string, previewLines int) Model {
+	searchInput := textinput.New()
+	searchInput.Prompt = "/ "
+	searchInput.Placeholder = "search titles and previews"
+
+	jumpInput := textinput.New()
+	jumpInput.Prompt = "go to: "
+	jumpInput.Placeholder = "YYYY-MM-DD"
+	jumpInput.CharLimit = 10
+
 	return Model{
+		allEntries:     []Entry{},
 		entries:        []Entry{},
 		cursor:         0,
 		viewportHeight: 20, // Default height, will be updated on resize
@@ -111,6 +288,139 @@ func NewModel(vaultDir string, previewLines int) Model {
 		err:            nil,
 		vaultDir:       vaultDir,
 		previewLines:   previewLines,
+		searchInput:    searchInput,
+		jumpInput:      jumpInput,
+		keys:           DefaultKeyMap(),
+	}
+}
+
+// WithEditor returns a copy of the model that opens entries with editor,
+// passing args as described by config.EditorCommand.
+// Learn: Value-receiver "With" methods configure immutable-style models.
+func (m Model) WithEditor(editor, args string) Model {
+	m.editor = editor
+	m.editorArgs = args
+	return m
+}
+
+// WithKeyMap returns a copy of the model that responds to keys.
+func (m Model) WithKeyMap(keys KeyMap) Model {
+	m.keys = keys
+	return m
+}
+
+// WithStyle returns a copy of the model that renders entries with a glamour style.
+func (m Model) WithStyle(style string) Model {
+	m.style = style
+	return m
+}
+
+// WithCodeTheme returns a copy of the model that highlights code blocks
+// in the reader pane with a chroma theme.
+func (m Model) WithCodeTheme(codeTheme string) Model {
+	m.codeTheme = codeTheme
+	return m
+}
+
+// WithWikiLinks returns a copy of the model whose reader pane shows
+// [[YYYY-MM-DD]] references to other entries as links.
+func (m Model) WithWikiLinks(enabled bool) Model {
+	m.wikiLinks = enabled
+	return m
+}
+
+// WithPageSize returns a copy of the model whose pgup/pgdown move n
+// entries. Zero or less pages by the number of entries on screen.
+func (m Model) WithPageSize(n int) Model {
+	m.pageSize = n
+	return m
+}
+
+// WithLayout returns a copy of the model that reads entries stored in layout.
+func (m Model) WithLayout(layout vault.Layout) Model {
+	m.layout = layout
+	return m
+}
+
+// WithPreviewFrom returns a copy of the model whose previews start at from.
+func (m Model) WithPreviewFrom(from PreviewFrom) Model {
+	m.previewFrom = from
+	return m
+}
+
+// WithRenderedPreview returns a copy of the model whose previews are
+// rendered as markdown, in the reader pane's style, instead of shown raw.
+func (m Model) WithRenderedPreview(enabled bool) Model {
+	m.renderedPreview = enabled
+	return m
+}
+
+// WithDateFormat returns a copy of the model that reads entries whose
+// filenames use the time layout format.
+func (m Model) WithDateFormat(format string) Model {
+	m.dateFormat = format
+	return m
+}
+
+// WithPassphrase returns a copy of the model that reads and edits encrypted
+// entries with passphrase.
+func (m Model) WithPassphrase(passphrase string) Model {
+	m.passphrase = passphrase
+	return m
+}
+
+// WithFrontMatter returns a copy of the model that starts entries it
+// creates with front matter listing fields.
+func (m Model) WithFrontMatter(fields []string) Model {
+	m.frontMatter = fields
+	return m
+}
+
+// WithToday returns a copy of the model that pins the entry for date
+// (YYYY-MM-DD) to the top of the timeline, showing a placeholder row when
+// that entry hasn't been written yet.
+func (m Model) WithToday(date string) Model {
+	m.today = date
+	return m
+}
+
+// WithStore returns a copy of the model that reads and creates entries in
+// s instead of the vault directory, such as a vault.Memory in tests.
+// Editing still needs files on disk, so it only works when s is a *vault.Vault.
+func (m Model) WithStore(s vault.Store) Model {
+	m.store = s
+	return m
+}
+
+// entryStore returns where the model reads entries from: the store set by
+// WithStore, or the vault on disk.
+func (m Model) entryStore() vault.Store {
+	if m.store != nil {
+		return m.store
+	}
+	return m.vault()
+}
+
+// loadEntriesCmd returns the command that (re)loads the entry list.
+func (m Model) loadEntriesCmd() tea.Cmd {
+	if m.store != nil {
+		store := m.store
+		return func() tea.Msg {
+			entries, err := loadEntriesFromStore(store)
+			return LoadEntriesMsg{Entries: entries, Error: err}
+		}
+	}
+	return LoadEntriesCmd(m.vaultDir, m.layout, m.dateFormat)
+}
+
+// vault returns the vault the model reads entries from.
+func (m Model) vault() *vault.Vault {
+	return &vault.Vault{
+		Directory:   m.vaultDir,
+		Layout:      m.layout,
+		DateFormat:  m.dateFormat,
+		Passphrase:  m.passphrase,
+		FrontMatter: m.frontMatter,
 	}
 }
 
@@ -129,10 +439,11 @@ type LoadEntriesMsg struct {
 }
 
 // LoadEntriesCmd returns a command that loads entries from the vault.
-// This is called asynchronously to avoid blocking the UI.
-func LoadEntriesCmd(vaultDir string, previewLines int) tea.Cmd {
+// This is called asynchronously to avoid blocking the UI. Only dates and
+// paths are loaded; titles and previews follow via LoadPreviewsCmd.
+func LoadEntriesCmd(vaultDir string, layout vault.Layout, dateFormat string) tea.Cmd {
 	return func() tea.Msg {
-		entries, err := loadEntriesFromVault(vaultDir, previewLines)
+		entries, err := loadEntriesFromVault(vaultDir, layout, dateFormat)
 		return LoadEntriesMsg{
 			Entries: entries,
 			Error:   err,
@@ -140,41 +451,45 @@ func LoadEntriesCmd(vaultDir string, previewLines int) tea.Cmd {
 	}
 }
 
-// loadEntriesFromVault loads all journal entries from the vault directory.
+// loadEntriesFromVault lists the journal entries in the vault directory
+// without reading them, so startup time doesn't grow with entry size.
 // Learn: Helper functions should handle complex operations to keep main logic clean.
-func loadEntriesFromVault(vaultDir string, previewLines int) ([]Entry, error) {
-	// Create vault instance
-	v, err := vault.New(vaultDir)
+func loadEntriesFromVault(vaultDir string, layout vault.Layout, dateFormat string) ([]Entry, error) {
+	// Open the vault without creating a missing directory
+	v, err := vault.OpenWithLayout(vaultDir, layout)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize vault: %w", err)
+		return nil, fmt.Errorf("failed to open vault: %w", err)
 	}
+	v.DateFormat = dateFormat
+	return loadEntriesFromStore(v)
+}
 
-	// Get list of all entries (returns filenames like "2024-01-01.md")
-	entryFiles, err := v.ListEntries()
+// loadEntriesFromStore lists the entries in s as pending timeline rows.
+func loadEntriesFromStore(s vault.Store) ([]Entry, error) {
+	// Get metadata for all entries, newest first
+	infos, err := s.ListEntriesInfo()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list entries: %w", err)
 	}
 
-	// Convert to Entry structs with previews
-	entries := make([]Entry, 0, len(entryFiles))
-	for _, filename := range entryFiles {
-		// Strip .md extension to get date
-		date := strings.TrimSuffix(filename, ".md")
-		entry, err := createEntryFromDate(v, date, previewLines)
-		if err != nil {
-			// Log error but continue with other entries
-			fmt.Fprintf(os.Stderr, "Warning: failed to load entry %s: %v\n", date, err)
-			continue
+	entries := make([]Entry, len(infos))
+	for i, info := range infos {
+		entries[i] = Entry{
+			Date:    info.Date,
+			Path:    info.Path,
+			Pending: true,
 		}
-		entries = append(entries, entry)
 	}
 
 	return entries, nil
 }
 
-// createEntryFromDate creates an Entry struct from a date by reading the file.
+// createEntryFromDate creates an Entry struct from a date by reading the
+// file, with up to previewLines preview lines (allPreviewLines for all).
+// With renderers the preview lines alone, not the whole entry, are also
+// rendered into Rendered; nil skips that entirely.
 // Learn: Small helper functions make code more readable and testable.
-func createEntryFromDate(v *vault.Vault, date string, previewLines int) (Entry, error) {
+func createEntryFromDate(v vault.Store, date string, previewLines int, from PreviewFrom, render *previewRenderers) (Entry, error) {
 	// Read entry content
 	content, err := v.ReadEntry(date)
 	if err != nil {
@@ -182,7 +497,13 @@ func createEntryFromDate(v *vault.Vault, date string, previewLines int) (Entry,
 	}
 
 	// Extract title and preview
-	title, preview := extractTitleAndPreview(string(content), previewLines)
+	title, preview := extractTitleAndPreview(string(content), previewLines, from)
+
+	// Render the snippet, keeping the raw lines if that fails
+	var rendered []string
+	if render != nil {
+		rendered = render.render(preview)
+	}
 
 	// Get file path
 	entryPath := v.DatePath(date)
@@ -192,13 +513,19 @@ func createEntryFromDate(v *vault.Vault, date string, previewLines int) (Entry,
 		Path:     entryPath,
 		Title:    title,
 		Preview:  preview,
-		Expanded: false,
+		Rendered: rendered,
+		Complete: previewLines == allPreviewLines || len(preview) < previewLines,
 	}, nil
 }
 
-// extractTitleAndPreview extracts the title and preview lines from entry content.
+// extractTitleAndPreview extracts the title and preview lines from entry
+// content. The preview starts after the title, or with PreviewFirstContent
+// at the first line that is neither blank nor a heading. A previewLines of
+// allPreviewLines keeps every line.
 // Learn: Text processing functions are common in CLI applications.
-func extractTitleAndPreview(content string, previewLines int) (string, []string) {
+func extractTitleAndPreview(content string, previewLines int, from PreviewFrom) (string, []string) {
+	// Front matter is metadata, and a "# " YAML comment in it isn't a title
+	content = string(markdown.StripFrontMatter([]byte(content)))
 	lines := strings.Split(content, "\n")
 
 	title := "(untitled)"
@@ -215,9 +542,19 @@ func extractTitleAndPreview(content string, previewLines int) (string, []string)
 		}
 	}
 
+	if from == PreviewFirstContent {
+		previewStart = len(lines)
+		for i, line := range lines {
+			if trimmed := strings.TrimSpace(line); trimmed != "" && !isHeadingLine(trimmed) {
+				previewStart = i
+				break
+			}
+		}
+	}
+
 	// Extract preview lines (skip empty lines at start)
 	previewCount := 0
-	for i := previewStart; i < len(lines) && previewCount < previewLines; i++ {
+	for i := previewStart; i < len(lines) && (previewLines == allPreviewLines || previewCount < previewLines); i++ {
 		line := lines[i]
 		if strings.TrimSpace(line) != "" || previewCount > 0 {
 			preview = append(preview, line)
@@ -228,8 +565,16 @@ func extractTitleAndPreview(content string, previewLines int) (string, []string)
 	return title, preview
 }
 
+// isHeadingLine reports whether a trimmed line is an ATX heading such as
+// "## Morning": one to six "#" followed by a space or nothing.
+func isHeadingLine(trimmed string) bool {
+	level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+	rest := trimmed[level:]
+	return level >= 1 && level <= 6 && (rest == "" || rest[0] == ' ' || rest[0] == '\t')
+}
+
 // Init returns the initial command for the model.
 // Learn: Init is called once when the program starts.
 func (m Model) Init() tea.Cmd {
-	return LoadEntriesCmd(m.vaultDir, m.previewLines)
+	return m.loadEntriesCmd()
 }
@@ -1,11 +1,17 @@
 package tui
 
 import (
+	"fmt"
 	"os"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
 	"logmd/vault"
 )
 
@@ -49,6 +55,7 @@ func TestExtractTitleAndPreview(t *testing.T) {
 		name            string
 		content         string
 		previewLines    int
+		from            PreviewFrom
 		expectedTitle   string
 		expectedPreview []string
 	}{
@@ -87,6 +94,44 @@ func TestExtractTitleAndPreview(t *testing.T) {
 			expectedTitle:   "Title",
 			expectedPreview: []string{"First line", "", "Second line"},
 		},
+		{
+			name:            "FrontMatter",
+			content:         "---\ndate: 2024-01-15\n# mood: calm\ntags: []\n---\n\n# 2024-01-15\n\nFirst line",
+			previewLines:    2,
+			expectedTitle:   "2024-01-15",
+			expectedPreview: []string{"First line"},
+		},
+		{
+			name:            "SubheadingAfterTitle",
+			content:         "# Title\n\n## Morning\nWoke early.\n## Evening\nRead.",
+			previewLines:    2,
+			expectedTitle:   "Title",
+			expectedPreview: []string{"## Morning", "Woke early."},
+		},
+		{
+			name:            "SubheadingFirstContent",
+			content:         "# Title\n\n## Morning\n\nWoke early.\n## Evening\nRead.",
+			previewLines:    2,
+			from:            PreviewFirstContent,
+			expectedTitle:   "Title",
+			expectedPreview: []string{"Woke early.", "## Evening"},
+		},
+		{
+			name:            "FirstContentBeforeTitle",
+			content:         "Intro line\n# Title\nBody",
+			previewLines:    1,
+			from:            PreviewFirstContent,
+			expectedTitle:   "Title",
+			expectedPreview: []string{"Intro line"},
+		},
+		{
+			name:            "FirstContentHeadingsOnly",
+			content:         "# Title\n## Empty\n#hashtag",
+			previewLines:    2,
+			from:            PreviewFirstContent,
+			expectedTitle:   "Title",
+			expectedPreview: []string{"#hashtag"},
+		},
 		{
 			name:            "LimitPreviewLines",
 			content:         "# Title\n\nLine 1\nLine 2\nLine 3\nLine 4",
@@ -98,7 +143,7 @@ func TestExtractTitleAndPreview(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			title, preview := extractTitleAndPreview(tc.content, tc.previewLines)
+			title, preview := extractTitleAndPreview(tc.content, tc.previewLines, tc.from)
 
 			if title != tc.expectedTitle {
 				t.Errorf("Expected title %q, got %q", tc.expectedTitle, title)
@@ -143,44 +188,130 @@ func TestLoadEntriesFromVault(t *testing.T) {
 	}
 
 	// Test loading entries
-	entries, err := loadEntriesFromVault(tmpDir, 2)
+	entries, err := loadEntriesFromVault(tmpDir, vault.LayoutFlat, "")
 	if err != nil {
 		t.Fatalf("Failed to load entries: %v", err)
 	}
 
-	// Should have 3 entries (vault.ListEntries returns newest first)
+	// Should have 3 entries (vault.ListEntriesInfo returns newest first)
 	if len(entries) != 3 {
-		t.Errorf("Expected 3 entries, got %d", len(entries))
+		t.Fatalf("Expected 3 entries, got %d", len(entries))
 	}
 
-	// Check that entries are loaded correctly
-	// Note: vault.ListEntries returns entries in reverse chronological order
+	// Entries are listed without being read
 	expectedDates := []string{"2024-01-03", "2024-01-02", "2024-01-01"}
-	expectedTitles := []string{"(untitled)", "Day Two", "New Year Resolution"}
-
 	for i, entry := range entries {
 		if entry.Date != expectedDates[i] {
 			t.Errorf("Entry %d: expected date %s, got %s", i, expectedDates[i], entry.Date)
 		}
+		if entry.Path != v.DatePath(entry.Date) {
+			t.Errorf("Entry %d: expected path %s, got %s", i, v.DatePath(entry.Date), entry.Path)
+		}
+		if !entry.Pending || entry.Title != "" {
+			t.Errorf("Entry %d: expected a pending entry without a title, got %+v", i, entry)
+		}
+	}
+
+	// Previews fill in the titles
+	previews := loadPreviews(v, expectedDates, 2, PreviewAfterTitle, nil)
+	expectedTitles := []string{"(untitled)", "Day Two", "New Year Resolution"}
+
+	for i, entry := range previews {
+		if entry.Date != expectedDates[i] {
+			t.Errorf("Preview %d: expected date %s, got %s", i, expectedDates[i], entry.Date)
+		}
 
 		if entry.Title != expectedTitles[i] {
-			t.Errorf("Entry %d: expected title %q, got %q", i, expectedTitles[i], entry.Title)
+			t.Errorf("Preview %d: expected title %q, got %q", i, expectedTitles[i], entry.Title)
 		}
 
-		if entry.Expanded {
-			t.Errorf("Entry %d: should not be expanded initially", i)
+		if entry.Expansion != ExpansionCollapsed || entry.Pending {
+			t.Errorf("Preview %d: should be neither expanded nor pending", i)
 		}
 
 		if len(entry.Preview) > 2 {
-			t.Errorf("Entry %d: preview should be limited to 2 lines, got %d", i, len(entry.Preview))
+			t.Errorf("Preview %d: preview should be limited to 2 lines, got %d", i, len(entry.Preview))
 		}
 	}
 }
 
+// TestLoadPreviewsOrdering verifies concurrent loading keeps the
+// newest-first order on every run.
+func TestLoadPreviewsOrdering(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+
+	// Enough entries that every worker handles several
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var expected []string
+	for i := 0; i < 200; i++ {
+		date := start.AddDate(0, 0, i).Format("2006-01-02")
+		if err := v.WriteEntry(date, []byte("# "+date)); err != nil {
+			t.Fatalf("Failed to write test entry %s: %v", date, err)
+		}
+		expected = append([]string{date}, expected...)
+	}
+
+	for run := 0; run < 5; run++ {
+		entries := loadPreviews(v, expected, 1, PreviewAfterTitle, nil)
+		if len(entries) != len(expected) {
+			t.Fatalf("Run %d: expected %d entries, got %d", run, len(expected), len(entries))
+		}
+		for i, entry := range entries {
+			if entry.Date != expected[i] || entry.Title != expected[i] {
+				t.Fatalf("Run %d: entry %d is %s (%q), expected %s", run, i, entry.Date, entry.Title, expected[i])
+			}
+		}
+	}
+}
+
+// TestUnreadablePreview tests that an entry that fails to load stops
+// showing as loading and says why instead.
+func TestUnreadablePreview(t *testing.T) {
+	store := vault.NewMemory()
+	if err := store.WriteEntry("2024-01-02", []byte("# Fine\n\nBody")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	m := NewModel("/does/not/exist", 5).WithStore(store)
+	updated, _ := m.Update(LoadEntriesMsg{Entries: []Entry{
+		{Date: "2024-01-02", Pending: true, Expansion: ExpansionPreview},
+		{Date: "2024-01-01", Pending: true, Expansion: ExpansionPreview},
+	}})
+	m = updated.(Model)
+
+	// 2024-01-01 is missing from the store, so reading it fails
+	updated, _ = m.Update(LoadPreviewsCmd(store, []string{"2024-01-02", "2024-01-01"}, 2, PreviewAfterTitle, nil)())
+	m = updated.(Model)
+	for _, entry := range m.entries {
+		if entry.Pending {
+			t.Errorf("Expected %s to be loaded, still pending", entry.Date)
+		}
+	}
+	if m.entries[0].Title != "Fine" {
+		t.Errorf("Expected the readable entry's title, got %q", m.entries[0].Title)
+	}
+	failed := m.entries[1]
+	if failed.Title != "(unreadable)" || !failed.Complete || len(failed.Preview) != 1 || !strings.Contains(failed.Preview[0], "2024-01-01") {
+		t.Errorf("Expected an unreadable entry with the error as preview, got %+v", failed)
+	}
+	if view := m.View(); strings.Contains(view, "loading preview") {
+		t.Errorf("Expected no loading placeholder, got:\n%s", view)
+	}
+}
+
 // TestLoadEntriesFromVaultError tests error handling when vault loading fails.
 func TestLoadEntriesFromVaultError(t *testing.T) {
 	// Try to load from non-existent directory
-	entries, err := loadEntriesFromVault("/nonexistent/directory", 5)
+	entries, err := loadEntriesFromVault("/nonexistent/directory", vault.LayoutFlat, "")
 
 	if err == nil {
 		t.Error("Expected error when loading from non-existent directory")
@@ -210,7 +341,7 @@ func TestModelUpdate(t *testing.T) {
 
 	// Test LoadEntriesMsg with success
 	entries := []Entry{
-		{Date: "2024-01-01", Title: "Test", Preview: []string{"Preview"}, Expanded: false},
+		{Date: "2024-01-01", Title: "Test", Preview: []string{"Preview"}},
 	}
 	loadMsg := LoadEntriesMsg{Entries: entries, Error: nil}
 
@@ -276,3 +407,1087 @@ func TestModelError(t *testing.T) {
 		t.Errorf("Expected error %v, got %v", os.ErrNotExist, model.Error())
 	}
 }
+
+// TestRenderPreviewLineWrapping tests that wrapped preview rows keep the indent.
+func TestRenderPreviewLineWrapping(t *testing.T) {
+	model := NewModel("/test", 5)
+	model.width = 24
+
+	rendered := model.renderPreviewLine("the quick brown fox jumps over the lazy dog", previewStyle)
+	lines := strings.Split(rendered, "\n")
+	if len(lines) < 2 {
+		t.Fatalf("Expected preview to wrap onto multiple rows, got %q", rendered)
+	}
+
+	indent := strings.Repeat(" ", previewIndent)
+	for i, line := range lines {
+		plain := ansi.Strip(line)
+		if !strings.HasPrefix(plain, indent) {
+			t.Errorf("Row %d should start with %d-space indent, got %q", i, previewIndent, plain)
+		}
+		if strings.HasPrefix(plain, indent+" ") {
+			t.Errorf("Row %d has more than %d spaces of indent: %q", i, previewIndent, plain)
+		}
+	}
+}
+
+// loadedModel returns a model populated with the given entries.
+func loadedModel(entries []Entry) Model {
+	updated, _ := NewModel("/test", 5).Update(LoadEntriesMsg{Entries: entries})
+	return updated.(Model)
+}
+
+// pressKeys sends a sequence of key messages to the model.
+func pressKeys(m Model, keys ...tea.KeyMsg) Model {
+	for _, k := range keys {
+		updated, _ := m.Update(k)
+		m = updated.(Model)
+	}
+	return m
+}
+
+// TestSearchMode tests live filtering, keeping and clearing the search.
+func TestSearchMode(t *testing.T) {
+	m := loadedModel([]Entry{
+		{Date: "2024-01-03", Title: "Deploy day", Preview: []string{"Shipped the release"}},
+		{Date: "2024-01-02", Title: "Quiet", Preview: []string{"Read a book"}},
+		{Date: "2024-01-01", Title: "Planning", Preview: []string{"Release checklist"}},
+	})
+	m.cursor = 2
+
+	slash := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}}
+	m = pressKeys(m, slash)
+	if !m.searching {
+		t.Fatal("Expected '/' to enter search mode")
+	}
+
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("RELEASE")})
+	if len(m.entries) != 2 {
+		t.Fatalf("Expected 2 matches for 'release', got %d", len(m.entries))
+	}
+	if m.cursor != 0 {
+		t.Errorf("Expected cursor reset to first match, got %d", m.cursor)
+	}
+
+	// Enter keeps the filter applied
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.searching || m.filter != "RELEASE" || len(m.entries) != 2 {
+		t.Errorf("Expected filter kept after enter, got searching=%v filter=%q entries=%d", m.searching, m.filter, len(m.entries))
+	}
+
+	// Navigation stays within the filtered set
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown})
+	if m.cursor != 1 {
+		t.Errorf("Expected cursor clamped to 1, got %d", m.cursor)
+	}
+
+	// Narrowing to no matches keeps bounds valid
+	m = pressKeys(m, slash, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("zzz")})
+	if len(m.entries) != 0 || m.cursor != 0 {
+		t.Errorf("Expected no matches with cursor 0, got %d entries, cursor %d", len(m.entries), m.cursor)
+	}
+	_ = m.View()
+
+	// Escape clears the filter
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.searching || m.filter != "" || len(m.entries) != 3 {
+		t.Errorf("Expected filter cleared after esc, got searching=%v filter=%q entries=%d", m.searching, m.filter, len(m.entries))
+	}
+}
+
+// TestToggleExpandedSurvivesFilter tests that expansion is kept when filtering.
+func TestToggleExpandedSurvivesFilter(t *testing.T) {
+	m := loadedModel([]Entry{
+		{Date: "2024-01-02", Title: "Alpha"},
+		{Date: "2024-01-01", Title: "Beta"},
+	})
+
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyEnter})
+	m.applyFilter("alpha")
+	m.applyFilter("")
+
+	if m.entries[0].Expansion != ExpansionPreview {
+		t.Error("Expected expanded state to survive filtering")
+	}
+}
+
+// TestToggleExpansionLevels tests stepping an entry through the expansion
+// levels, reading more of it as each level needs more lines.
+func TestToggleExpansionLevels(t *testing.T) {
+	var body strings.Builder
+	body.WriteString("# Long day\n\n")
+	for i := 1; i <= 10; i++ {
+		fmt.Fprintf(&body, "line %d\n", i)
+	}
+	store := vault.NewMemory()
+	if err := store.WriteEntry("2024-01-01", []byte(body.String())); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	m := NewModel("/does/not/exist", 2).WithStore(store)
+	updated, cmd := m.Update(m.Init()())
+	updated, _ = updated.(Model).Update(cmd())
+	m = updated.(Model)
+
+	// expand presses the toggle key and runs any command it returns
+	expand := func() {
+		updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = updated.(Model)
+		if cmd != nil {
+			updated, _ = m.Update(cmd())
+			m = updated.(Model)
+		}
+	}
+	shown := func() int {
+		return strings.Count(m.renderEntry(m.entries[0], false), "line ")
+	}
+
+	testCases := []struct {
+		expansion int
+		lines     int
+	}{
+		{ExpansionPreview, 2},
+		{ExpansionMore, 6},
+		{ExpansionFull, 10},
+		{ExpansionCollapsed, 0},
+		{ExpansionPreview, 2},
+	}
+	for _, tc := range testCases {
+		expand()
+		if m.entries[0].Expansion != tc.expansion || m.allEntries[0].Expansion != tc.expansion {
+			t.Fatalf("Expected expansion %d, got %d", tc.expansion, m.entries[0].Expansion)
+		}
+		if got := shown(); got != tc.lines {
+			t.Errorf("Expansion %d: expected %d lines, got %d", tc.expansion, tc.lines, got)
+		}
+	}
+	if !m.entries[0].Complete {
+		t.Error("Expected the entry to be complete after showing all of it")
+	}
+}
+
+// TestPreviewLimit tests the number of lines each expansion level shows.
+func TestPreviewLimit(t *testing.T) {
+	for expansion, expected := range map[int]int{
+		ExpansionCollapsed: 0,
+		ExpansionPreview:   5,
+		ExpansionMore:      15,
+		ExpansionFull:      allPreviewLines,
+	} {
+		if got := previewLimit(expansion, 5); got != expected {
+			t.Errorf("previewLimit(%d, 5) = %d, expected %d", expansion, got, expected)
+		}
+	}
+}
+
+// TestEditKey tests launching the editor and handling its result.
+func TestEditKey(t *testing.T) {
+	m := loadedModel([]Entry{{Date: "2024-01-01", Title: "Entry", Path: "/tmp/2024-01-01.md"}})
+
+	// Without an editor the command reports an error instead of crashing
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if cmd == nil {
+		t.Fatal("Expected a command from 'e'")
+	}
+	updated, _ := m.Update(cmd())
+	if updated.(Model).Error() == nil {
+		t.Error("Expected error when no editor is configured")
+	}
+
+	m = m.WithEditor("true", "")
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if cmd == nil {
+		t.Fatal("Expected an exec command from 'e'")
+	}
+
+	// A failed editor run surfaces in err
+	updated, _ = m.Update(editorFinishedMsg{err: os.ErrNotExist})
+	if updated.(Model).Error() == nil {
+		t.Error("Expected editor failure to be stored in err")
+	}
+
+	// A successful run reloads entries
+	updated, cmd = m.Update(editorFinishedMsg{})
+	if updated.(Model).Error() != nil || cmd == nil {
+		t.Error("Expected a reload command after the editor exits cleanly")
+	}
+}
+
+// TestCopyKey tests copying the selected entry's markdown with 'y'.
+func TestCopyKey(t *testing.T) {
+	store := vault.NewMemory()
+	if err := store.WriteEntry("2024-01-01", []byte("# New Year\n\nFresh start.\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	var copied string
+	var copyErr error
+	originalCopy := copyToClipboard
+	defer func() { copyToClipboard = originalCopy }()
+	copyToClipboard = func(text string) error {
+		copied = text
+		return copyErr
+	}
+
+	m := NewModel("/does/not/exist", 5).WithStore(store)
+	updated, _ := m.Update(LoadEntriesMsg{Entries: []Entry{{Date: "2024-01-01", Title: "New Year"}}})
+	m = updated.(Model)
+
+	// The raw markdown is copied and confirmed in the status line
+	_, cmd := m.Update(keyMsg("y"))
+	if cmd == nil {
+		t.Fatal("Expected a command from 'y'")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if copied != "# New Year\n\nFresh start.\n" {
+		t.Errorf("Expected the raw entry to be copied, got %q", copied)
+	}
+	if !strings.Contains(m.status, "Copied 2024-01-01") || m.Error() != nil {
+		t.Errorf("Expected a confirmation status, got status %q err %v", m.status, m.Error())
+	}
+
+	// A clipboard failure is reported without ending the session
+	copyErr = fmt.Errorf("no clipboard available")
+	_, cmd = m.Update(keyMsg("y"))
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if !strings.Contains(m.status, "no clipboard available") || m.Error() != nil {
+		t.Errorf("Expected the failure in the status line, got status %q err %v", m.status, m.Error())
+	}
+
+	// An unwritten today row has nothing to copy
+	m = loadedModel([]Entry{{Date: "2024-01-02", Unwritten: true, Today: true}})
+	updated, cmd = m.Update(keyMsg("y"))
+	if cmd != nil || updated.(Model).status == "" {
+		t.Errorf("Expected a status message for copying an unwritten entry, got %q", updated.(Model).status)
+	}
+}
+
+// TestReloadKeepsSelection tests that reloading keeps the cursor on the same date.
+func TestReloadKeepsSelection(t *testing.T) {
+	entries := []Entry{{Date: "2024-01-03"}, {Date: "2024-01-02"}, {Date: "2024-01-01"}}
+	m := loadedModel(entries)
+	m.cursor = 1
+
+	// A new entry shifts the selected date down by one
+	updated, _ := m.Update(LoadEntriesMsg{Entries: append([]Entry{{Date: "2024-01-04"}}, entries...)})
+	m = updated.(Model)
+	if m.entries[m.cursor].Date != "2024-01-02" {
+		t.Errorf("Expected cursor on 2024-01-02, got %s", m.entries[m.cursor].Date)
+	}
+}
+
+// TestReaderPane tests opening, scrolling and closing the full-entry reader.
+func TestReaderPane(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	var body strings.Builder
+	body.WriteString("# Long Entry\n\n")
+	for i := 0; i < 50; i++ {
+		body.WriteString("Paragraph line.\n\n")
+	}
+	if err := v.WriteEntry("2024-01-01", []byte(body.String())); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	m := loadedModel([]Entry{{Date: "2024-01-01", Path: v.DatePath("2024-01-01")}})
+	m.vaultDir = tmpDir
+	m.viewportHeight = 10
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	if cmd == nil {
+		t.Fatal("Expected a render command from 'v'")
+	}
+	updated, _ := m.Update(cmd())
+	m = updated.(Model)
+	if !m.reading || len(m.readerLines) <= m.readerHeight() {
+		t.Fatalf("Expected reader pane with scrollable content, got reading=%v lines=%d", m.reading, len(m.readerLines))
+	}
+
+	// Scrolling never goes past either end
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyUp})
+	if m.readerScroll != 0 {
+		t.Errorf("Expected scroll clamped at 0, got %d", m.readerScroll)
+	}
+	for i := 0; i < 20; i++ {
+		m = pressKeys(m, tea.KeyMsg{Type: tea.KeyPgDown})
+	}
+	if maxScroll := len(m.readerLines) - m.readerHeight(); m.readerScroll != maxScroll {
+		t.Errorf("Expected scroll clamped at %d, got %d", maxScroll, m.readerScroll)
+	}
+	if strings.Count(m.View(), "\n") > m.readerHeight()+4 {
+		t.Error("Reader view should not exceed the viewport height")
+	}
+
+	// Escape returns to the list
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.reading {
+		t.Error("Expected esc to close the reader pane")
+	}
+
+	// Render errors are surfaced instead of opening the pane
+	updated, _ = m.Update(RenderEntryMsg{Date: "2024-01-01", Error: os.ErrNotExist})
+	if updated.(Model).Error() == nil {
+		t.Error("Expected render error to be stored in err")
+	}
+}
+
+// TestJumpToDate tests exact, nearest and invalid jump targets.
+func TestJumpToDate(t *testing.T) {
+	m := loadedModel([]Entry{
+		{Date: "2024-03-10"},
+		{Date: "2024-03-01"},
+		{Date: "2024-02-01"},
+	})
+
+	jump := func(m Model, date string) Model {
+		m = pressKeys(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+		if !m.jumping {
+			t.Fatal("Expected 'g' to open the jump prompt")
+		}
+		return pressKeys(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(date)}, tea.KeyMsg{Type: tea.KeyEnter})
+	}
+
+	m = jump(m, "2024-03-01")
+	if m.cursor != 1 || m.status != "" {
+		t.Errorf("Expected exact jump to index 1 without status, got cursor %d status %q", m.cursor, m.status)
+	}
+
+	// 2024-02-20 is 10 days from 2024-03-01 and 19 days from 2024-02-01
+	m = jump(m, "2024-02-20")
+	if m.cursor != 1 || m.status == "" {
+		t.Errorf("Expected nearest jump to index 1 with status, got cursor %d status %q", m.cursor, m.status)
+	}
+
+	m = jump(m, "2025-01-01")
+	if m.cursor != 0 {
+		t.Errorf("Expected jump past newest entry to index 0, got %d", m.cursor)
+	}
+
+	// Invalid input keeps the cursor and shows a transient message
+	m = jump(m, "2024-13-40")
+	if m.cursor != 0 || !strings.Contains(m.status, "invalid date") {
+		t.Errorf("Expected invalid date status without moving, got cursor %d status %q", m.cursor, m.status)
+	}
+	if !strings.Contains(m.View(), "invalid date") {
+		t.Error("Expected status message in the help line")
+	}
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyDown})
+	if m.status != "" {
+		t.Error("Expected status to clear on the next key press")
+	}
+}
+
+// TestNearestEntryTie tests that ties prefer the later entry.
+func TestNearestEntryTie(t *testing.T) {
+	entries := []Entry{{Date: "2024-01-03"}, {Date: "2024-01-01"}}
+	target := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	if got := nearestEntry(entries, target); got != 0 {
+		t.Errorf("Expected tie to pick the later entry (index 0), got %d", got)
+	}
+	if got := nearestEntry(nil, target); got != -1 {
+		t.Errorf("Expected -1 for no entries, got %d", got)
+	}
+}
+
+// TestToggleSortOrder tests reversing the order while keeping the selection.
+func TestToggleSortOrder(t *testing.T) {
+	m := loadedModel([]Entry{{Date: "2024-01-03"}, {Date: "2024-01-02"}, {Date: "2024-01-01"}})
+	m.viewportHeight = 6
+	m.cursor = 0
+
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	if !m.oldestFirst || m.entries[0].Date != "2024-01-01" {
+		t.Fatalf("Expected oldest-first order, got first entry %s", m.entries[0].Date)
+	}
+	if m.entries[m.cursor].Date != "2024-01-03" {
+		t.Errorf("Expected cursor to stay on 2024-01-03, got %s", m.entries[m.cursor].Date)
+	}
+	if start, end := m.visibleRange(); m.cursor < start || m.cursor > end {
+		t.Errorf("Cursor %d should be visible in range %d-%d", m.cursor, start, end)
+	}
+	if !strings.Contains(m.View(), "oldest first") {
+		t.Error("Expected sort direction in the title bar")
+	}
+
+	// Reloads keep the chosen order
+	updated, _ := m.Update(LoadEntriesMsg{Entries: []Entry{{Date: "2024-01-04"}, {Date: "2024-01-03"}}})
+	m = updated.(Model)
+	if m.entries[0].Date != "2024-01-03" {
+		t.Errorf("Expected reload to keep oldest-first order, got first entry %s", m.entries[0].Date)
+	}
+}
+
+// TestFooterPosition tests the position footer with and without a filter.
+func TestFooterPosition(t *testing.T) {
+	m := loadedModel([]Entry{
+		{Date: "2024-01-03", Title: "Gym"},
+		{Date: "2024-01-02", Title: "Work"},
+		{Date: "2024-01-01", Title: "Gym again"},
+	})
+
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyDown})
+	if !strings.Contains(m.View(), "entry 2 of 3 • 2024-01-02") {
+		t.Errorf("Expected footer to track the cursor, got %q", m.footer())
+	}
+
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("gym")})
+	if got := m.footer(); got != "entry 1 of 2 • 2 of 3 matched • 2024-01-03" {
+		t.Errorf("Expected filtered footer, got %q", got)
+	}
+
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("zzz")})
+	if got := m.footer(); got != "0 of 3 matched" {
+		t.Errorf("Expected empty filtered footer, got %q", got)
+	}
+}
+
+// TestLazyPreviews tests that previews are read on demand and cached.
+func TestLazyPreviews(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	for _, date := range []string{"2024-01-01", "2024-01-02", "2024-01-03", "2024-01-04"} {
+		if err := v.WriteEntry(date, []byte("# Title "+date+"\n\nBody "+date)); err != nil {
+			t.Fatalf("Failed to write test entry %s: %v", date, err)
+		}
+	}
+
+	entries, err := loadEntriesFromVault(tmpDir, vault.LayoutFlat, "")
+	if err != nil {
+		t.Fatalf("Failed to load entries: %v", err)
+	}
+
+	// Only two entries fit on screen
+	m := NewModel(tmpDir, 5)
+	m.viewportHeight = 5
+	updated, cmd := m.Update(LoadEntriesMsg{Entries: entries})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a command loading the visible previews")
+	}
+	if !strings.Contains(m.View(), "loading…") {
+		t.Error("Expected pending entries to show a loading title")
+	}
+
+	msg, ok := cmd().(LoadPreviewsMsg)
+	if !ok || len(msg.Entries) != 2 {
+		t.Fatalf("Expected previews for the 2 visible entries, got %+v", msg)
+	}
+	updated, _ = m.Update(msg)
+	m = updated.(Model)
+	if m.entries[0].Title != "Title 2024-01-04" || m.entries[0].Pending {
+		t.Errorf("Expected loaded title on the first entry, got %+v", m.entries[0])
+	}
+	if !m.entries[2].Pending {
+		t.Error("Expected entries off screen to stay pending")
+	}
+
+	// Re-expanding a loaded entry doesn't read it again
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Error("Expected no command when expanding a cached entry")
+	}
+
+	// Searching loads the rest so the filter can match them
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	m = updated.(Model)
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("body 2024-01-01")})
+	if len(m.entries) != 0 {
+		t.Fatalf("Expected no matches before the previews load, got %d", len(m.entries))
+	}
+	for _, result := range cmd().(tea.BatchMsg) {
+		if msg, ok := result().(LoadPreviewsMsg); ok {
+			updated, _ = m.Update(msg)
+			m = updated.(Model)
+		}
+	}
+	if len(m.entries) != 1 || m.entries[0].Date != "2024-01-01" {
+		t.Errorf("Expected the search to match the newly loaded entry, got %+v", m.entries)
+	}
+}
+
+// TestHelpOverlay tests toggling the keybinding overlay.
+func TestHelpOverlay(t *testing.T) {
+	m := loadedModel([]Entry{{Date: "2024-01-02"}, {Date: "2024-01-01"}})
+	question := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}}
+
+	m = pressKeys(m, question)
+	if !m.showingHelp {
+		t.Fatal("Expected '?' to open the help overlay")
+	}
+
+	// Every binding's help text is listed
+	view := ansi.Strip(m.View())
+	for _, binding := range DefaultKeyMap().Bindings() {
+		help := binding.Help()
+		if !strings.Contains(view, help.Key) || !strings.Contains(view, help.Desc) {
+			t.Errorf("Expected overlay to list %q (%s)", help.Key, help.Desc)
+		}
+	}
+
+	// Other keys are ignored while the overlay is open
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyDown})
+	if m.cursor != 0 || !m.showingHelp {
+		t.Errorf("Expected navigation keys to be ignored, got cursor %d", m.cursor)
+	}
+
+	m = pressKeys(m, question)
+	if m.showingHelp {
+		t.Error("Expected '?' to close the help overlay")
+	}
+
+	m = pressKeys(m, question, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showingHelp {
+		t.Error("Expected esc to close the help overlay")
+	}
+}
+
+// TestKeyBindings tests that every key bound in DefaultKeyMap triggers its action.
+func TestKeyBindings(t *testing.T) {
+	var entries []Entry
+	for day := 20; day >= 1; day-- {
+		entries = append(entries, Entry{Date: fmt.Sprintf("2024-01-%02d", day), Path: "/tmp/entry.md"})
+	}
+
+	keys := DefaultKeyMap()
+	testCases := []struct {
+		name    string
+		binding key.Binding
+		cursor  int
+		check   func(before, after Model, cmd tea.Cmd) bool
+	}{
+		{"Up", keys.Up, 5, func(_, m Model, _ tea.Cmd) bool { return m.cursor == 4 }},
+		{"Down", keys.Down, 5, func(_, m Model, _ tea.Cmd) bool { return m.cursor == 6 }},
+		{"PageUp", keys.PageUp, 18, func(_, m Model, _ tea.Cmd) bool { return m.cursor == 18-m.visibleRows() }},
+		{"PageDown", keys.PageDown, 2, func(_, m Model, _ tea.Cmd) bool { return m.cursor == 2+m.visibleRows() }},
+		{"Home", keys.Home, 5, func(_, m Model, _ tea.Cmd) bool { return m.cursor == 0 }},
+		{"End", keys.End, 5, func(_, m Model, _ tea.Cmd) bool { return m.cursor == 19 }},
+		{"Toggle", keys.Toggle, 5, func(_, m Model, _ tea.Cmd) bool { return m.entries[5].Expansion == ExpansionPreview }},
+		{"Search", keys.Search, 0, func(_, m Model, _ tea.Cmd) bool { return m.searching }},
+		{"Jump", keys.Jump, 0, func(_, m Model, _ tea.Cmd) bool { return m.jumping }},
+		{"Order", keys.Order, 0, func(_, m Model, _ tea.Cmd) bool { return m.oldestFirst }},
+		{"Help", keys.Help, 0, func(_, m Model, _ tea.Cmd) bool { return m.showingHelp }},
+		{"Quit", keys.Quit, 0, func(_, m Model, _ tea.Cmd) bool { return m.quitting }},
+		{"Edit", keys.Edit, 0, func(_, _ Model, cmd tea.Cmd) bool { return cmd != nil }},
+		{"Read", keys.Read, 0, func(_, _ Model, cmd tea.Cmd) bool { return cmd != nil }},
+		{"Copy", keys.Copy, 0, func(_, _ Model, cmd tea.Cmd) bool { return cmd != nil }},
+	}
+
+	for _, tc := range testCases {
+		for _, k := range tc.binding.Keys() {
+			t.Run(tc.name+"/"+k, func(t *testing.T) {
+				// Toggling writes through to the loaded slice, so use a fresh copy
+				m := loadedModel(slices.Clone(entries))
+				m.cursor = tc.cursor
+
+				updated, cmd := m.Update(keyMsg(k))
+				if !tc.check(m, updated.(Model), cmd) {
+					t.Errorf("Pressing %q did not trigger %s", k, tc.name)
+				}
+			})
+		}
+	}
+}
+
+// keyMsg builds the key message bubbletea sends for a binding's key name.
+func keyMsg(name string) tea.KeyMsg {
+	special := map[string]tea.KeyType{
+		"up": tea.KeyUp, "down": tea.KeyDown, "pgup": tea.KeyPgUp, "pgdown": tea.KeyPgDown,
+		"home": tea.KeyHome, "end": tea.KeyEnd, "enter": tea.KeyEnter, " ": tea.KeySpace,
+		"ctrl+c": tea.KeyCtrlC, "esc": tea.KeyEsc,
+	}
+	if keyType, ok := special[name]; ok {
+		return tea.KeyMsg{Type: keyType}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(name)}
+}
+
+// TestKeyMapOverrides tests replacing bindings from config and detecting clashes.
+func TestKeyMapOverrides(t *testing.T) {
+	keys, err := DefaultKeyMap().WithOverrides(map[string][]string{"down": {"s"}, "up": {"w", "up"}})
+	if err != nil {
+		t.Fatalf("WithOverrides() failed: %v", err)
+	}
+	if keys.Up.Help().Key != "w/up" || keys.Up.Help().Desc != "move up" {
+		t.Errorf("Expected help text to follow the new keys, got %+v", keys.Up.Help())
+	}
+	if !reflect.DeepEqual(keys.Quit.Keys(), DefaultKeyMap().Quit.Keys()) {
+		t.Errorf("Expected unlisted actions to keep defaults, got %v", keys.Quit.Keys())
+	}
+	if conflicts := keys.Conflicts(); len(conflicts) != 0 {
+		t.Errorf("Expected no conflicts, got %v", conflicts)
+	}
+
+	// The model follows the configured keys
+	m := loadedModel([]Entry{{Date: "2024-01-02"}, {Date: "2024-01-01"}}).WithKeyMap(keys)
+	m = pressKeys(m, keyMsg("s"))
+	if m.cursor != 1 {
+		t.Errorf("Expected 's' to move down, got cursor %d", m.cursor)
+	}
+	m = pressKeys(m, keyMsg("j"))
+	if m.cursor != 1 {
+		t.Error("Expected 'j' to be unbound after overriding down")
+	}
+	if !strings.Contains(ansi.Strip(pressKeys(m, keyMsg("?")).View()), "w/up") {
+		t.Error("Expected the help overlay to show the configured keys")
+	}
+	view := ansi.Strip(m.View())
+	if !strings.Contains(view, "w/up up") || !strings.Contains(view, "y copy") || strings.Contains(view, "↑/k") {
+		t.Errorf("Expected the help line to show the configured keys, got:\n%s", view)
+	}
+
+	// The reader pane scrolls and describes itself with the same keys
+	m.reading = true
+	m.readerLines = []string{"one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten"}
+	m.viewportHeight = 3
+	if view := ansi.Strip(m.View()); !strings.Contains(view, "s down") || strings.Contains(view, "↓/j") {
+		t.Errorf("Expected the reader help line to show the configured keys, got:\n%s", view)
+	}
+	if m = pressKeys(m, keyMsg("s")); m.readerScroll != 1 {
+		t.Errorf("Expected 's' to scroll the reader, got scroll %d", m.readerScroll)
+	}
+
+	// Binding one key to two actions is reported
+	keys, err = DefaultKeyMap().WithOverrides(map[string][]string{"jump": {"j"}})
+	if err != nil {
+		t.Fatalf("WithOverrides() failed: %v", err)
+	}
+	if conflicts := keys.Conflicts(); len(conflicts) != 1 || !strings.Contains(conflicts[0], "down and jump") {
+		t.Errorf("Expected a conflict between down and jump, got %v", conflicts)
+	}
+
+	// Unknown actions and empty key lists are rejected
+	if _, err := DefaultKeyMap().WithOverrides(map[string][]string{"fly": {"f"}}); err == nil {
+		t.Error("Expected error for an unknown action")
+	}
+	if _, err := DefaultKeyMap().WithOverrides(map[string][]string{"up": {}}); err == nil {
+		t.Error("Expected error for an action without keys")
+	}
+}
+
+// TestPinnedToday tests that today's entry stays on top and that an
+// unwritten today row is created on enter.
+func TestPinnedToday(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := vault.New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+	for _, date := range []string{"2024-01-01", "2024-01-02"} {
+		if err := v.WriteEntry(date, []byte("# "+date)); err != nil {
+			t.Fatalf("Failed to write test entry %s: %v", date, err)
+		}
+	}
+
+	entries, err := loadEntriesFromVault(tmpDir, vault.LayoutFlat, "")
+	if err != nil {
+		t.Fatalf("Failed to load entries: %v", err)
+	}
+
+	m := NewModel(tmpDir, 5).WithToday("2024-01-03")
+	updated, _ := m.Update(LoadEntriesMsg{Entries: entries})
+	m = updated.(Model)
+
+	// An unwritten placeholder is pinned above the existing entries
+	if len(m.entries) != 3 {
+		t.Fatalf("Expected 3 rows, got %d", len(m.entries))
+	}
+	pinned := m.entries[0]
+	if pinned.Date != "2024-01-03" || !pinned.Today || !pinned.Unwritten || pinned.Pending {
+		t.Errorf("Expected an unwritten today row first, got %+v", pinned)
+	}
+	view := m.View()
+	if !strings.Contains(view, "📌") || !strings.Contains(view, "(not written)") {
+		t.Errorf("Expected the pinned row to be marked as not written, got:\n%s", view)
+	}
+
+	// Reversing the order keeps it on top
+	m = pressKeys(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	dates := []string{m.entries[0].Date, m.entries[1].Date, m.entries[2].Date}
+	if !slices.Equal(dates, []string{"2024-01-03", "2024-01-01", "2024-01-02"}) {
+		t.Errorf("Expected today pinned above oldest-first entries, got %v", dates)
+	}
+	if m.cursor != 0 {
+		t.Errorf("Expected the cursor to stay on the pinned row, got %d", m.cursor)
+	}
+
+	// Viewing an unwritten entry explains instead of failing
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	m = updated.(Model)
+	if cmd != nil || m.status == "" {
+		t.Errorf("Expected a status message for viewing an unwritten entry, got %q", m.status)
+	}
+
+	// Enter creates the entry and reloads the timeline
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a command creating today's entry")
+	}
+	msg, ok := cmd().(LoadEntriesMsg)
+	if !ok || msg.Error != nil {
+		t.Fatalf("Expected entries to reload, got %+v", msg)
+	}
+	if !v.EntryExists("2024-01-03") {
+		t.Fatal("Expected today's entry to be created")
+	}
+	updated, _ = m.Update(msg)
+	m = updated.(Model)
+	pinned = m.entries[0]
+	if pinned.Date != "2024-01-03" || !pinned.Today || pinned.Unwritten || !pinned.Pending {
+		t.Errorf("Expected the written entry pinned first, got %+v", pinned)
+	}
+	if len(m.entries) != 3 {
+		t.Errorf("Expected the placeholder to be replaced, got %d rows", len(m.entries))
+	}
+
+	// Without WithToday nothing is pinned
+	m = loadedModel(entries)
+	if len(m.entries) != 2 || m.entries[0].Today {
+		t.Errorf("Expected no pinned row by default, got %+v", m.entries)
+	}
+}
+
+// TestModelWithStore tests loading, previewing and reading entries from an
+// in-memory store without a journal directory.
+func TestModelWithStore(t *testing.T) {
+	store := vault.NewMemory()
+	for date, content := range map[string]string{
+		"2024-01-01": "# New Year\n\nFresh start.",
+		"2024-01-02": "# Back to Work\n\nInbox zero.",
+	} {
+		if err := store.WriteEntry(date, []byte(content)); err != nil {
+			t.Fatalf("Failed to write entry %s: %v", date, err)
+		}
+	}
+
+	m := NewModel("/does/not/exist", 5).WithStore(store)
+	updated, cmd := m.Update(m.Init()())
+	m = updated.(Model)
+	if m.Error() != nil {
+		t.Fatalf("Expected entries from the store, got error %v", m.Error())
+	}
+	if len(m.entries) != 2 || m.entries[0].Date != "2024-01-02" {
+		t.Fatalf("Expected 2 entries newest first, got %+v", m.entries)
+	}
+
+	// Previews are read from the store too
+	if cmd == nil {
+		t.Fatal("Expected a command loading previews")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.entries[0].Title != "Back to Work" || m.entries[1].Title != "New Year" {
+		t.Errorf("Expected titles from the store, got %q and %q", m.entries[0].Title, m.entries[1].Title)
+	}
+
+	// Editing needs files on disk
+	m.editor = "vi"
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if cmd == nil {
+		t.Fatal("Expected a command from 'e'")
+	}
+	if msg, ok := cmd().(editorFinishedMsg); !ok || msg.err == nil {
+		t.Errorf("Expected an editing error for an in-memory store, got %#v", msg)
+	}
+
+	// Creating today's entry writes to the store
+	m = m.WithToday("2024-01-03")
+	updated, _ = m.Update(LoadEntriesMsg{Entries: m.allEntries})
+	m = updated.(Model)
+	m.cursor = 0
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("Expected a command creating today's entry")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if !store.EntryExists("2024-01-03") || len(m.entries) != 3 || m.entries[0].Unwritten {
+		t.Errorf("Expected today's entry created in the store, got %+v", m.entries)
+	}
+}
+
+// TestTooSmallTerminal tests that a tiny window shows a resize message
+// instead of the timeline, only quits on keys, and recovers on resize.
+func TestTooSmallTerminal(t *testing.T) {
+	model := NewModel("/test", 5)
+	model.loading = false
+	model.allEntries = []Entry{{Date: "2024-01-02", Title: "Tuesday"}, {Date: "2024-01-01", Title: "Monday"}}
+	model.entries = model.allEntries
+
+	// The size is unknown until the first WindowSizeMsg
+	if model.tooSmall() {
+		t.Error("Expected an unknown size not to count as too small")
+	}
+
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 30, Height: 8})
+	m := updated.(Model)
+	view := m.View()
+	if !strings.Contains(view, "Terminal too small") || !strings.Contains(view, "30×8, need 40×12") {
+		t.Errorf("Expected a too small message, got %q", view)
+	}
+	if strings.Contains(view, "Journal Timeline") {
+		t.Errorf("Expected the timeline to be hidden, got %q", view)
+	}
+
+	// Navigation is ignored while nothing can be seen
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if m = updated.(Model); m.cursor != 0 {
+		t.Errorf("Expected the cursor to stay put, got %d", m.cursor)
+	}
+
+	// Growing only one dimension isn't enough
+	updated, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 11})
+	if m = updated.(Model); !m.tooSmall() {
+		t.Error("Expected 120×11 to be too small")
+	}
+
+	updated, _ = m.Update(tea.WindowSizeMsg{Width: MinWidth, Height: MinHeight})
+	m = updated.(Model)
+	if view := m.View(); !strings.Contains(view, "Journal Timeline") {
+		t.Errorf("Expected the timeline after resizing, got %q", view)
+	}
+
+	updated, _ = m.Update(tea.WindowSizeMsg{Width: 20, Height: 5})
+	_, cmd := updated.(Model).Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if cmd == nil {
+		t.Error("Expected q to quit while the terminal is too small")
+	}
+}
+
+// TestTinyWindowSizes tests that tiny windows never produce negative or
+// inverted ranges, and that View keeps the selected entry in sight.
+func TestTinyWindowSizes(t *testing.T) {
+	entries := []Entry{
+		{Date: "2024-01-05", Title: "Friday"},
+		{Date: "2024-01-04", Title: "Thursday"},
+		{Date: "2024-01-03", Title: "Wednesday"},
+		{Date: "2024-01-02", Title: "Tuesday"},
+		{Date: "2024-01-01", Title: "Monday"},
+	}
+
+	for _, height := range []int{1, 3, 6} {
+		m := loadedModel(entries)
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: height})
+		m = updated.(Model)
+		if m.viewportHeight < 1 {
+			t.Errorf("height %d: expected viewportHeight of at least 1, got %d", height, m.viewportHeight)
+		}
+
+		// Walk to the bottom and check the range at every step
+		for range entries {
+			start, end := m.visibleRange()
+			if start < 0 || end < start || m.cursor < start || m.cursor > end {
+				t.Errorf("height %d: cursor %d outside range %d-%d", height, m.cursor, start, end)
+			}
+			if m.scrollOffset < 0 {
+				t.Errorf("height %d: negative scrollOffset %d", height, m.scrollOffset)
+			}
+			if view := m.View(); !strings.Contains(view, m.entries[m.cursor].Date) {
+				t.Errorf("height %d: expected selected entry %s in view, got %q", height, m.entries[m.cursor].Date, view)
+			}
+			m.cursor = min(m.cursor+1, len(m.entries)-1)
+			m.adjustScroll()
+		}
+	}
+
+	// No entries and an out-of-range offset still give a valid range
+	m := NewModel("/test", 5)
+	m.viewportHeight = 1
+	m.scrollOffset = 7
+	if start, end := m.visibleRange(); start != 0 || end != 0 {
+		t.Errorf("Expected range 0-0 with no entries, got %d-%d", start, end)
+	}
+}
+
+// TestPaging tests that pgdown and pgup move by a screenful or the
+// configured page size, and that paging down then up returns to the start.
+func TestPaging(t *testing.T) {
+	var entries []Entry
+	for day := 28; day >= 1; day-- {
+		entries = append(entries, Entry{Date: fmt.Sprintf("2024-02-%02d", day)})
+	}
+	pgdown := tea.KeyMsg{Type: tea.KeyPgDown}
+	pgup := tea.KeyMsg{Type: tea.KeyPgUp}
+
+	m := loadedModel(entries)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 14})
+	m = updated.(Model)
+	rows := m.visibleRows()
+
+	m.cursor = 1
+	m = pressKeys(m, pgdown)
+	if m.cursor != 1+rows {
+		t.Errorf("Expected pgdown to move a screenful (%d) to %d, got %d", rows, 1+rows, m.cursor)
+	}
+	start, end := m.visibleRange()
+	if m.cursor < start || m.cursor > end {
+		t.Errorf("Cursor %d should be visible in range %d-%d", m.cursor, start, end)
+	}
+	offset := m.scrollOffset
+
+	m = pressKeys(m, pgdown, pgup)
+	if m.cursor != 1+rows || m.scrollOffset != offset {
+		t.Errorf("Expected pgdown then pgup to return to cursor %d offset %d, got %d offset %d", 1+rows, offset, m.cursor, m.scrollOffset)
+	}
+
+	// The ends of the list stop a page short
+	for range entries {
+		m = pressKeys(m, pgdown)
+	}
+	if m.cursor != len(entries)-1 {
+		t.Errorf("Expected pgdown to stop at the last entry, got %d", m.cursor)
+	}
+	for range entries {
+		m = pressKeys(m, pgup)
+	}
+	if m.cursor != 0 || m.scrollOffset != 0 {
+		t.Errorf("Expected pgup to stop at the top, got cursor %d offset %d", m.cursor, m.scrollOffset)
+	}
+
+	// A fixed page size overrides the screenful
+	m = loadedModel(entries).WithPageSize(3)
+	m = pressKeys(m, pgdown, pgdown)
+	if m.cursor != 6 {
+		t.Errorf("Expected two pages of 3 to reach 6, got %d", m.cursor)
+	}
+	m = pressKeys(m, pgup)
+	if m.cursor != 3 {
+		t.Errorf("Expected pgup by 3 to reach 3, got %d", m.cursor)
+	}
+}
+
+// TestRenderedPreview tests that rendered previews style the preview lines
+// while search still matches the raw markdown.
+func TestRenderedPreview(t *testing.T) {
+	store := vault.NewMemory()
+	content := "# Ship day\n\nShipped **the release** with `make dist`.\n\n```sh\nmake dist\n"
+	if err := store.WriteEntry("2024-01-01", []byte(content)); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	load := func(m Model) Model {
+		updated, cmd := m.Update(m.Init()())
+		updated, _ = updated.(Model).Update(cmd())
+		m = updated.(Model)
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		return updated.(Model)
+	}
+
+	// Off by default: the raw lines are shown as they are
+	m := load(NewModel("/does/not/exist", 5).WithStore(store).WithStyle("notty"))
+	if m.entries[0].Rendered != nil {
+		t.Errorf("Expected no rendered preview by default, got %q", m.entries[0].Rendered)
+	}
+	if view := m.renderEntry(m.entries[0], false); !strings.Contains(view, "**the release**") {
+		t.Errorf("Expected the raw markdown in the preview, got:\n%s", view)
+	}
+
+	m = load(NewModel("/does/not/exist", 5).WithStore(store).WithStyle("notty").WithRenderedPreview(true))
+	entry := m.entries[0]
+	if len(entry.Rendered) == 0 {
+		t.Fatal("Expected a rendered preview")
+	}
+	view := ansi.Strip(m.renderEntry(entry, false))
+	if !strings.Contains(view, "the release") || strings.Contains(view, "```") {
+		t.Errorf("Expected the preview rendered, with the unclosed fence as code, got:\n%s", view)
+	}
+
+	// Search still matches the raw lines
+	if !entryMatches(entry, "**the release**") {
+		t.Error("Expected search to match the raw markdown")
+	}
+}
+
+// TestRenderedPreviewResize tests that a width change drops previews rendered
+// for the old width and renders them again at the new one, while a resize to
+// the same width keeps them.
+func TestRenderedPreviewResize(t *testing.T) {
+	store := vault.NewMemory()
+	content := "# Ship day\n\nShipped the release after a long week of fixing flaky tests and chasing reviews.\n"
+	if err := store.WriteEntry("2024-01-01", []byte(content)); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	// run feeds every message the command produces back into the model
+	var run func(m Model, cmd tea.Cmd) Model
+	run = func(m Model, cmd tea.Cmd) Model {
+		if cmd == nil {
+			return m
+		}
+		msg := cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			for _, c := range batch {
+				m = run(m, c)
+			}
+			return m
+		}
+		updated, next := m.Update(msg)
+		if _, ok := msg.(tea.KeyMsg); ok {
+			return updated.(Model)
+		}
+		return run(updated.(Model), next)
+	}
+
+	m := NewModel("/does/not/exist", 5).WithStore(store).WithStyle("notty").WithRenderedPreview(true)
+	m = run(m, m.Init())
+	updated, cmd := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = run(updated.(Model), cmd)
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = run(updated.(Model), cmd)
+	if len(m.entries[0].Rendered) == 0 {
+		t.Fatal("Expected a rendered preview")
+	}
+	wide := m.renderers
+
+	// The same width keeps the renderers and the rendered lines
+	updated, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	m = updated.(Model)
+	if m.renderers != wide || len(m.entries[0].Rendered) == 0 {
+		t.Error("Expected a resize to the same width to keep the rendered preview")
+	}
+
+	// A narrower window renders the preview again, wrapped to fit
+	updated, cmd = m.Update(tea.WindowSizeMsg{Width: 40, Height: 30})
+	m = updated.(Model)
+	if m.renderers == wide {
+		t.Error("Expected new renderers for the new width")
+	}
+	if m.entries[0].Rendered != nil {
+		t.Errorf("Expected the old rendered lines dropped, got %q", m.entries[0].Rendered)
+	}
+	m = run(m, cmd)
+	if len(m.entries[0].Rendered) == 0 {
+		t.Fatal("Expected the preview rendered again")
+	}
+	for _, line := range m.entries[0].Rendered {
+		if w := ansi.StringWidth(line); w > 40-previewIndent {
+			t.Errorf("Expected lines wrapped to the new width, got %d: %q", w, line)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package tui
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// LoadPreviewsMsg is sent when titles and previews have been read for a set
+// of entries. Entries that failed to load carry the error as their preview.
+type LoadPreviewsMsg struct {
+	Entries []Entry
+	// renderers rendered the previews, so previews rendered for a width the
+	// window has since left can be told apart (nil when they stay raw)
+	renderers *previewRenderers
+}
+
+// LoadPreviewsCmd returns a command that reads the title and preview of each
+// date in dates. With render set the previews are also rendered as markdown
+// by its renderers; nil leaves them raw.
+func LoadPreviewsCmd(v vault.Store, dates []string, previewLines int, from PreviewFrom, render *previewRenderers) tea.Cmd {
+	return func() tea.Msg {
+		return LoadPreviewsMsg{Entries: loadPreviews(v, dates, previewLines, from, render), renderers: render}
+	}
+}
+
+// previewRenderers hands out markdown renderers built with one set of
+// options, keeping them for later batches of previews. A glamour renderer
+// keeps state while it renders, so each one is used by one goroutine at a
+// time.
+// Learn: sync.Pool reuses expensive objects across goroutines without sharing one.
+// See: https://pkg.go.dev/sync#Pool
+type previewRenderers struct {
+	opts markdown.Options
+	pool sync.Pool
+}
+
+// newPreviewRenderers returns an empty pool of renderers for opts.
+func newPreviewRenderers(opts markdown.Options) *previewRenderers {
+	return &previewRenderers{opts: opts}
+}
+
+// render styles preview as markdown, one string per non-blank rendered
+// line. It returns nil for an empty preview or when rendering fails, so
+// the raw lines are shown instead.
+func (p *previewRenderers) render(preview []string) []string {
+	if len(preview) == 0 {
+		return nil
+	}
+
+	renderer, ok := p.pool.Get().(*markdown.Renderer)
+	if !ok {
+		var err error
+		if renderer, err = markdown.NewRenderer(p.opts); err != nil {
+			return nil
+		}
+	}
+	defer p.pool.Put(renderer)
+
+	lines, err := renderer.RenderPreview([]byte(strings.Join(preview, "\n")))
+	if err != nil {
+		return nil
+	}
+	return lines
+}
+
+// renderedPreviewsMsg carries previews rendered again after the width
+// changed, keyed by date, along with how many raw lines each was made from.
+type renderedPreviewsMsg struct {
+	renderers *previewRenderers
+	rendered  map[string][]string
+	lines     map[string]int
+}
+
+// rerenderCmd returns a command rendering the loaded previews that have no
+// rendered lines yet with the model's current renderers, or nil when there
+// are none. The raw lines are already in memory, so no file is read.
+func (m Model) rerenderCmd() tea.Cmd {
+	render := m.renderers
+	if render == nil {
+		return nil
+	}
+
+	previews := make(map[string][]string)
+	for _, entry := range m.allEntries {
+		if !entry.Pending && entry.Rendered == nil && len(entry.Preview) > 0 {
+			previews[entry.Date] = entry.Preview
+		}
+	}
+	if len(previews) == 0 {
+		return nil
+	}
+
+	return func() tea.Msg {
+		msg := renderedPreviewsMsg{
+			renderers: render,
+			rendered:  make(map[string][]string, len(previews)),
+			lines:     make(map[string]int, len(previews)),
+		}
+		for date, preview := range previews {
+			msg.rendered[date] = render.render(preview)
+			msg.lines[date] = len(preview)
+		}
+		return msg
+	}
+}
+
+// applyRendered stores re-rendered previews on the matching entries, unless
+// the width changed again since or an entry's preview has grown meanwhile.
+func (m *Model) applyRendered(msg renderedPreviewsMsg) {
+	if msg.renderers != m.renderers {
+		return
+	}
+	fill := func(entries []Entry) {
+		for i := range entries {
+			rendered, ok := msg.rendered[entries[i].Date]
+			if ok && msg.lines[entries[i].Date] == len(entries[i].Preview) {
+				entries[i].Rendered = rendered
+			}
+		}
+	}
+	fill(m.allEntries)
+	fill(m.entries)
+}
+
+// resizeRenderers replaces the model's renderers when the options previews
+// are rendered with, including the wrap width, have changed. Rendered lines
+// made for the old width are dropped and the returned command renders them
+// again; it is nil when nothing changed or previews aren't rendered.
+func (m *Model) resizeRenderers() tea.Cmd {
+	if !m.renderedPreview {
+		return nil
+	}
+	opts := m.previewOptions()
+	if m.renderers != nil && m.renderers.opts == opts {
+		return nil
+	}
+
+	m.renderers = newPreviewRenderers(opts)
+	for i := range m.allEntries {
+		m.allEntries[i].Rendered = nil
+	}
+	for i := range m.entries {
+		m.entries[i].Rendered = nil
+	}
+	return m.rerenderCmd()
+}
+
+// loadPreviews reads the given entries, keeping the order of dates. An entry
+// that can't be read is still returned, marked unreadable, so the timeline
+// stops waiting for it instead of showing it as loading forever.
+func loadPreviews(v vault.Store, dates []string, previewLines int, from PreviewFrom, render *previewRenderers) []Entry {
+	results := loadEntriesConcurrently(v, dates, previewLines, from, render)
+
+	// Collect in the original order so callers see a deterministic result
+	entries := make([]Entry, 0, len(results))
+	for i, result := range results {
+		if result.err != nil {
+			entries = append(entries, unreadableEntry(v, dates[i], result.err))
+			continue
+		}
+		entries = append(entries, result.entry)
+	}
+
+	return entries
+}
+
+// unreadableEntry describes an entry that failed to load, with the error as
+// its only preview line. It is Complete so expanding it doesn't retry.
+func unreadableEntry(v vault.Store, date string, err error) Entry {
+	return Entry{
+		Date:     date,
+		Path:     v.DatePath(date),
+		Title:    "(unreadable)",
+		Preview:  []string{err.Error()},
+		Complete: true,
+	}
+}
+
+// entryResult holds the outcome of loading a single entry.
+type entryResult struct {
+	entry Entry
+	err   error
+}
+
+// loadEntriesConcurrently reads entries across a bounded pool of workers.
+// Each result is stored at its date's index, so the output order matches
+// dates no matter which worker finishes first. With render set the workers
+// also render previews, taking renderers from it.
+// Learn: A fixed pool of goroutines reading from a channel bounds concurrency.
+// See: https://gobyexample.com/worker-pools
+func loadEntriesConcurrently(v vault.Store, dates []string, previewLines int, from PreviewFrom, render *previewRenderers) []entryResult {
+	results := make([]entryResult, len(dates))
+
+	workers := min(runtime.NumCPU(), len(dates))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				entry, err := createEntryFromDate(v, dates[i], previewLines, from, render)
+				results[i] = entryResult{entry: entry, err: err}
+			}
+		}()
+	}
+
+	for i := range dates {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// previewCmd returns a command loading the pending entries the user can see,
+// or nil when there are none. While searching, or with a filter applied,
+// every pending entry is loaded so the filter can match its title and preview.
+func (m *Model) previewCmd() tea.Cmd {
+	candidates := m.entries
+	if m.searching || m.filter != "" {
+		candidates = m.allEntries
+	} else if len(m.entries) > 0 {
+		start, end := m.visibleRange()
+		candidates = m.entries[start : end+1]
+	}
+
+	if m.requested == nil {
+		m.requested = make(map[string]bool)
+	}
+
+	var dates []string
+	for _, entry := range candidates {
+		if entry.Pending && !m.requested[entry.Date] {
+			m.requested[entry.Date] = true
+			dates = append(dates, entry.Date)
+		}
+	}
+	if len(dates) == 0 {
+		return nil
+	}
+
+	return LoadPreviewsCmd(m.entryStore(), dates, m.previewLines, m.previewFrom, m.previewRender())
+}
+
+// previewRender returns the renderers for previews, or nil when rendered
+// previews are off. Until the first WindowSizeMsg has set the model's
+// renderers, previews get renderers of their own.
+func (m Model) previewRender() *previewRenderers {
+	if !m.renderedPreview {
+		return nil
+	}
+	if m.renderers != nil && m.renderers.opts == m.previewOptions() {
+		return m.renderers
+	}
+	return newPreviewRenderers(m.previewOptions())
+}
+
+// previewOptions returns the options previews are rendered with, wrapped
+// to fit beside the preview indent.
+func (m Model) previewOptions() markdown.Options {
+	wordWrap := 0
+	if m.width > 0 {
+		wordWrap = max(m.width-previewIndent-2, 20)
+	}
+	return markdown.Options{
+		Style:     m.style,
+		WordWrap:  wordWrap,
+		CodeTheme: m.codeTheme,
+		WikiLinks: m.wikiLinks,
+	}
+}
+
+// applyPreviews stores loaded titles and previews on the matching entries,
+// keeping their expansion level. Entries already loaded take the new preview
+// only when it is longer, as when expanding an entry reads more of it. The
+// filter is re-applied since entries may match it now.
+func (m *Model) applyPreviews(loaded []Entry) {
+	byDate := make(map[string]Entry, len(loaded))
+	for _, entry := range loaded {
+		byDate[entry.Date] = entry
+	}
+
+	fill := func(entries []Entry) {
+		for i := range entries {
+			entry, ok := byDate[entries[i].Date]
+			if ok && (entries[i].Pending || len(entry.Preview) > len(entries[i].Preview)) {
+				entries[i].Title = entry.Title
+				entries[i].Preview = entry.Preview
+				entries[i].Rendered = entry.Rendered
+				entries[i].Complete = entry.Complete
+				entries[i].Pending = false
+			}
+		}
+	}
+	fill(m.allEntries)
+	fill(m.entries)
+
+	if m.filter != "" {
+		selected := ""
+		if m.cursor < len(m.entries) {
+			selected = m.entries[m.cursor].Date
+		}
+		m.applyFilter(m.filter)
+		m.selectDate(selected)
+	}
+}
@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"logmd/markdown"
+	"logmd/vault"
+)
+
+// RenderEntryMsg is sent when an entry has been rendered for the reader pane.
+type RenderEntryMsg struct {
+	Date    string
+	Content string
+	Error   error
+}
+
+// RenderEntryCmd returns a command that reads and renders a full entry.
+// Rendering happens off the update loop so large entries don't block input;
+// opts.WordWrap is the pane width.
+func RenderEntryCmd(v vault.Store, entry Entry, opts markdown.Options) tea.Cmd {
+	return func() tea.Msg {
+		content, err := v.ReadEntry(entry.Date)
+		if err != nil {
+			return RenderEntryMsg{Date: entry.Date, Error: err}
+		}
+
+		renderer, err := markdown.NewRenderer(opts)
+		if err != nil {
+			return RenderEntryMsg{Date: entry.Date, Error: err}
+		}
+
+		rendered, err := renderer.Render(content)
+		return RenderEntryMsg{Date: entry.Date, Content: rendered, Error: err}
+	}
+}
+
+// handleReaderKey processes keyboard input while the reader pane is open.
+// Scrolling follows the model's KeyMap, and esc or the quit keys go back.
+// Learn: Modal UIs route keys to the active mode before the default handler.
+func (m Model) handleReaderKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.String() == "ctrl+c":
+		m.quitting = true
+		return m, tea.Quit
+
+	case msg.String() == "esc", key.Matches(msg, m.keys.Quit):
+		m.reading = false
+		m.readerLines = nil
+		m.readerScroll = 0
+
+	case key.Matches(msg, m.keys.Up):
+		m.scrollReader(-1)
+
+	case key.Matches(msg, m.keys.Down):
+		m.scrollReader(1)
+
+	case key.Matches(msg, m.keys.PageUp):
+		m.scrollReader(-m.readerHeight())
+
+	case key.Matches(msg, m.keys.PageDown):
+		m.scrollReader(m.readerHeight())
+
+	case key.Matches(msg, m.keys.Home):
+		m.readerScroll = 0
+
+	case key.Matches(msg, m.keys.End):
+		m.scrollReader(len(m.readerLines))
+	}
+
+	return m, nil
+}
+
+// scrollReader moves the reader pane by delta lines, clamped to the content.
+func (m *Model) scrollReader(delta int) {
+	m.readerScroll += delta
+
+	maxScroll := len(m.readerLines) - m.readerHeight()
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	if m.readerScroll > maxScroll {
+		m.readerScroll = maxScroll
+	}
+	if m.readerScroll < 0 {
+		m.readerScroll = 0
+	}
+}
+
+// readerHeight is the number of content lines visible in the reader pane.
+func (m Model) readerHeight() int {
+	height := m.viewportHeight
+	if height < 1 {
+		height = 1
+	}
+	return height
+}
+
+// readerView renders the visible slice of the reader pane.
+func (m Model) readerView() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render("📖 " + m.readerDate))
+	b.WriteString("\n")
+
+	end := m.readerScroll + m.readerHeight()
+	if end > len(m.readerLines) {
+		end = len(m.readerLines)
+	}
+	b.WriteString(strings.Join(m.readerLines[m.readerScroll:end], "\n"))
+	b.WriteString("\n")
+
+	b.WriteString(helpStyle.Render(shortHelp(m.keys.readerShortcuts())))
+	return b.String()
+}
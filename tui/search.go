@@ -0,0 +1,87 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleSearchKey processes keyboard input while the search input has focus.
+// Typing filters entries live; enter keeps the filter and esc clears it.
+// Learn: Bubbles components are updated by forwarding messages to their Update.
+// See: https://github.com/charmbracelet/bubbles#text-input
+func (m Model) handleSearchKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		m.quitting = true
+		return m, tea.Quit
+
+	case "esc":
+		m.searching = false
+		m.searchInput.Blur()
+		m.searchInput.SetValue("")
+		m.applyFilter("")
+		return m, m.previewCmd()
+
+	case "enter":
+		m.searching = false
+		m.searchInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+	if m.searchInput.Value() != m.filter {
+		m.applyFilter(m.searchInput.Value())
+	}
+	return m, cmd
+}
+
+// startSearch focuses the search input, keeping any filter already applied.
+func (m Model) startSearch() (tea.Model, tea.Cmd) {
+	m.searching = true
+	m.searchInput.SetValue(m.filter)
+	m.searchInput.CursorEnd()
+
+	// Pending entries are loaded so the search covers every entry
+	return m, tea.Batch(m.searchInput.Focus(), m.previewCmd())
+}
+
+// applyFilter rebuilds the visible entries from allEntries for a query and
+// moves the cursor to the first match.
+func (m *Model) applyFilter(query string) {
+	m.filter = query
+	m.entries = filterEntries(m.allEntries, query)
+	m.cursor = 0
+	m.scrollOffset = 0
+}
+
+// filterEntries returns the entries whose title or preview contains query,
+// case-insensitively. An empty query returns all entries.
+func filterEntries(entries []Entry, query string) []Entry {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return append([]Entry(nil), entries...)
+	}
+
+	matches := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		if entryMatches(entry, query) {
+			matches = append(matches, entry)
+		}
+	}
+	return matches
+}
+
+// entryMatches reports whether a lowercased query appears in an entry.
+func entryMatches(entry Entry, query string) bool {
+	if strings.Contains(strings.ToLower(entry.Title), query) {
+		return true
+	}
+	for _, line := range entry.Preview {
+		if strings.Contains(strings.ToLower(line), query) {
+			return true
+		}
+	}
+	return false
+}
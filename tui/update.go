@@ -1,9 +1,46 @@
 package tui
 
 import (
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"logmd/clipboard"
+	"logmd/config"
+	"logmd/markdown"
+	"logmd/vault"
 )
 
+// copiedMsg is sent when an entry has been copied to the clipboard.
+type copiedMsg struct {
+	date string
+	err  error
+}
+
+// copyToClipboard writes text to the system clipboard. Tests replace it so
+// they don't touch the real clipboard.
+var copyToClipboard = clipboard.Copy
+
+// copyEntryCmd returns a command copying the raw markdown of the entry for
+// date to the clipboard.
+func copyEntryCmd(v vault.Store, date string) tea.Cmd {
+	return func() tea.Msg {
+		content, err := v.ReadEntry(date)
+		if err == nil {
+			err = copyToClipboard(string(content))
+		}
+		return copiedMsg{date: date, err: err}
+	}
+}
+
+// editorFinishedMsg is sent when the external editor exits.
+type editorFinishedMsg struct {
+	err error
+}
+
 // Update handles all state changes in response to messages.
 // Learn: Update functions in Bubble Tea handle state transitions and side effects.
 // See: https://github.com/charmbracelet/bubbletea#update
@@ -14,7 +51,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.handleKeyPress(msg)
 
 	case tea.WindowSizeMsg:
-		m.viewportHeight = msg.Height - 6 // Account for title, help, and padding
+		m.viewportHeight = max(msg.Height-6, 1) // Account for title, help, and padding
+		m.width = msg.Width
+		m.height = msg.Height
+		rerender := m.resizeRenderers()
+		return m, tea.Batch(m.previewCmd(), rerender)
+
+	case renderedPreviewsMsg:
+		m.applyRendered(msg)
 		return m, nil
 
 	case LoadEntriesMsg:
@@ -23,80 +67,365 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.err = msg.Error
 			return m, nil
 		}
-		m.entries = msg.Entries
+		// Keep the cursor on the same entry across reloads
+		selected := ""
+		if m.cursor < len(m.entries) {
+			selected = m.entries[m.cursor].Date
+		}
+		m.allEntries = msg.Entries
+		if m.oldestFirst {
+			slices.Reverse(m.allEntries)
+		}
+		m.allEntries = m.pinToday(m.allEntries)
+		m.applyFilter(m.filter)
+		m.selectDate(selected)
+		m.requested = nil
+		return m, m.previewCmd()
+
+	case LoadPreviewsMsg:
+		// Previews rendered for an earlier width are shown raw until rendered again
+		stale := msg.renderers != nil && msg.renderers.opts != m.previewOptions()
+		if stale {
+			for i := range msg.Entries {
+				msg.Entries[i].Rendered = nil
+			}
+		}
+		m.applyPreviews(msg.Entries)
+		if stale {
+			return m, m.rerenderCmd()
+		}
+		return m, nil
+
+	case RenderEntryMsg:
+		if msg.Error != nil {
+			m.err = fmt.Errorf("failed to render entry %s: %w", msg.Date, msg.Error)
+			return m, nil
+		}
+		m.reading = true
+		m.readerDate = msg.Date
+		m.readerLines = strings.Split(strings.TrimRight(msg.Content, "\n"), "\n")
+		m.readerScroll = 0
+		return m, nil
+
+	case copiedMsg:
+		if msg.err != nil {
+			m.status = fmt.Sprintf("failed to copy %s: %v", msg.date, msg.err)
+			return m, nil
+		}
+		m.status = fmt.Sprintf("📋 Copied %s to the clipboard", msg.date)
 		return m, nil
 
+	case editorFinishedMsg:
+		if msg.err != nil {
+			m.err = fmt.Errorf("failed to run editor '%s': %w", m.editor, msg.err)
+			return m, nil
+		}
+		// Reload in case the entry changed
+		return m, m.loadEntriesCmd()
+
 	default:
 		return m, nil
 	}
 }
 
 // handleKeyPress processes keyboard input and returns updated model and commands.
-// Learn: Switch statements on type assertions are a common Go pattern.
-// See: https://go.dev/tour/methods/16
+// Keys are matched against the model's KeyMap so the help overlay always
+// describes the bindings that are actually in effect.
+// Learn: key.Matches reports whether a key message triggers a binding.
+// See: https://pkg.go.dev/github.com/charmbracelet/bubbles/key#Matches
 func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	if len(m.entries) == 0 {
-		// Only allow quit when no entries
-		switch msg.String() {
-		case "q", "ctrl+c":
+	// Status messages last until the next key press
+	m.status = ""
+
+	// Nothing else can be seen to act on until the window is big enough
+	if m.tooSmall() {
+		if key.Matches(msg, m.keys.Quit) || msg.String() == "ctrl+c" {
 			m.quitting = true
 			return m, tea.Quit
 		}
 		return m, nil
 	}
 
-	switch msg.String() {
-	case "q", "ctrl+c":
+	// Overlays, the reader pane and text prompts capture all keys while open
+	if m.showingHelp {
+		return m.handleHelpKey(msg)
+	}
+	if m.reading {
+		return m.handleReaderKey(msg)
+	}
+	if m.searching {
+		return m.handleSearchKey(msg)
+	}
+	if m.jumping {
+		return m.handleJumpKey(msg)
+	}
+
+	if len(m.entries) == 0 {
+		// Only allow quit, help, search and clearing a filter when no entries are shown
+		switch {
+		case key.Matches(msg, m.keys.Quit):
+			m.quitting = true
+			return m, tea.Quit
+		case key.Matches(msg, m.keys.Help):
+			m.showingHelp = true
+		case key.Matches(msg, m.keys.Search):
+			if len(m.allEntries) > 0 {
+				return m.startSearch()
+			}
+		case msg.String() == "esc":
+			m.applyFilter("")
+		}
+		return m, m.previewCmd()
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Quit):
 		m.quitting = true
 		return m, tea.Quit
 
-	case "up", "k":
+	case key.Matches(msg, m.keys.Up):
 		if m.cursor > 0 {
 			m.cursor--
 			m.adjustScroll()
 		}
 
-	case "down", "j":
+	case key.Matches(msg, m.keys.Down):
 		if m.cursor < len(m.entries)-1 {
 			m.cursor++
 			m.adjustScroll()
 		}
 
-	case "enter", " ":
-		if m.cursor < len(m.entries) {
-			m.entries[m.cursor].Expanded = !m.entries[m.cursor].Expanded
+	case key.Matches(msg, m.keys.Toggle):
+		if m.entries[m.cursor].Unwritten {
+			return m, m.createTodayCmd()
 		}
+		return m, m.toggleExpanded()
+
+	case key.Matches(msg, m.keys.Search):
+		return m.startSearch()
+
+	case key.Matches(msg, m.keys.Edit):
+		return m, m.editEntryCmd(m.entries[m.cursor])
 
-	case "pgup":
-		m.cursor -= 10
-		if m.cursor < 0 {
-			m.cursor = 0
+	case key.Matches(msg, m.keys.Read):
+		if m.entries[m.cursor].Unwritten {
+			m.status = "today's entry hasn't been written yet (enter creates it)"
+			break
 		}
-		m.adjustScroll()
+		return m, RenderEntryCmd(m.entryStore(), m.entries[m.cursor], markdown.Options{
+			Style:     m.style,
+			WordWrap:  m.width,
+			CodeTheme: m.codeTheme,
+			WikiLinks: m.wikiLinks,
+		})
 
-	case "pgdown":
-		m.cursor += 10
-		if m.cursor >= len(m.entries) {
-			m.cursor = len(m.entries) - 1
+	case key.Matches(msg, m.keys.Copy):
+		if m.entries[m.cursor].Unwritten {
+			m.status = "today's entry hasn't been written yet (enter creates it)"
+			break
 		}
-		m.adjustScroll()
+		return m, copyEntryCmd(m.entryStore(), m.entries[m.cursor].Date)
+
+	case key.Matches(msg, m.keys.Jump):
+		return m.startJump()
 
-	case "home":
+	case key.Matches(msg, m.keys.Order):
+		m.toggleSortOrder()
+
+	case key.Matches(msg, m.keys.Help):
+		m.showingHelp = true
+
+	case msg.String() == "esc":
+		if m.filter != "" {
+			m.searchInput.SetValue("")
+			m.applyFilter("")
+		}
+
+	case key.Matches(msg, m.keys.PageUp):
+		m.page(-1)
+
+	case key.Matches(msg, m.keys.PageDown):
+		m.page(1)
+
+	case key.Matches(msg, m.keys.Home):
 		m.cursor = 0
 		m.adjustScroll()
 
-	case "end":
+	case key.Matches(msg, m.keys.End):
 		m.cursor = len(m.entries) - 1
 		m.adjustScroll()
 	}
 
-	return m, nil
+	// Load titles and previews that have scrolled into view
+	return m, m.previewCmd()
+}
+
+// editEntryCmd suspends the TUI and opens the entry in the configured editor.
+// Encrypted entries are edited through a decrypted copy that is checked back
+// in when the editor exits.
+// Learn: tea.ExecProcess hands the terminal to a child process and resumes after.
+// See: https://pkg.go.dev/github.com/charmbracelet/bubbletea#ExecProcess
+func (m Model) editEntryCmd(entry Entry) tea.Cmd {
+	if m.editor == "" {
+		return func() tea.Msg {
+			return editorFinishedMsg{err: fmt.Errorf("no editor configured")}
+		}
+	}
+
+	v, ok := m.entryStore().(*vault.Vault)
+	if !ok {
+		return func() tea.Msg {
+			return editorFinishedMsg{err: fmt.Errorf("editing needs entries stored on disk")}
+		}
+	}
+	if entry.Unwritten && !v.EntryExists(entry.Date) {
+		if err := v.CreateEntry(entry.Date); err != nil {
+			return func() tea.Msg {
+				return editorFinishedMsg{err: err}
+			}
+		}
+	}
+	path, err := v.CheckoutEntry(entry.Date)
+	if err != nil {
+		return func() tea.Msg {
+			return editorFinishedMsg{err: err}
+		}
+	}
+
+	argv, err := config.EditorCommand(m.editor, m.editorArgs, path)
+	if err != nil {
+		v.CheckinEntry(entry.Date, path)
+		return func() tea.Msg {
+			return editorFinishedMsg{err: err}
+		}
+	}
+	c := exec.Command(argv[0], argv[1:]...)
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		if checkinErr := v.CheckinEntry(entry.Date, path); err == nil {
+			err = checkinErr
+		}
+		return editorFinishedMsg{err: err}
+	})
+}
+
+// createTodayCmd creates the entry for the pinned today row from the
+// template and reloads the timeline so the new entry replaces the placeholder.
+func (m Model) createTodayCmd() tea.Cmd {
+	v := m.entryStore()
+	date := m.today
+	reload := m.loadEntriesCmd()
+	return func() tea.Msg {
+		if err := v.CreateEntry(date); err != nil {
+			return LoadEntriesMsg{Error: fmt.Errorf("failed to create entry %s: %w", date, err)}
+		}
+		return reload()
+	}
+}
+
+// pinToday moves the entry for m.today to the front of entries, inserting an
+// unwritten placeholder when there is no such entry. Entries are returned
+// unchanged when pinning is disabled.
+func (m Model) pinToday(entries []Entry) []Entry {
+	if m.today == "" {
+		return entries
+	}
+
+	i := slices.IndexFunc(entries, func(e Entry) bool { return e.Date == m.today })
+	if i < 0 {
+		placeholder := Entry{Date: m.today, Path: m.entryStore().DatePath(m.today), Unwritten: true}
+		entries = slices.Insert(entries, 0, placeholder)
+		i = 0
+	}
+	entries[i].Today = true
+	pinFirst(entries)
+	return entries
+}
+
+// pinFirst moves the pinned today row, if any, to the front of entries in
+// place, keeping the order of the rest.
+func pinFirst(entries []Entry) {
+	i := slices.IndexFunc(entries, func(e Entry) bool { return e.Today })
+	if i <= 0 {
+		return
+	}
+	pinned := entries[i]
+	copy(entries[1:i+1], entries[:i])
+	entries[0] = pinned
+}
+
+// selectDate moves the cursor to the entry with the given date, if shown.
+func (m *Model) selectDate(date string) {
+	for i, entry := range m.entries {
+		if entry.Date == date {
+			m.cursor = i
+			m.adjustScroll()
+			return
+		}
+	}
+}
+
+// toggleSortOrder reverses the entry order, keeping the cursor on the same
+// entry and the pinned today row on top. Reversing in place keeps
+// visibleRange and adjustScroll valid since the number of entries doesn't
+// change.
+// See: https://pkg.go.dev/slices#Reverse
+func (m *Model) toggleSortOrder() {
+	selected := m.entries[m.cursor].Date
+
+	m.oldestFirst = !m.oldestFirst
+	slices.Reverse(m.allEntries)
+	slices.Reverse(m.entries)
+	pinFirst(m.allEntries)
+	pinFirst(m.entries)
+	m.selectDate(selected)
+}
+
+// toggleExpanded moves the entry under the cursor to the next expansion
+// level, collapsing it after the full entry, and keeps the unfiltered copy
+// in allEntries in sync. It returns a command reading more of the entry
+// when the new level shows more lines than have been read, or nil.
+func (m *Model) toggleExpanded() tea.Cmd {
+	entry := &m.entries[m.cursor]
+	entry.Expansion = (entry.Expansion + 1) % (ExpansionFull + 1)
+
+	for i := range m.allEntries {
+		if m.allEntries[i].Date == entry.Date {
+			m.allEntries[i].Expansion = entry.Expansion
+			break
+		}
+	}
+
+	// Pending entries get their first preview lines from previewCmd
+	limit := previewLimit(entry.Expansion, m.previewLines)
+	if entry.Pending || entry.Complete || (limit != allPreviewLines && len(entry.Preview) >= limit) {
+		return nil
+	}
+	return LoadPreviewsCmd(m.entryStore(), []string{entry.Date}, limit, m.previewFrom, m.previewRender())
+}
+
+// pageStep is how many entries a page moves: the configured page size, or
+// else a screenful.
+func (m Model) pageStep() int {
+	if m.pageSize > 0 {
+		return m.pageSize
+	}
+	return m.visibleRows()
+}
+
+// page moves the cursor a page down (direction 1) or up (-1). The view
+// scrolls by the same amount, so the cursor keeps its row on screen and
+// paging back returns to where it started, except where a list end stops it.
+func (m *Model) page(direction int) {
+	step := direction * m.pageStep()
+	m.cursor = min(max(m.cursor+step, 0), len(m.entries)-1)
+	m.scrollOffset += step
+	m.adjustScroll()
 }
 
 // adjustScroll ensures the cursor is visible within the viewport.
 // Learn: Scrolling logic requires careful bounds checking and offset management.
 func (m *Model) adjustScroll() {
-	visibleHeight := m.viewportHeight - 4 // Account for title and help
+	visibleHeight := m.visibleRows()
 
 	// Scroll up if cursor is above viewport
 	if m.cursor < m.scrollOffset {
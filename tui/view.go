@@ -7,6 +7,10 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
+// previewIndent is the left indent applied to every preview row, including
+// the continuation rows of a wrapped line.
+const previewIndent = 4
+
 // Styles for the timeline interface
 // Learn: lipgloss provides a CSS-like API for terminal styling in Go.
 // See: https://github.com/charmbracelet/lipgloss#usage
@@ -33,8 +37,14 @@ var (
 	previewStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#374151")).
 			Padding(0, 2).
+			PaddingLeft(previewIndent).
 			Italic(true)
 
+	// renderedPreviewStyle only indents, since rendered lines carry their own styling
+	renderedPreviewStyle = lipgloss.NewStyle().
+				Padding(0, 2).
+				PaddingLeft(previewIndent)
+
 	errorStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#EF4444")).
 			Bold(true)
@@ -42,6 +52,26 @@ var (
 	helpStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#6B7280")).
 			Padding(1, 0)
+
+	footerStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#9CA3AF"))
+
+	pendingStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#9CA3AF")).
+			Faint(true)
+
+	todayStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#F59E0B")).
+			Bold(true)
+)
+
+// MinWidth and MinHeight are the smallest terminal the timeline is drawn
+// in. Below them the layout wraps into garbage and leaves no room for
+// entries (the title, prompts and help take eight rows), so View shows a
+// "terminal too small" message until the window is resized.
+const (
+	MinWidth  = 40
+	MinHeight = 12
 )
 
 // View renders the timeline interface.
@@ -52,21 +82,52 @@ func (m Model) View() string {
 		return errorStyle.Render(fmt.Sprintf("Error: %v", m.err))
 	}
 
+	if m.tooSmall() {
+		return m.tooSmallView()
+	}
+
 	if m.loading {
 		return "Loading journal entries..."
 	}
 
-	if len(m.entries) == 0 {
+	if m.showingHelp {
+		return m.helpView()
+	}
+
+	if m.reading {
+		return m.readerView()
+	}
+
+	if len(m.allEntries) == 0 {
 		return "No journal entries found. Use 'logmd today' to create your first entry."
 	}
 
 	var b strings.Builder
 
-	// Title
-	b.WriteString(titleStyle.Render("📖 Journal Timeline"))
+	// Title with the current sort direction
+	order := "newest first"
+	if m.oldestFirst {
+		order = "oldest first"
+	}
+	b.WriteString(titleStyle.Render("📖 Journal Timeline · " + order))
 	b.WriteString("\n\n")
 
+	// Search bar and jump prompt
+	if m.jumping {
+		b.WriteString(m.jumpInput.View())
+		b.WriteString("\n\n")
+	} else if m.searching {
+		b.WriteString(m.searchInput.View())
+		b.WriteString("\n\n")
+	} else if m.filter != "" {
+		b.WriteString(helpStyle.UnsetPadding().Render(fmt.Sprintf("filter: %q (%d matches)", m.filter, len(m.entries))))
+		b.WriteString("\n\n")
+	}
+
 	// Entries
+	if len(m.entries) == 0 {
+		b.WriteString("No entries match your search.\n")
+	}
 	start, end := m.visibleRange()
 	for i := start; i <= end && i < len(m.entries); i++ {
 		entry := m.entries[i]
@@ -76,11 +137,42 @@ func (m Model) View() string {
 
 	// Help text
 	b.WriteString("\n")
-	b.WriteString(helpStyle.Render("↑/k up • ↓/j down • enter/space toggle • q quit"))
+	switch {
+	case m.status != "":
+		b.WriteString(errorStyle.Padding(1, 0).Render(m.status))
+	case m.jumping:
+		b.WriteString(helpStyle.Render("enter go • esc cancel"))
+	case m.searching:
+		b.WriteString(helpStyle.Render("type to filter • enter keep filter • esc clear"))
+	default:
+		b.WriteString(helpStyle.Render(shortHelp(m.keys.timelineShortcuts())))
+	}
+
+	// Footer with the cursor position
+	b.WriteString("\n")
+	b.WriteString(footerStyle.Render(m.footer()))
 
 	return b.String()
 }
 
+// footer describes the cursor position, e.g. "entry 3 of 12 • 2024-01-15".
+// While a filter is active the match count is shown against the full total.
+func (m Model) footer() string {
+	var parts []string
+
+	if len(m.entries) > 0 {
+		parts = append(parts, fmt.Sprintf("entry %d of %d", m.cursor+1, len(m.entries)))
+	}
+	if m.filter != "" {
+		parts = append(parts, fmt.Sprintf("%d of %d matched", len(m.entries), len(m.allEntries)))
+	}
+	if m.cursor < len(m.entries) {
+		parts = append(parts, m.entries[m.cursor].Date)
+	}
+
+	return strings.Join(parts, " • ")
+}
+
 // renderEntry renders a single timeline entry.
 // Learn: Helper methods should handle specific rendering concerns for clarity.
 func (m Model) renderEntry(entry Entry, selected bool) string {
@@ -90,6 +182,17 @@ func (m Model) renderEntry(entry Entry, selected bool) string {
 	icon := iconStyle.Render("📅")
 	date := dateStyle.Render(entry.Date)
 	title := entry.Title
+	if entry.Today {
+		// The pinned row stands out from the dated entries below it
+		icon = todayStyle.Render("📌")
+		date = todayStyle.Render(entry.Date + " · today")
+	}
+	switch {
+	case entry.Unwritten:
+		title = pendingStyle.Render("(not written)")
+	case entry.Pending:
+		title = pendingStyle.Render("loading…")
+	}
 
 	line := fmt.Sprintf("%s %s %s", icon, date, title)
 
@@ -101,12 +204,24 @@ func (m Model) renderEntry(entry Entry, selected bool) string {
 
 	b.WriteString(line)
 
-	// Preview if expanded
-	if entry.Expanded && len(entry.Preview) > 0 {
+	// Preview if expanded, once it has been read, as far as the level
+	// reaches; rendered lines don't match the raw ones, but count the same way
+	preview, style := entry.Preview, previewStyle
+	if len(entry.Rendered) > 0 {
+		preview, style = entry.Rendered, renderedPreviewStyle
+	}
+	if limit := previewLimit(entry.Expansion, m.previewLines); limit != allPreviewLines && len(preview) > limit {
+		preview = preview[:limit]
+	}
+	if entry.Expansion > ExpansionCollapsed && entry.Pending {
+		b.WriteString("\n")
+		b.WriteString(m.renderPreviewLine("loading preview…", previewStyle))
 		b.WriteString("\n")
-		for _, previewLine := range entry.Preview {
+	} else if len(preview) > 0 {
+		b.WriteString("\n")
+		for _, previewLine := range preview {
 			if strings.TrimSpace(previewLine) != "" {
-				b.WriteString(previewStyle.Render("  " + previewLine))
+				b.WriteString(m.renderPreviewLine(previewLine, style))
 				b.WriteString("\n")
 			}
 		}
@@ -115,15 +230,54 @@ func (m Model) renderEntry(entry Entry, selected bool) string {
 	return b.String()
 }
 
-// visibleRange calculates which entries should be visible given the current scroll.
-// Learn: Viewport calculations are important for performance with large lists.
-func (m Model) visibleRange() (start, end int) {
-	start = m.scrollOffset
-	end = start + m.viewportHeight - 4 // Account for title and help text
+// tooSmall reports whether the last known terminal size is below MinWidth
+// by MinHeight. The size is unknown, and so not too small, until the first
+// WindowSizeMsg arrives.
+func (m Model) tooSmall() bool {
+	return m.height > 0 && (m.width < MinWidth || m.height < MinHeight)
+}
+
+// tooSmallView asks for a bigger window in place of the timeline, keeping
+// the selected entry in sight. Bubble Tea sends a WindowSizeMsg on every
+// resize, so it updates as the user drags.
+func (m Model) tooSmallView() string {
+	var b strings.Builder
+	b.WriteString(errorStyle.Render("Terminal too small"))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("%d×%d, need %d×%d\n", m.width, m.height, MinWidth, MinHeight))
+	if m.cursor < len(m.entries) {
+		entry := m.entries[m.cursor]
+		b.WriteString(dateStyle.Render(entry.Date+" "+entry.Title) + "\n")
+	}
+	b.WriteString(footerStyle.Render(fmt.Sprintf("Resize or press %s to quit", m.keys.Quit.Help().Key)))
+	return b.String()
+}
 
-	if end >= len(m.entries) {
-		end = len(m.entries) - 1
+// renderPreviewLine renders a single preview line in style, wrapping it to
+// the terminal width so continuation rows keep the same hanging indent as
+// the first row.
+// Learn: lipgloss wraps text inside Width and applies padding to every row.
+// See: https://github.com/charmbracelet/lipgloss#width-and-height
+func (m Model) renderPreviewLine(line string, style lipgloss.Style) string {
+	if m.width > 0 {
+		style = style.Width(m.width)
 	}
+	return style.Render(line)
+}
+
+// visibleRows is how many entries fit below the title and above the help
+// text, never less than one however small the window.
+func (m Model) visibleRows() int {
+	return max(m.viewportHeight-4, 1)
+}
 
+// visibleRange calculates which entries should be visible given the current
+// scroll. Both ends are inclusive indexes into entries with start <= end;
+// with no entries both are 0.
+// Learn: Viewport calculations are important for performance with large lists.
+func (m Model) visibleRange() (start, end int) {
+	last := max(len(m.entries)-1, 0)
+	start = min(max(m.scrollOffset, 0), last)
+	end = min(start+m.visibleRows(), last)
 	return start, end
 }
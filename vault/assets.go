@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// AssetsDirName is the directory at the root of the journal where images
+// and other files embedded in entries are kept by convention. Entries link
+// to them relatively, e.g. ![run](assets/run.jpg) in the flat layout or
+// ![run](../../assets/run.jpg) in the nested one.
+const AssetsDirName = "assets"
+
+// AssetsDir returns the absolute path of the vault's assets directory.
+func (v *Vault) AssetsDir() string {
+	return filepath.Join(v.Directory, AssetsDirName)
+}
+
+// Assets returns the absolute paths of every file under AssetsDir, sorted,
+// including files in subdirectories. A missing assets directory has no
+// assets rather than being an error.
+// Learn: filepath.WalkDir visits files in lexical order without statting each one.
+// See: https://pkg.go.dev/path/filepath#WalkDir
+func (v *Vault) Assets() ([]string, error) {
+	root := v.AssetsDir()
+	if _, err := os.Stat(root); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	var assets []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			assets = append(assets, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read assets directory %s: %w", root, err)
+	}
+
+	sort.Strings(assets)
+	return assets, nil
+}
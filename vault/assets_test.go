@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestAssets tests listing files under the assets directory.
+func TestAssets(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	// No assets directory yet
+	assets, err := vault.Assets()
+	if err != nil || len(assets) != 0 {
+		t.Errorf("Expected no assets without a directory, got %v, %v", assets, err)
+	}
+
+	for _, name := range []string{"run.jpg", filepath.Join("2024", "cat.png")} {
+		path := filepath.Join(vault.AssetsDir(), name)
+		os.MkdirAll(filepath.Dir(path), 0700)
+		if err := os.WriteFile(path, []byte("img"), 0644); err != nil {
+			t.Fatalf("Failed to write asset: %v", err)
+		}
+	}
+
+	assets, err = vault.Assets()
+	if err != nil {
+		t.Fatalf("Assets() failed: %v", err)
+	}
+	expected := []string{
+		filepath.Join(tmpDir, "assets", "2024", "cat.png"),
+		filepath.Join(tmpDir, "assets", "run.jpg"),
+	}
+	if !reflect.DeepEqual(assets, expected) {
+		t.Errorf("Assets() = %v, expected %v", assets, expected)
+	}
+
+	// Entry links resolve to the same paths
+	if path, ok := vault.ResolveLocalLink("2024-01-15", "assets/run.jpg"); !ok || path != expected[1] {
+		t.Errorf("ResolveLocalLink() = %s, %t, expected %s", path, ok, expected[1])
+	}
+}
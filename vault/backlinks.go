@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"strings"
+)
+
+// Backlinks returns the dates of entries that reference date with a
+// [[YYYY-MM-DD]] wiki link, newest first. An entry linking to itself
+// doesn't count.
+func (v *Vault) Backlinks(date string) ([]string, error) {
+	matches, err := v.BacklinkLines(date)
+	if err != nil {
+		return nil, err
+	}
+
+	var dates []string
+	for _, match := range matches {
+		if len(dates) == 0 || dates[len(dates)-1] != match.Date {
+			dates = append(dates, match.Date)
+		}
+	}
+	return dates, nil
+}
+
+// BacklinkLines returns every line referencing date with a [[YYYY-MM-DD]]
+// wiki link, newest entry first and in line order within an entry, reading
+// each entry once. References inside fenced or inline code are skipped, as
+// they are when rendering.
+func (v *Vault) BacklinkLines(date string) ([]Match, error) {
+	reference := "[[" + date + "]]"
+
+	var matches []Match
+	err := v.WalkEntries(func(entry EntryInfo) error {
+		if entry.Date == date {
+			return nil
+		}
+		content, err := v.ReadEntry(entry.Date)
+		if err != nil {
+			return err
+		}
+		// Most entries don't mention the date at all
+		if !strings.Contains(string(content), reference) {
+			return nil
+		}
+
+		inFence := false
+		for i, line := range strings.Split(string(content), "\n") {
+			line = strings.TrimSuffix(line, "\r")
+			trimmed := strings.TrimSpace(line)
+			if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+				inFence = !inFence
+				continue
+			}
+			if !inFence && strings.Contains(inlineCodePattern.ReplaceAllString(line, ""), reference) {
+				matches = append(matches, Match{Date: entry.Date, Line: i + 1, Text: line})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
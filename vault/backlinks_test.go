@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+// TestBacklinks tests finding the entries and lines that reference a date.
+func TestBacklinks(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	entries := map[string]string{
+		"2024-01-14": "# Sunday\n\nPlanning for [[2024-01-15]].\r\n",
+		"2024-01-15": "# Monday\n\nSee [[2024-01-15]] itself.\n",
+		"2024-01-16": "# Tuesday\n\nAfter [[2024-01-15]] and\n`[[2024-01-15]]` in code\n\n```\n[[2024-01-15]]\n```\nBack to [[2024-01-15]]\n",
+		"2024-01-17": "# Wednesday\n\nOnly [[2024-01-14]] and 2024-01-15.\n",
+	}
+	for date, content := range entries {
+		if err := vault.WriteEntry(date, []byte(content)); err != nil {
+			t.Fatalf("Failed to write entry: %v", err)
+		}
+	}
+
+	matches, err := vault.BacklinkLines("2024-01-15")
+	if err != nil {
+		t.Fatalf("BacklinkLines() failed: %v", err)
+	}
+	expected := []Match{
+		{Date: "2024-01-16", Line: 3, Text: "After [[2024-01-15]] and"},
+		{Date: "2024-01-16", Line: 9, Text: "Back to [[2024-01-15]]"},
+		{Date: "2024-01-14", Line: 3, Text: "Planning for [[2024-01-15]]."},
+	}
+	if !reflect.DeepEqual(matches, expected) {
+		t.Errorf("BacklinkLines() =\n%+v\nexpected\n%+v", matches, expected)
+	}
+
+	dates, err := vault.Backlinks("2024-01-15")
+	if err != nil {
+		t.Fatalf("Backlinks() failed: %v", err)
+	}
+	if !reflect.DeepEqual(dates, []string{"2024-01-16", "2024-01-14"}) {
+		t.Errorf("Expected backlinks [2024-01-16 2024-01-14], got %v", dates)
+	}
+
+	dates, err = vault.Backlinks("2024-01-17")
+	if err != nil {
+		t.Fatalf("Backlinks() failed: %v", err)
+	}
+	if len(dates) != 0 {
+		t.Errorf("Expected no backlinks, got %v", dates)
+	}
+}
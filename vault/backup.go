@@ -0,0 +1,108 @@
+package vault
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Backup writes a gzip-compressed tar archive of the vault's markdown files
+// to w and returns how many were added. Paths in the archive are relative to
+// the vault directory, so nested layouts keep their year/month folders.
+// Files are copied as they are on disk: encrypted entries stay encrypted, and
+// the salt file is included so they can still be opened after a restore.
+// With from or to set (YYYY-MM-DD, inclusive) only entries in that range are
+// archived. Hidden directories such as .git are skipped.
+// Learn: tar.Writer and gzip.Writer are both io.Writers and stack like pipes.
+// See: https://pkg.go.dev/archive/tar#example-package-Minimal
+func (v *Vault) Backup(w io.Writer, from, to string) (int, error) {
+	dates, err := parseDateRange(from, to)
+	if err != nil {
+		return 0, err
+	}
+	ranged := from != "" || to != ""
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	added := 0
+	err = filepath.WalkDir(v.Directory, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != v.Directory && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// Step 1: Pick the files to archive
+		rel, err := filepath.Rel(v.Directory, path)
+		if err != nil {
+			return err
+		}
+		if rel != saltFileName {
+			date, isEntry := parseEntryDate(d.Name(), v.dateFormat())
+			if !strings.HasSuffix(d.Name(), ".md") || (ranged && (!isEntry || !dates.contains(date))) {
+				return nil
+			}
+		}
+
+		// Step 2: Copy the file into the archive
+		if err := addToTar(tw, path, filepath.ToSlash(rel)); err != nil {
+			return err
+		}
+		if rel != saltFileName {
+			added++
+		}
+		return nil
+	})
+	if err != nil {
+		return added, fmt.Errorf("failed to back up %s: %w", v.Directory, err)
+	}
+
+	// Closing flushes the tar footer and gzip trailer; both are needed to
+	// produce a readable archive
+	if err := tw.Close(); err != nil {
+		return added, fmt.Errorf("failed to finish backup archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return added, fmt.Errorf("failed to finish backup archive: %w", err)
+	}
+	return added, nil
+}
+
+// addToTar writes the regular file at path into tw under name.
+func addToTar(tw *tar.Writer, path, name string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Name = name
+
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, file)
+	return err
+}
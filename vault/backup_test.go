@@ -0,0 +1,137 @@
+package vault
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// readBackup returns the contents of a backup archive keyed by file name.
+func readBackup(t *testing.T, archive []byte) map[string]string {
+	t.Helper()
+
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatalf("Failed to open gzip stream: %v", err)
+	}
+	tr := tar.NewReader(gz)
+
+	files := make(map[string]string)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Failed to read tar entry: %v", err)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", header.Name, err)
+		}
+		files[header.Name] = string(content)
+	}
+	return files
+}
+
+// TestBackup tests that a backup round-trips through archive/tar.
+func TestBackup(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := NewWithLayout(tmpDir, LayoutNested)
+	if err != nil {
+		t.Fatalf("NewWithLayout() failed: %v", err)
+	}
+	for _, date := range []string{"2024-01-10", "2024-01-15", "2024-02-01"} {
+		if err := vault.WriteEntry(date, []byte("# "+date+"\n")); err != nil {
+			t.Fatalf("WriteEntry() failed: %v", err)
+		}
+	}
+
+	// Other markdown is kept, everything else and hidden directories are not
+	os.WriteFile(filepath.Join(tmpDir, "ideas.md"), []byte("# Ideas\n"), 0644)
+	os.WriteFile(filepath.Join(tmpDir, "photo.png"), []byte("png"), 0644)
+	os.MkdirAll(filepath.Join(tmpDir, ".git"), 0755)
+	os.WriteFile(filepath.Join(tmpDir, ".git", "notes.md"), []byte("git"), 0644)
+
+	var archive bytes.Buffer
+	added, err := vault.Backup(&archive, "", "")
+	if err != nil {
+		t.Fatalf("Backup() failed: %v", err)
+	}
+	if added != 4 {
+		t.Errorf("Expected 4 files backed up, got %d", added)
+	}
+
+	expected := map[string]string{
+		"2024/01/2024-01-10.md": "# 2024-01-10\n",
+		"2024/01/2024-01-15.md": "# 2024-01-15\n",
+		"2024/02/2024-02-01.md": "# 2024-02-01\n",
+		"ideas.md":              "# Ideas\n",
+	}
+	if files := readBackup(t, archive.Bytes()); !reflect.DeepEqual(files, expected) {
+		t.Errorf("Expected archive %v, got %v", expected, files)
+	}
+
+	// A range keeps only the entries inside it
+	archive.Reset()
+	if _, err := vault.Backup(&archive, "2024-01-12", "2024-01-31"); err != nil {
+		t.Fatalf("Backup() with range failed: %v", err)
+	}
+	expected = map[string]string{"2024/01/2024-01-15.md": "# 2024-01-15\n"}
+	if files := readBackup(t, archive.Bytes()); !reflect.DeepEqual(files, expected) {
+		t.Errorf("Expected ranged archive %v, got %v", expected, files)
+	}
+
+	if _, err := vault.Backup(io.Discard, "2024-02-01", "2024-01-01"); err == nil {
+		t.Error("Expected error for a reversed range")
+	}
+}
+
+// TestBackupEncrypted tests that encrypted backups keep the salt.
+func TestBackupEncrypted(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	vault.Passphrase = "correct horse"
+	if err := vault.WriteEntry("2024-01-15", []byte("# Secret\n")); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+
+	var archive bytes.Buffer
+	if _, err := vault.Backup(&archive, "2024-01-01", ""); err != nil {
+		t.Fatalf("Backup() failed: %v", err)
+	}
+	files := readBackup(t, archive.Bytes())
+
+	if !IsEncrypted([]byte(files["2024-01-15.md"])) {
+		t.Error("Expected the entry to stay encrypted in the archive")
+	}
+
+	// Restoring into a new directory still opens with the passphrase
+	restoreDir := filepath.Join(tmpDir, "restore")
+	os.MkdirAll(restoreDir, 0700)
+	for name, content := range files {
+		os.WriteFile(filepath.Join(restoreDir, name), []byte(content), 0600)
+	}
+	restored := &Vault{Directory: restoreDir, Passphrase: "correct horse"}
+	if content, err := restored.ReadEntry("2024-01-15"); err != nil || string(content) != "# Secret\n" {
+		t.Errorf("Expected restored entry to decrypt, got %q, %v", content, err)
+	}
+}
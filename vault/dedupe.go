@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// DuplicateGroup is a set of entries sharing the same content.
+type DuplicateGroup struct {
+	// Hash is the hex sha256 of the shared content (empty for the Blank group)
+	Hash string
+	// Blank marks the group of empty or template-only entries, whose bytes
+	// differ only by the date in their heading
+	Blank bool
+	// Dates lists the entries in the group, newest first
+	Dates []string
+	// Content is the shared content (nil for the Blank group)
+	Content []byte
+}
+
+// Duplicates groups entries whose content is byte-for-byte identical,
+// comparing sha256 hashes of the decrypted content. Only groups of two or
+// more entries are returned, except the Blank group, which is reported
+// whenever it has members so stale days stand out. Groups are ordered by
+// their newest entry.
+// Learn: Keying a map by a content hash groups equal values in one pass.
+// See: https://pkg.go.dev/crypto/sha256#Sum256
+func (v *Vault) Duplicates() ([]DuplicateGroup, error) {
+	filenames, err := v.ListEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 1: Bucket dates by content hash, remembering first-seen order
+	byHash := make(map[string]*DuplicateGroup)
+	var order []string
+	for _, filename := range filenames {
+		date := strings.TrimSuffix(filename, ".md")
+		content, err := v.ReadEntry(date)
+		if err != nil {
+			return nil, err
+		}
+
+		key := ""
+		if !v.isBlankEntry(date, content) {
+			sum := sha256.Sum256(content)
+			key = hex.EncodeToString(sum[:])
+		}
+
+		group, ok := byHash[key]
+		if !ok {
+			group = &DuplicateGroup{Hash: key, Blank: key == ""}
+			if !group.Blank {
+				group.Content = content
+			}
+			byHash[key] = group
+			order = append(order, key)
+		}
+		group.Dates = append(group.Dates, date)
+	}
+
+	// Step 2: Keep the groups worth reporting
+	var groups []DuplicateGroup
+	for _, key := range order {
+		group := byHash[key]
+		if len(group.Dates) > 1 || group.Blank {
+			groups = append(groups, *group)
+		}
+	}
+
+	return groups, nil
+}
+
+// isBlankEntry reports whether content is empty or still just the template
+// CreateEntry writes for date, ignoring surrounding whitespace. Entries
+// created before front matter was turned on still count as blank.
+func (v *Vault) isBlankEntry(date string, content []byte) bool {
+	trimmed := strings.TrimSpace(string(content))
+	return trimmed == "" ||
+		trimmed == strings.TrimSpace(headingTemplate(date)) ||
+		trimmed == strings.TrimSpace(v.entryTemplate(date))
+}
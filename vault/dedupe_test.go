@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+// TestDuplicates tests grouping identical and template-only entries.
+func TestDuplicates(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	pasted := "# Standup\n\n- shipped the thing\n"
+	for date, content := range map[string]string{
+		"2024-01-10": pasted,
+		"2024-01-11": "# Unique\n",
+		"2024-01-12": pasted,
+		"2024-01-13": "",
+		"2024-01-14": pasted,
+	} {
+		if err := vault.WriteEntry(date, []byte(content)); err != nil {
+			t.Fatalf("WriteEntry() failed: %v", err)
+		}
+	}
+	if err := vault.CreateEntry("2024-01-15"); err != nil {
+		t.Fatalf("CreateEntry() failed: %v", err)
+	}
+
+	groups, err := vault.Duplicates()
+	if err != nil {
+		t.Fatalf("Duplicates() failed: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, got %+v", groups)
+	}
+
+	// Ordered by newest entry: the blank group holds 2024-01-15
+	blank := groups[0]
+	if !blank.Blank || !reflect.DeepEqual(blank.Dates, []string{"2024-01-15", "2024-01-13"}) {
+		t.Errorf("Expected blank group of 2024-01-15 and 2024-01-13, got %+v", blank)
+	}
+
+	same := groups[1]
+	if same.Blank || string(same.Content) != pasted || len(same.Hash) != 64 {
+		t.Errorf("Expected a hashed group with the pasted content, got %+v", same)
+	}
+	if !reflect.DeepEqual(same.Dates, []string{"2024-01-14", "2024-01-12", "2024-01-10"}) {
+		t.Errorf("Expected pasted dates newest first, got %v", same.Dates)
+	}
+}
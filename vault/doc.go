@@ -17,7 +17,7 @@ Key Features:
 
 Usage Example:
 
-	// Create a new vault
+	// Create a new vault (Open instead fails if the directory is missing)
 	vault, err := vault.New("~/journal")
 	if err != nil {
 		log.Fatal(err)
@@ -62,6 +62,33 @@ a separate markdown file:
 	├── 2024-01-13.md
 	└── ...
 
+Vaults opened with NewWithLayout(dir, LayoutNested) instead group entries
+into year and month subdirectories, which keeps large journals manageable
+in a file manager:
+
+	journal/
+	└── 2024/
+	    └── 01/
+	        ├── 2024-01-15.md
+	        └── 2024-01-14.md
+
+In-Memory Stores:
+
+The Store interface covers reading, writing and listing entries. Vault
+implements it on disk and Memory in a map, so code that accepts a Store
+can be tested without a temp directory:
+
+	store := vault.NewMemory()
+	store.WriteEntry("2024-01-15", []byte("# 2024-01-15\n"))
+
+Trash:
+
+DeleteEntry moves an entry into the hidden .trash/ directory with the time
+of the delete in its name, e.g. .trash/2024-01-15.20240116-093000.250.md.
+RestoreEntry moves the latest copy back and EmptyTrash removes them for
+good. Setting DeletePermanently skips the trash. Entry listings never look
+inside hidden directories, so trashed entries stay out of them.
+
 Error Handling:
 
 All file operations return descriptive errors using fmt.Errorf with error
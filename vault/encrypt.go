@@ -0,0 +1,295 @@
+package vault
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"logmd/logging"
+)
+
+// Encrypted entry format: the header line, a 16-byte salt, a 12-byte GCM
+// nonce and the sealed content. Keeping the salt in every file means an
+// entry can be decrypted with nothing but the passphrase.
+const (
+	// saltFileName holds the vault-wide salt used for new encrypted entries
+	saltFileName = ".logmd-salt"
+	saltSize     = 16
+	keySize      = 32 // AES-256
+	// pbkdf2Iterations follows the OWASP recommendation for PBKDF2-HMAC-SHA256
+	pbkdf2Iterations = 600_000
+)
+
+// encryptedHeader starts every encrypted entry so it can't be mistaken for markdown.
+var encryptedHeader = []byte("LOGMD-ENCRYPTED-V1\n")
+
+// ErrEncrypted is returned when reading an encrypted entry without a passphrase.
+var ErrEncrypted = errors.New("entry is encrypted (set encrypt = true and provide a passphrase)")
+
+// ErrDecrypt is returned when an encrypted entry can't be opened, either
+// because the passphrase is wrong or the file was modified.
+var ErrDecrypt = errors.New("failed to decrypt entry (wrong passphrase or corrupted file)")
+
+// derivedKeys caches keys by passphrase and salt. Deriving a key is
+// deliberately slow, and every entry in a vault shares the same salt.
+var (
+	derivedKeysMu sync.Mutex
+	derivedKeys   = make(map[string][]byte)
+)
+
+// IsEncrypted reports whether data is an encrypted entry.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, encryptedHeader)
+}
+
+// deriveKey turns a passphrase into an AES key with PBKDF2.
+// Learn: A key derivation function makes brute-forcing a passphrase expensive.
+// See: https://pkg.go.dev/crypto/pbkdf2
+func deriveKey(passphrase string, salt []byte) ([]byte, error) {
+	cacheKey := passphrase + "\x00" + string(salt)
+
+	derivedKeysMu.Lock()
+	defer derivedKeysMu.Unlock()
+	if key, ok := derivedKeys[cacheKey]; ok {
+		return key, nil
+	}
+
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, pbkdf2Iterations, keySize)
+	if err != nil {
+		return nil, err
+	}
+	derivedKeys[cacheKey] = key
+	return key, nil
+}
+
+// newGCM builds an AES-GCM cipher for a passphrase and salt.
+// Learn: GCM authenticates the ciphertext, so tampering is detected on Open.
+// See: https://pkg.go.dev/crypto/cipher#NewGCM
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := deriveKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptContent seals plaintext into the encrypted entry format.
+func encryptContent(passphrase string, salt, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(encryptedHeader)+len(salt)+len(nonce)+len(plaintext)+gcm.Overhead())
+	out = append(out, encryptedHeader...)
+	out = append(out, salt...)
+	out = append(out, nonce...)
+	// The header is authenticated too, so it can't be swapped out
+	return gcm.Seal(out, nonce, plaintext, encryptedHeader), nil
+}
+
+// decryptContent opens an entry produced by encryptContent.
+func decryptContent(passphrase string, data []byte) ([]byte, error) {
+	body := bytes.TrimPrefix(data, encryptedHeader)
+	if len(body) < saltSize {
+		return nil, ErrDecrypt
+	}
+	salt, body := body[:saltSize], body[saltSize:]
+
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) < gcm.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	nonce, sealed := body[:gcm.NonceSize()], body[gcm.NonceSize():]
+
+	plaintext, err := gcm.Open(nil, nonce, sealed, encryptedHeader)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+// salt returns the vault's salt, creating the salt file on first use.
+func (v *Vault) salt() ([]byte, error) {
+	path := filepath.Join(v.Directory, saltFileName)
+
+	salt, err := os.ReadFile(path)
+	if err == nil && len(salt) == saltSize {
+		return salt, nil
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read salt file: %w", err)
+	}
+
+	salt = make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(path, salt, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write salt file: %w", err)
+	}
+	return salt, nil
+}
+
+// EncryptEntries encrypts every entry that is still stored as plain text,
+// returning how many were encrypted. The vault must have a passphrase, and
+// it must open any entries that are already encrypted so a typo can't leave
+// the journal split across two passphrases.
+func (v *Vault) EncryptEntries() (int, error) {
+	if v.Passphrase == "" {
+		return 0, fmt.Errorf("a passphrase is required to encrypt entries")
+	}
+
+	filenames, err := v.ListEntries()
+	if err != nil {
+		return 0, err
+	}
+
+	// Step 1: Read every entry, checking the passphrase on encrypted ones
+	plain := make(map[string][]byte)
+	var dates []string
+	for _, filename := range filenames {
+		date := strings.TrimSuffix(filename, ".md")
+		raw, err := os.ReadFile(v.DatePath(date))
+		if err != nil {
+			return 0, fmt.Errorf("failed to read entry %s: %w", date, err)
+		}
+		if IsEncrypted(raw) {
+			if _, err := decryptContent(v.Passphrase, raw); err != nil {
+				return 0, fmt.Errorf("passphrase does not match entry %s: %w", date, err)
+			}
+			continue
+		}
+		plain[date] = raw
+		dates = append(dates, date)
+	}
+
+	// Step 2: Write the plain ones back through the encrypting WriteEntry
+	for i, date := range dates {
+		if err := v.WriteEntry(date, plain[date]); err != nil {
+			return i, err
+		}
+	}
+
+	return len(dates), nil
+}
+
+// HasEncryptedEntries reports whether any entry in the vault is encrypted,
+// meaning the journal already has a passphrase that new entries must use.
+func (v *Vault) HasEncryptedEntries() (bool, error) {
+	date, _, err := v.newestEncrypted()
+	return date != "", err
+}
+
+// CheckPassphrase returns an error wrapping ErrDecrypt when passphrase
+// doesn't open the newest encrypted entry, so a mistyped passphrase is
+// caught before anything is written with it. A vault with no encrypted
+// entries accepts any passphrase.
+func (v *Vault) CheckPassphrase(passphrase string) error {
+	date, raw, err := v.newestEncrypted()
+	if err != nil || date == "" {
+		return err
+	}
+	if _, err := decryptContent(passphrase, raw); err != nil {
+		return fmt.Errorf("passphrase does not match entry %s: %w", date, err)
+	}
+	return nil
+}
+
+// newestEncrypted returns the date and raw content of the newest encrypted
+// entry, or an empty date when no entry is encrypted.
+func (v *Vault) newestEncrypted() (string, []byte, error) {
+	filenames, err := v.ListEntries()
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, filename := range filenames {
+		date := strings.TrimSuffix(filename, ".md")
+		raw, err := os.ReadFile(v.DatePath(date))
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to read entry %s: %w", date, err)
+		}
+		if IsEncrypted(raw) {
+			return date, raw, nil
+		}
+	}
+	return "", nil, nil
+}
+
+// CheckoutEntry returns a path where the entry for date can be read or
+// edited as plain text. Without a passphrase that is the entry itself;
+// otherwise the entry is decrypted into a private temp file. Every checkout
+// must be followed by CheckinEntry with the same path.
+func (v *Vault) CheckoutEntry(date string) (string, error) {
+	if v.Passphrase == "" {
+		return v.DatePath(date), nil
+	}
+
+	content, err := v.ReadEntry(date)
+	if err != nil {
+		return "", err
+	}
+
+	// CreateTemp makes the file readable by the current user only
+	tmp, err := os.CreateTemp("", "logmd-"+date+"-*.md")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file for entry %s: %w", date, err)
+	}
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("failed to write temp file for entry %s: %w", date, err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("failed to write temp file for entry %s: %w", date, err)
+	}
+
+	logging.Debugf("decrypt entry %s to %s", date, tmp.Name())
+	return tmp.Name(), nil
+}
+
+// CheckinEntry finishes a CheckoutEntry. A decrypted temp file is encrypted
+// back into the entry if it changed, then removed.
+func (v *Vault) CheckinEntry(date, path string) error {
+	if path == v.DatePath(date) {
+		return nil
+	}
+	defer func() {
+		logging.Debugf("remove %s", path)
+		os.Remove(path)
+	}()
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read edited entry %s: %w", date, err)
+	}
+	original, err := v.ReadEntry(date)
+	if err == nil && bytes.Equal(original, edited) {
+		return nil
+	}
+
+	return v.WriteEntry(date, edited)
+}
@@ -0,0 +1,254 @@
+package vault
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"regexp"
+	"testing"
+)
+
+// TestEncryptedEntries verifies entries round-trip through encryption.
+func TestEncryptedEntries(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	// A plain entry written before encryption was enabled
+	if err := vault.WriteEntry("2024-01-14", []byte("# Plain\n")); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+
+	vault.Passphrase = "correct horse"
+	secret := []byte("# Secret\n\nNobody should read this.\n")
+	if err := vault.WriteEntry("2024-01-15", secret); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+
+	raw, err := os.ReadFile(vault.DatePath("2024-01-15"))
+	if err != nil {
+		t.Fatalf("Failed to read entry file: %v", err)
+	}
+	if !IsEncrypted(raw) || bytes.Contains(raw, []byte("Nobody")) {
+		t.Errorf("Expected ciphertext on disk, got %q", raw)
+	}
+
+	content, err := vault.ReadEntry("2024-01-15")
+	if err != nil {
+		t.Fatalf("ReadEntry() failed: %v", err)
+	}
+	if !bytes.Equal(content, secret) {
+		t.Errorf("Expected %q, got %q", secret, content)
+	}
+
+	// Plain entries still read as they are
+	if content, err := vault.ReadEntry("2024-01-14"); err != nil || string(content) != "# Plain\n" {
+		t.Errorf("Expected plain entry to read unchanged, got %q, %v", content, err)
+	}
+
+	// Appending and searching see the decrypted content
+	if err := vault.AppendToEntry("2024-01-15", []byte("- later")); err != nil {
+		t.Fatalf("AppendToEntry() failed: %v", err)
+	}
+	matches, err := vault.Grep(regexp.MustCompile("later"))
+	if err != nil || len(matches) != 1 || matches[0].Date != "2024-01-15" {
+		t.Errorf("Expected one match in the encrypted entry, got %v, %v", matches, err)
+	}
+
+	// Without a passphrase, or with the wrong one, reading fails clearly
+	locked := &Vault{Directory: tmpDir, Layout: LayoutFlat}
+	if _, err := locked.ReadEntry("2024-01-15"); !errors.Is(err, ErrEncrypted) {
+		t.Errorf("Expected ErrEncrypted without a passphrase, got %v", err)
+	}
+	locked.Passphrase = "wrong"
+	if _, err := locked.ReadEntry("2024-01-15"); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Expected ErrDecrypt with the wrong passphrase, got %v", err)
+	}
+
+	// Tampering is detected
+	raw[len(raw)-1] ^= 0xff
+	if err := os.WriteFile(vault.DatePath("2024-01-15"), raw, 0644); err != nil {
+		t.Fatalf("Failed to write entry file: %v", err)
+	}
+	if _, err := vault.ReadEntry("2024-01-15"); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Expected ErrDecrypt for a modified file, got %v", err)
+	}
+}
+
+// TestEncryptEntries verifies plain entries are encrypted once.
+func TestEncryptEntries(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := NewWithLayout(tmpDir, LayoutNested)
+	if err != nil {
+		t.Fatalf("NewWithLayout() failed: %v", err)
+	}
+	for _, date := range []string{"2024-01-14", "2024-01-15"} {
+		if err := vault.WriteEntry(date, []byte("# "+date+"\n")); err != nil {
+			t.Fatalf("WriteEntry() failed: %v", err)
+		}
+	}
+
+	if _, err := vault.EncryptEntries(); err == nil {
+		t.Error("Expected error without a passphrase")
+	}
+
+	vault.Passphrase = "correct horse"
+	count, err := vault.EncryptEntries()
+	if err != nil || count != 2 {
+		t.Fatalf("Expected 2 entries encrypted, got %d, %v", count, err)
+	}
+	if count, err := vault.EncryptEntries(); err != nil || count != 0 {
+		t.Errorf("Expected already encrypted entries to be skipped, got %d, %v", count, err)
+	}
+
+	content, err := vault.ReadEntry("2024-01-14")
+	if err != nil || string(content) != "# 2024-01-14\n" {
+		t.Errorf("Expected migrated entry to decrypt, got %q, %v", content, err)
+	}
+
+	// The salt file isn't mistaken for an entry
+	entries, err := vault.ListEntries()
+	if err != nil || len(entries) != 2 {
+		t.Errorf("Expected 2 entries listed, got %v, %v", entries, err)
+	}
+}
+
+// TestEncryptEntriesPassphraseMismatch verifies a different passphrase is refused.
+func TestEncryptEntriesPassphraseMismatch(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	vault.Passphrase = "first"
+	if err := vault.WriteEntry("2024-01-15", []byte("# Secret\n")); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+	vault.Passphrase = ""
+	if err := vault.WriteEntry("2024-01-16", []byte("# Plain\n")); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+
+	vault.Passphrase = "second"
+	if _, err := vault.EncryptEntries(); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Expected ErrDecrypt for a mismatched passphrase, got %v", err)
+	}
+
+	raw, err := os.ReadFile(vault.DatePath("2024-01-16"))
+	if err != nil || IsEncrypted(raw) {
+		t.Errorf("Expected plain entry to be left alone, got %q, %v", raw, err)
+	}
+}
+
+// TestCheckPassphrase verifies a passphrase is checked against the newest
+// encrypted entry, and accepted while there is none.
+func TestCheckPassphrase(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	if err := vault.WriteEntry("2024-01-16", []byte("# Plain\n")); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+
+	if encrypted, err := vault.HasEncryptedEntries(); err != nil || encrypted {
+		t.Errorf("Expected no encrypted entries, got %v, %v", encrypted, err)
+	}
+	if err := vault.CheckPassphrase("anything"); err != nil {
+		t.Errorf("Expected any passphrase accepted without encrypted entries, got %v", err)
+	}
+
+	vault.Passphrase = "first"
+	if err := vault.WriteEntry("2024-01-15", []byte("# Secret\n")); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+
+	if encrypted, err := vault.HasEncryptedEntries(); err != nil || !encrypted {
+		t.Errorf("Expected an encrypted entry, got %v, %v", encrypted, err)
+	}
+	if err := vault.CheckPassphrase("first"); err != nil {
+		t.Errorf("Expected the passphrase to match, got %v", err)
+	}
+	if err := vault.CheckPassphrase("frist"); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Expected ErrDecrypt for a mistyped passphrase, got %v", err)
+	}
+}
+
+// TestCheckoutEntry verifies editing through a decrypted temp file.
+func TestCheckoutEntry(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	if err := vault.WriteEntry("2024-01-15", []byte("# Plain\n")); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+
+	// Without a passphrase the entry file itself is edited
+	path, err := vault.CheckoutEntry("2024-01-15")
+	if err != nil || path != vault.DatePath("2024-01-15") {
+		t.Errorf("Expected the entry path, got %s, %v", path, err)
+	}
+	if err := vault.CheckinEntry("2024-01-15", path); err != nil {
+		t.Errorf("CheckinEntry() failed: %v", err)
+	}
+
+	vault.Passphrase = "correct horse"
+	path, err = vault.CheckoutEntry("2024-01-15")
+	if err != nil {
+		t.Fatalf("CheckoutEntry() failed: %v", err)
+	}
+	if path == vault.DatePath("2024-01-15") {
+		t.Fatal("Expected a temp file for an encrypted vault")
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("Expected a private temp file, got %v, %v", info, err)
+	}
+
+	if err := os.WriteFile(path, []byte("# Edited\n"), 0600); err != nil {
+		t.Fatalf("Failed to edit temp file: %v", err)
+	}
+	if err := vault.CheckinEntry("2024-01-15", path); err != nil {
+		t.Fatalf("CheckinEntry() failed: %v", err)
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("Expected the temp file to be removed")
+	}
+	raw, err := os.ReadFile(vault.DatePath("2024-01-15"))
+	if err != nil || !IsEncrypted(raw) {
+		t.Errorf("Expected the edited entry to be stored encrypted, got %q", raw)
+	}
+	if content, err := vault.ReadEntry("2024-01-15"); err != nil || string(content) != "# Edited\n" {
+		t.Errorf("Expected edited content, got %q, %v", content, err)
+	}
+}
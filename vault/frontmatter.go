@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+)
+
+// frontMatterTemplate returns a YAML front matter block with one line per
+// field, or "" when there are no fields. The date field holds date and the
+// tags field an empty list; every other field is left blank to fill in.
+// Learn: A key with nothing after the colon is a valid YAML null.
+// See: https://yaml.org/spec/1.2.2/#example-mapping-scalars-to-scalars
+func frontMatterTemplate(fields []string, date string) string {
+	if len(fields) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("---\n")
+	for _, field := range fields {
+		switch field {
+		case "date":
+			fmt.Fprintf(&b, "date: %s\n", date)
+		case "tags":
+			b.WriteString("tags: []\n")
+		default:
+			fmt.Fprintf(&b, "%s:\n", field)
+		}
+	}
+	b.WriteString("---\n\n")
+	return b.String()
+}
+
+// frontMatterLines returns how many leading lines a "---" delimited front
+// matter block takes up, delimiters included, or 0 when there is none. It
+// uses the same rules as markdown.StripFrontMatter.
+func frontMatterLines(lines []string) int {
+	if len(lines) < 3 || strings.TrimSuffix(lines[0], "\r") != "---" {
+		return 0
+	}
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSuffix(lines[i], "\r") == "---" {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// headingLine returns the index of the first non-blank line after any front
+// matter, which is where an entry's heading belongs, or -1 if there is none.
+func headingLine(lines []string) int {
+	for i := frontMatterLines(lines); i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != "" {
+			return i
+		}
+	}
+	return -1
+}
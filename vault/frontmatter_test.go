@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"os"
+	"testing"
+)
+
+// TestCreateEntryFrontMatter tests new entries start with the configured front matter.
+func TestCreateEntryFrontMatter(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	vault.FrontMatter = []string{"date", "mood", "tags"}
+
+	if err := vault.CreateEntry("2024-01-15"); err != nil {
+		t.Fatalf("CreateEntry() failed: %v", err)
+	}
+	content, err := vault.ReadEntry("2024-01-15")
+	if err != nil {
+		t.Fatalf("ReadEntry() failed: %v", err)
+	}
+	expected := "---\ndate: 2024-01-15\nmood:\ntags: []\n---\n\n# 2024-01-15\n\n"
+	if string(content) != expected {
+		t.Errorf("Expected %q, got %q", expected, content)
+	}
+
+	// A fresh entry passes lint and still counts as blank
+	findings, err := vault.Lint("2024-01-15")
+	if err != nil || len(findings) != 0 {
+		t.Errorf("Lint() = %v, %v; expected no findings", findings, err)
+	}
+	if !vault.isBlankEntry("2024-01-15", content) {
+		t.Error("Expected a template-only entry with front matter to be blank")
+	}
+	if !vault.isBlankEntry("2024-01-16", []byte("# 2024-01-16\n")) {
+		t.Error("Expected entries created without front matter to stay blank")
+	}
+
+	// The heading below the front matter is the one that moves with the entry
+	if ok, err := vault.HasHeading("2024-01-15", "2024-01-15"); err != nil || !ok {
+		t.Errorf("HasHeading() = %v, %v; expected the heading after front matter", ok, err)
+	}
+	if err := vault.MoveEntry("2024-01-15", "2024-01-14"); err != nil {
+		t.Fatalf("MoveEntry() failed: %v", err)
+	}
+	if changed, err := vault.RewriteHeading("2024-01-14", "2024-01-15", "2024-01-14"); err != nil || !changed {
+		t.Fatalf("RewriteHeading() = %v, %v; expected a change", changed, err)
+	}
+	content, _ = vault.ReadEntry("2024-01-14")
+	expected = "---\ndate: 2024-01-15\nmood:\ntags: []\n---\n\n# 2024-01-14\n\n"
+	if string(content) != expected {
+		t.Errorf("Expected only the heading to change, got %q", content)
+	}
+}
+
+// TestFrontMatterTemplate tests the block written for different field lists.
+func TestFrontMatterTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   []string
+		expected string
+	}{
+		{"disabled", nil, ""},
+		{"custom fields", []string{"weather", "date"}, "---\nweather:\ndate: 2024-01-15\n---\n\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := frontMatterTemplate(tt.fields, "2024-01-15"); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package vault
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Finding is a single problem reported by Lint.
+type Finding struct {
+	// Date is the entry date (YYYY-MM-DD)
+	Date string `json:"date"`
+	// Line is the 1-based line number the problem is on
+	Line int `json:"line"`
+	// Rule names the check, e.g. "trailing-whitespace"
+	Rule string `json:"rule"`
+	// Message describes the problem
+	Message string `json:"message"`
+}
+
+// String formats the finding as "date:line: rule: message".
+func (f Finding) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s", f.Date, f.Line, f.Rule, f.Message)
+}
+
+// linkPattern matches inline markdown links and images, capturing the target.
+var linkPattern = regexp.MustCompile(`!?\[[^\]]*\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+
+// schemePattern matches targets with a URL scheme such as https: or mailto:.
+var schemePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]*:`)
+
+// datePattern matches a YYYY-MM-DD date anywhere in a line.
+var datePattern = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}\b`)
+
+// Lint checks the entry for date and returns its findings in line order:
+// a missing top-level heading, a heading naming a different date, trailing
+// whitespace, and relative links to files that don't exist.
+func (v *Vault) Lint(date string) ([]Finding, error) {
+	content, err := v.ReadEntry(date)
+	if err != nil {
+		return nil, err
+	}
+
+	exists := func(target string) bool {
+		return v.linkedFileExists(date, target)
+	}
+	return lintEntry(date, content, exists), nil
+}
+
+// CheckLocalLink reports whether a link destination in the entry for date
+// points at a local file and, if it does, whether that file exists.
+// Relative paths are resolved against the entry's directory, as a markdown
+// previewer would; URLs and in-page anchors aren't local.
+func (v *Vault) CheckLocalLink(date, destination string) (local, exists bool) {
+	path, local := v.ResolveLocalLink(date, destination)
+	if !local {
+		return false, false
+	}
+	_, err := os.Stat(path)
+	return true, err == nil
+}
+
+// ResolveLocalLink returns the cleaned absolute path a link destination in
+// the entry for date points at, or false when it isn't a local file.
+func (v *Vault) ResolveLocalLink(date, destination string) (string, bool) {
+	target, local := localLinkTarget(destination)
+	if !local {
+		return "", false
+	}
+	return v.linkedFilePath(date, target), true
+}
+
+// linkedFilePath resolves path against the directory of the entry for date
+// unless it is absolute.
+func (v *Vault) linkedFilePath(date, path string) string {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(v.DatePath(date)), path)
+	}
+	return filepath.Clean(path)
+}
+
+// linkedFileExists reports whether path, relative to the directory of the
+// entry for date unless absolute, exists.
+func (v *Vault) linkedFileExists(date, path string) bool {
+	_, err := os.Stat(v.linkedFilePath(date, path))
+	return err == nil
+}
+
+// LintAll lints every entry, newest first.
+func (v *Vault) LintAll() ([]Finding, error) {
+	filenames, err := v.ListEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	for _, filename := range filenames {
+		entryFindings, err := v.Lint(strings.TrimSuffix(filename, ".md"))
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, entryFindings...)
+	}
+	return findings, nil
+}
+
+// lintEntry runs the checks over content. exists reports whether a relative
+// link target is present, so the checks themselves never touch the disk.
+func lintEntry(date string, content []byte, exists func(target string) bool) []Finding {
+	var findings []Finding
+	add := func(line int, rule, format string, args ...any) {
+		findings = append(findings, Finding{Date: date, Line: line, Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	lines := strings.Split(string(content), "\n")
+	frontMatter := frontMatterLines(lines)
+	headingChecked := false
+	inFence := false
+
+	for i, line := range lines {
+		lineNo := i + 1
+		line = strings.TrimSuffix(line, "\r")
+		trimmed := strings.TrimSpace(line)
+
+		// Step 1: Trailing whitespace applies everywhere, code included
+		if trimmed != "" && strings.TrimRight(line, " \t") != line {
+			add(lineNo, "trailing-whitespace", "line ends with whitespace")
+		}
+		if i < frontMatter {
+			continue
+		}
+
+		// Step 2: The first non-blank line after any front matter should
+		// be a "# " heading
+		if !headingChecked && trimmed != "" {
+			headingChecked = true
+			if !strings.HasPrefix(trimmed, "# ") {
+				add(lineNo, "heading", "entry does not start with a top-level \"# \" heading")
+			} else if dates := datePattern.FindAllString(trimmed, -1); len(dates) > 0 && !slices.Contains(dates, date) {
+				add(lineNo, "heading-date", "heading date %s does not match entry date %s", dates[0], date)
+			}
+		}
+
+		// Step 3: Relative links outside code must point at existing files
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		for _, match := range linkPattern.FindAllStringSubmatch(inlineCodePattern.ReplaceAllString(line, ""), -1) {
+			if target, ok := localLinkTarget(match[1]); ok && !exists(target) {
+				add(lineNo, "broken-link", "link target %s does not exist", match[1])
+			}
+		}
+	}
+
+	if !headingChecked {
+		add(1, "heading", "entry is empty")
+	}
+	return findings
+}
+
+// localLinkTarget returns the file path a link points at, or false for
+// URLs, in-page anchors and other targets that aren't local files.
+func localLinkTarget(target string) (string, bool) {
+	if strings.HasPrefix(target, "#") || schemePattern.MatchString(target) {
+		return "", false
+	}
+
+	// Drop any #fragment or ?query and decode %20-style escapes
+	target, _, _ = strings.Cut(target, "#")
+	target, _, _ = strings.Cut(target, "?")
+	if decoded, err := url.PathUnescape(target); err == nil {
+		target = decoded
+	}
+	return filepath.FromSlash(target), target != ""
+}
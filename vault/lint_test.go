@@ -0,0 +1,128 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestLintEntry tests each lint rule against in-memory content.
+func TestLintEntry(t *testing.T) {
+	exists := func(target string) bool { return target == "photo.png" }
+
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{"clean", "# 2024-01-15\n\nA quiet day. See [photo](photo.png).\n", nil},
+		{"titled heading", "# New Year Resolution\n\nRun more.\n", nil},
+		{"empty", "", []string{"1:heading"}},
+		{"no heading", "\nJust text\n", []string{"2:heading"}},
+		{"subheading first", "## Morning\n", []string{"1:heading"}},
+		{"wrong date", "# 2024-01-14\n", []string{"1:heading-date"}},
+		{"trailing whitespace", "# Day\nfirst \nsecond\t\n\n", []string{"2:trailing-whitespace", "3:trailing-whitespace"}},
+		{"crlf is not whitespace", "# Day\r\nline\r\n", nil},
+		{"broken links", "# Day\n[a](missing.md) ![b](img/x.png \"title\") [c](photo.png#top)\n", []string{"2:broken-link", "2:broken-link"}},
+		{"urls and anchors", "# Day\n[a](https://example.com) [b](#top) [c](mailto:me@example.com)\n", nil},
+		{"front matter", "---\ndate: 2024-01-15\n# a yaml comment\n---\n\n# 2024-01-15\n", nil},
+		{"front matter without heading", "---\ndate: 2024-01-15 \n---\nText\n", []string{"2:trailing-whitespace", "4:heading"}},
+		{"links in code", "# Day\n```\n[a](missing.md)\n```\n`[b](missing.md)`\n", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, finding := range lintEntry("2024-01-15", []byte(tt.content), exists) {
+				got = append(got, fmt.Sprintf("%d:%s", finding.Line, finding.Rule))
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected findings %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestLint tests linting entries on disk, resolving links from the entry.
+func TestLint(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := NewWithLayout(tmpDir, LayoutNested)
+	if err != nil {
+		t.Fatalf("NewWithLayout() failed: %v", err)
+	}
+	if err := vault.WriteEntry("2024-01-15", []byte("# 2024-01-15\n\n![cat](cat.jpg)\n")); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+	if err := vault.WriteEntry("2024-01-16", []byte("# 2024-01-16 \n")); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+
+	findings, err := vault.Lint("2024-01-15")
+	if err != nil {
+		t.Fatalf("Lint() failed: %v", err)
+	}
+	if len(findings) != 1 || findings[0].Rule != "broken-link" || findings[0].Line != 3 {
+		t.Errorf("Expected a broken link on line 3, got %v", findings)
+	}
+
+	// Relative links resolve from the entry's own directory
+	os.WriteFile(filepath.Join(tmpDir, "2024", "01", "cat.jpg"), []byte("jpg"), 0644)
+	if findings, _ := vault.Lint("2024-01-15"); len(findings) != 0 {
+		t.Errorf("Expected no findings once the image exists, got %v", findings)
+	}
+
+	all, err := vault.LintAll()
+	if err != nil {
+		t.Fatalf("LintAll() failed: %v", err)
+	}
+	expected := []Finding{{Date: "2024-01-16", Line: 1, Rule: "trailing-whitespace", Message: "line ends with whitespace"}}
+	if !reflect.DeepEqual(all, expected) {
+		t.Errorf("Expected %v, got %v", expected, all)
+	}
+	if _, err := vault.Lint("2024-02-01"); err == nil {
+		t.Error("Expected error linting a missing entry")
+	}
+}
+
+// TestCheckLocalLink tests classifying link destinations and resolving
+// local ones from the entry's directory.
+func TestCheckLocalLink(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := NewWithLayout(tmpDir, LayoutNested)
+	if err != nil {
+		t.Fatalf("NewWithLayout() failed: %v", err)
+	}
+	os.MkdirAll(filepath.Join(tmpDir, "2024", "01"), 0700)
+	os.WriteFile(filepath.Join(tmpDir, "2024", "01", "cat photo.jpg"), []byte("jpg"), 0644)
+
+	testCases := []struct {
+		destination string
+		local       bool
+		exists      bool
+	}{
+		{"cat%20photo.jpg", true, true},
+		{"cat photo.jpg#top", true, true},
+		{"dog.jpg", true, false},
+		{"https://example.com", false, false},
+		{"mailto:me@example.com", false, false},
+		{"#morning", false, false},
+	}
+	for _, tc := range testCases {
+		local, exists := vault.CheckLocalLink("2024-01-15", tc.destination)
+		if local != tc.local || exists != tc.exists {
+			t.Errorf("CheckLocalLink(%q) = %t, %t, expected %t, %t", tc.destination, local, exists, tc.local, tc.exists)
+		}
+	}
+}
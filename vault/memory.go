@@ -0,0 +1,156 @@
+package vault
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Memory is a Store that keeps entries in a map instead of files, for tests
+// of commands and the TUI that shouldn't touch disk. It is safe for
+// concurrent use, since the TUI loads previews from several goroutines.
+// Learn: A sync.RWMutex lets many readers share access while writers get it alone.
+// See: https://pkg.go.dev/sync#RWMutex
+type Memory struct {
+	// FrontMatter lists the YAML front matter fields CreateEntry starts new
+	// entries with, as for Vault
+	FrontMatter []string
+
+	mu      sync.RWMutex
+	entries map[string]memoryEntry
+}
+
+// memoryEntry is one entry held by a Memory store.
+type memoryEntry struct {
+	content []byte
+	modTime time.Time
+}
+
+// NewMemory returns an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{entries: make(map[string]memoryEntry)}
+}
+
+// DatePath returns the entry's filename, date.md. There is no directory,
+// but the name matches what ListEntries returns.
+func (m *Memory) DatePath(date string) string {
+	return date + ".md"
+}
+
+// EntryExists checks if the store holds an entry for the given date.
+func (m *Memory) EntryExists(date string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.entries[date]
+	return ok
+}
+
+// ReadEntry returns a copy of the entry for the given date, or an error
+// wrapping ErrEntryNotFound if there is none.
+func (m *Memory) ReadEntry(date string) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entry, ok := m.entries[date]
+	if !ok {
+		return nil, &entryNotFoundError{date: date}
+	}
+	return bytes.Clone(entry.content), nil
+}
+
+// WriteEntry stores a copy of content as the entry for the given date,
+// replacing any existing one.
+func (m *Memory) WriteEntry(date string, content []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[date] = memoryEntry{content: bytes.Clone(content), modTime: time.Now()}
+	return nil
+}
+
+// CreateEntry creates a new entry from the same template as Vault.
+// Returns an error if the entry already exists.
+func (m *Memory) CreateEntry(date string) error {
+	if m.EntryExists(date) {
+		return fmt.Errorf("entry %s already exists", date)
+	}
+	return m.WriteEntry(date, []byte(frontMatterTemplate(m.FrontMatter, date)+headingTemplate(date)))
+}
+
+// AppendToEntry appends content to the entry for the given date like
+// Vault.AppendToEntry, creating the entry from the template first if needed.
+func (m *Memory) AppendToEntry(date string, content []byte) error {
+	if !m.EntryExists(date) {
+		if err := m.CreateEntry(date); err != nil {
+			return err
+		}
+	}
+
+	existing, err := m.ReadEntry(date)
+	if err != nil {
+		return err
+	}
+
+	if len(existing) > 0 && !bytes.HasSuffix(existing, []byte("\n")) {
+		existing = append(existing, '\n')
+	}
+	existing = append(existing, content...)
+	if !bytes.HasSuffix(existing, []byte("\n")) {
+		existing = append(existing, '\n')
+	}
+
+	return m.WriteEntry(date, existing)
+}
+
+// ListEntries returns the stored entries as YYYY-MM-DD.md, newest first.
+// Entries written under strings that aren't dates are left out, as files
+// with such names are from a Vault.
+func (m *Memory) ListEntries() ([]string, error) {
+	m.mu.RLock()
+	names := make([]string, 0, len(m.entries))
+	for date := range m.entries {
+		names = append(names, m.DatePath(date))
+	}
+	m.mu.RUnlock()
+
+	return sortEntriesNewestFirst(names, DefaultDateFormat), nil
+}
+
+// ListEntriesInfo returns metadata for the stored entries, newest first.
+// Size is the content length and ModTime the time of the last write.
+func (m *Memory) ListEntriesInfo() ([]EntryInfo, error) {
+	names, err := m.ListEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	infos := make([]EntryInfo, 0, len(names))
+	for _, name := range names {
+		date := strings.TrimSuffix(name, ".md")
+		entry := m.entries[date]
+		infos = append(infos, EntryInfo{
+			Date:    date,
+			Path:    name,
+			Exists:  true,
+			Size:    int64(len(entry.content)),
+			ModTime: entry.modTime,
+		})
+	}
+	return infos, nil
+}
+
+// ExistingDates returns the set of dates (YYYY-MM-DD) the store has entries for.
+func (m *Memory) ExistingDates() (map[string]bool, error) {
+	names, err := m.ListEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make(map[string]bool, len(names))
+	for _, name := range names {
+		dates[strings.TrimSuffix(name, ".md")] = true
+	}
+	return dates, nil
+}
@@ -0,0 +1,118 @@
+package vault
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestStoreImplementations runs the same checks against the filesystem
+// Vault and the in-memory store, so Memory stays a faithful stand-in.
+func TestStoreImplementations(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-store-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	v, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to create vault: %v", err)
+	}
+
+	stores := map[string]Store{
+		"Vault":  v,
+		"Memory": NewMemory(),
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			testStore(t, s)
+		})
+	}
+}
+
+// testStore exercises every Store method on an empty store.
+func testStore(t *testing.T, s Store) {
+	if _, err := s.ReadEntry("2024-01-15"); !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("Expected ErrEntryNotFound for a missing entry, got %v", err)
+	}
+	if s.EntryExists("2024-01-15") {
+		t.Error("Expected no entry in an empty store")
+	}
+
+	if err := s.CreateEntry("2024-01-15"); err != nil {
+		t.Fatalf("CreateEntry() failed: %v", err)
+	}
+	if err := s.CreateEntry("2024-01-15"); err == nil {
+		t.Error("Expected error creating an existing entry")
+	}
+	if err := s.AppendToEntry("2024-01-15", []byte("- note")); err != nil {
+		t.Fatalf("AppendToEntry() failed: %v", err)
+	}
+	content, err := s.ReadEntry("2024-01-15")
+	if err != nil {
+		t.Fatalf("ReadEntry() failed: %v", err)
+	}
+	if string(content) != "# 2024-01-15\n\n- note\n" {
+		t.Errorf("Unexpected content after append: %q", content)
+	}
+
+	if err := s.WriteEntry("2024-01-20", []byte("# Later\n")); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+	if err := s.AppendToEntry("2024-01-10", []byte("first")); err != nil {
+		t.Fatalf("AppendToEntry() on a new entry failed: %v", err)
+	}
+
+	names, err := s.ListEntries()
+	if err != nil {
+		t.Fatalf("ListEntries() failed: %v", err)
+	}
+	expected := []string{"2024-01-20.md", "2024-01-15.md", "2024-01-10.md"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("ListEntries() = %v, expected %v", names, expected)
+	}
+
+	infos, err := s.ListEntriesInfo()
+	if err != nil {
+		t.Fatalf("ListEntriesInfo() failed: %v", err)
+	}
+	if len(infos) != 3 || infos[0].Date != "2024-01-20" || !infos[0].Exists || infos[0].Size != int64(len("# Later\n")) {
+		t.Errorf("Unexpected ListEntriesInfo() result: %+v", infos)
+	}
+	if !strings.HasSuffix(infos[0].Path, s.DatePath("2024-01-20")) {
+		t.Errorf("Expected info path %s to end with DatePath %s", infos[0].Path, s.DatePath("2024-01-20"))
+	}
+
+	dates, err := s.ExistingDates()
+	if err != nil {
+		t.Fatalf("ExistingDates() failed: %v", err)
+	}
+	if len(dates) != 3 || !dates["2024-01-10"] {
+		t.Errorf("Unexpected ExistingDates() result: %v", dates)
+	}
+}
+
+// TestMemoryCopiesContent tests that callers can't change stored entries
+// through the slices they pass in or get back.
+func TestMemoryCopiesContent(t *testing.T) {
+	m := NewMemory()
+	content := []byte("# Original\n")
+	if err := m.WriteEntry("2024-01-15", content); err != nil {
+		t.Fatalf("WriteEntry() failed: %v", err)
+	}
+	content[2] = 'X'
+
+	read, err := m.ReadEntry("2024-01-15")
+	if err != nil {
+		t.Fatalf("ReadEntry() failed: %v", err)
+	}
+	read[3] = 'Y'
+
+	again, _ := m.ReadEntry("2024-01-15")
+	if string(again) != "# Original\n" {
+		t.Errorf("Expected stored content unchanged, got %q", again)
+	}
+}
@@ -0,0 +1,166 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"logmd/logging"
+)
+
+// Move is a planned rename of one entry file.
+type Move struct {
+	From string
+	To   string
+}
+
+// Plan lists the filesystem operations behind a move, migration or delete,
+// in the order they run: directories to create, files to rename, files to
+// remove, then directories left empty that get removed. Commands print it
+// for --dry-run.
+type Plan struct {
+	Mkdirs  []string
+	Moves   []Move
+	Removes []string
+	Rmdirs  []string
+}
+
+// PlanMove returns the plan MoveEntry would carry out for from and to,
+// failing for the same reasons it would.
+func (v *Vault) PlanMove(from, to string) (Plan, error) {
+	for _, date := range []string{from, to} {
+		if _, err := time.Parse(DefaultDateFormat, date); err != nil {
+			return Plan{}, fmt.Errorf("invalid date %s (expected YYYY-MM-DD)", date)
+		}
+	}
+	if !v.EntryExists(from) {
+		return Plan{}, &entryNotFoundError{date: from}
+	}
+	if v.EntryExists(to) {
+		return Plan{}, fmt.Errorf("entry %s already exists", to)
+	}
+
+	return v.planMoves([]Move{{From: v.DatePath(from), To: v.DatePath(to)}}), nil
+}
+
+// PlanMigration returns the plan MigrateTo would carry out to move every
+// entry into layout. Any destination that already exists fails the whole
+// plan, so a migration never stops halfway over a conflict.
+func (v *Vault) PlanMigration(layout Layout) (Plan, error) {
+	layout, err := ParseLayout(string(layout))
+	if err != nil {
+		return Plan{}, err
+	}
+	if layout == v.Layout {
+		return Plan{}, nil
+	}
+
+	filenames, err := v.ListEntries()
+	if err != nil {
+		return Plan{}, err
+	}
+
+	moves := make([]Move, 0, len(filenames))
+	for _, filename := range filenames {
+		date := strings.TrimSuffix(filename, ".md")
+		move := Move{From: v.DatePath(date), To: layoutPath(v.Directory, layout, v.dateFormat(), date)}
+		if _, err := os.Stat(move.To); err == nil {
+			return Plan{}, fmt.Errorf("failed to move entry %s: %s already exists", date, move.To)
+		}
+		moves = append(moves, move)
+	}
+
+	return v.planMoves(moves), nil
+}
+
+// planMoves works out the directories moves need created and the ones they
+// leave empty. A directory below the vault root is removed when everything
+// in it is moved out or is itself removed, and nothing is moved into it.
+func (v *Vault) planMoves(moves []Move) Plan {
+	plan := Plan{Moves: moves}
+
+	movedOut := make(map[string]bool)
+	movedInto := make(map[string]bool)
+	for _, move := range moves {
+		movedOut[move.From] = true
+		for dir := filepath.Dir(move.To); dir != v.Directory && dir != filepath.Dir(dir); dir = filepath.Dir(dir) {
+			movedInto[dir] = true
+		}
+
+		dir := filepath.Dir(move.To)
+		if _, err := os.Stat(dir); os.IsNotExist(err) && !slices.Contains(plan.Mkdirs, dir) {
+			plan.Mkdirs = append(plan.Mkdirs, dir)
+		}
+	}
+
+	// Source directories and their parents below the root, deepest first
+	var candidates []string
+	for _, move := range moves {
+		for dir := filepath.Dir(move.From); dir != v.Directory && dir != filepath.Dir(dir); dir = filepath.Dir(dir) {
+			if !slices.Contains(candidates, dir) {
+				candidates = append(candidates, dir)
+			}
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b string) int {
+		return strings.Count(b, string(filepath.Separator)) - strings.Count(a, string(filepath.Separator))
+	})
+
+	emptied := make(map[string]bool)
+	for _, dir := range candidates {
+		children, err := os.ReadDir(dir)
+		if err != nil || movedInto[dir] {
+			continue
+		}
+		empty := true
+		for _, child := range children {
+			path := filepath.Join(dir, child.Name())
+			if !movedOut[path] && !emptied[path] {
+				empty = false
+				break
+			}
+		}
+		if empty {
+			emptied[dir] = true
+			plan.Rmdirs = append(plan.Rmdirs, dir)
+		}
+	}
+
+	return plan
+}
+
+// apply carries out the plan and returns how many files were moved. Empty
+// directories are removed on a best-effort basis.
+func (p Plan) apply() (int, error) {
+	for _, dir := range p.Mkdirs {
+		logging.Debugf("create directory %s", dir)
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return 0, fmt.Errorf("failed to create directory %s: %w", dir, err)
+		}
+	}
+
+	for i, move := range p.Moves {
+		logging.Debugf("move %s to %s", move.From, move.To)
+		if err := os.Rename(move.From, move.To); err != nil {
+			return i, fmt.Errorf("failed to move %s: %w", move.From, err)
+		}
+	}
+
+	for _, path := range p.Removes {
+		logging.Debugf("remove %s", path)
+		if err := os.Remove(path); err != nil {
+			return len(p.Moves), fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+	}
+
+	// os.Remove only removes empty directories, so a file that appeared
+	// since planning is never lost
+	for _, dir := range p.Rmdirs {
+		logging.Debugf("remove directory %s if empty", dir)
+		os.Remove(dir)
+	}
+	return len(p.Moves), nil
+}
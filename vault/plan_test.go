@@ -0,0 +1,102 @@
+package vault
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestPlanMigration tests the operations planned for a migration.
+func TestPlanMigration(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := NewWithLayout(tmpDir, LayoutNested)
+	if err != nil {
+		t.Fatalf("NewWithLayout() failed: %v", err)
+	}
+	for _, date := range []string{"2023-12-31", "2024-01-15", "2024-02-01"} {
+		if err := vault.CreateEntry(date); err != nil {
+			t.Fatalf("CreateEntry() failed: %v", err)
+		}
+	}
+	// A stray file keeps its month directory alive
+	os.WriteFile(filepath.Join(tmpDir, "2024", "02", "notes.txt"), []byte("keep"), 0644)
+
+	plan, err := vault.PlanMigration(LayoutFlat)
+	if err != nil {
+		t.Fatalf("PlanMigration() failed: %v", err)
+	}
+
+	expected := Plan{
+		Moves: []Move{
+			{From: filepath.Join(tmpDir, "2024", "02", "2024-02-01.md"), To: filepath.Join(tmpDir, "2024-02-01.md")},
+			{From: filepath.Join(tmpDir, "2024", "01", "2024-01-15.md"), To: filepath.Join(tmpDir, "2024-01-15.md")},
+			{From: filepath.Join(tmpDir, "2023", "12", "2023-12-31.md"), To: filepath.Join(tmpDir, "2023-12-31.md")},
+		},
+		Rmdirs: []string{
+			filepath.Join(tmpDir, "2024", "01"),
+			filepath.Join(tmpDir, "2023", "12"),
+			filepath.Join(tmpDir, "2023"),
+		},
+	}
+	if !reflect.DeepEqual(plan, expected) {
+		t.Errorf("Expected plan %+v, got %+v", expected, plan)
+	}
+
+	// Planning touches nothing
+	if !vault.EntryExists("2024-01-15") {
+		t.Error("Expected PlanMigration() to leave entries in place")
+	}
+
+	// The migration does exactly what was planned
+	if _, err := vault.MigrateTo(LayoutFlat); err != nil {
+		t.Fatalf("MigrateTo() failed: %v", err)
+	}
+	for _, dir := range expected.Rmdirs {
+		if _, err := os.Stat(dir); !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be removed", dir)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "2024", "02", "notes.txt")); err != nil {
+		t.Errorf("Expected the stray file to stay: %v", err)
+	}
+}
+
+// TestPlanMove tests the operations planned for moving one entry.
+func TestPlanMove(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := NewWithLayout(tmpDir, LayoutNested)
+	if err != nil {
+		t.Fatalf("NewWithLayout() failed: %v", err)
+	}
+	if err := vault.CreateEntry("2024-01-31"); err != nil {
+		t.Fatalf("CreateEntry() failed: %v", err)
+	}
+
+	plan, err := vault.PlanMove("2024-01-31", "2024-02-01")
+	if err != nil {
+		t.Fatalf("PlanMove() failed: %v", err)
+	}
+	expected := Plan{
+		Mkdirs: []string{filepath.Join(tmpDir, "2024", "02")},
+		Moves:  []Move{{From: vault.DatePath("2024-01-31"), To: vault.DatePath("2024-02-01")}},
+		Rmdirs: []string{filepath.Join(tmpDir, "2024", "01")},
+	}
+	if !reflect.DeepEqual(plan, expected) {
+		t.Errorf("Expected plan %+v, got %+v", expected, plan)
+	}
+
+	if _, err := vault.PlanMove("2024-01-30", "2024-02-01"); err == nil {
+		t.Error("Expected error planning a move of a missing entry")
+	}
+}
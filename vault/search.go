@@ -0,0 +1,43 @@
+package vault
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Match is a single matching line found when searching entries.
+type Match struct {
+	// Date is the entry date (YYYY-MM-DD)
+	Date string `json:"date"`
+	// Line is the 1-based line number within the entry
+	Line int `json:"line"`
+	// Text is the full matching line without its trailing newline
+	Text string `json:"text"`
+}
+
+// Grep returns every line matching pattern across all entries, newest entry
+// first and in line order within an entry.
+// Learn: A compiled *regexp.Regexp is safe to reuse across many inputs.
+// See: https://pkg.go.dev/regexp#Regexp
+func (v *Vault) Grep(pattern *regexp.Regexp) ([]Match, error) {
+	var matches []Match
+	err := v.WalkEntries(func(entry EntryInfo) error {
+		content, err := v.ReadEntry(entry.Date)
+		if err != nil {
+			return err
+		}
+
+		for i, line := range strings.Split(string(content), "\n") {
+			line = strings.TrimSuffix(line, "\r")
+			if pattern.MatchString(line) {
+				matches = append(matches, Match{Date: entry.Date, Line: i + 1, Text: line})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
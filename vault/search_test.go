@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"os"
+	"regexp"
+	"testing"
+)
+
+// TestGrep verifies matches are reported with dates and line numbers.
+func TestGrep(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	entries := map[string]string{
+		"2024-01-01": "# Monday\n\nRan 5 km\nMeeting at noon\r\n",
+		"2024-01-02": "# Tuesday\n\nRan 10 km\n",
+		"2024-01-03": "# Wednesday\n\nRest day\n",
+	}
+	for date, content := range entries {
+		if err := vault.WriteEntry(date, []byte(content)); err != nil {
+			t.Fatalf("Failed to write entry: %v", err)
+		}
+	}
+
+	matches, err := vault.Grep(regexp.MustCompile(`\d+ km|^Meeting`))
+	if err != nil {
+		t.Fatalf("Grep() failed: %v", err)
+	}
+
+	expected := []Match{
+		{Date: "2024-01-02", Line: 3, Text: "Ran 10 km"},
+		{Date: "2024-01-01", Line: 3, Text: "Ran 5 km"},
+		{Date: "2024-01-01", Line: 4, Text: "Meeting at noon"},
+	}
+	if len(matches) != len(expected) {
+		t.Fatalf("Expected %d matches, got %d: %+v", len(expected), len(matches), matches)
+	}
+	for i, match := range matches {
+		if match != expected[i] {
+			t.Errorf("Match %d: expected %+v, got %+v", i, expected[i], match)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"sort"
+	"time"
+)
+
+// CurrentStreak returns the number of consecutive days with an entry ending at
+// the given day. If that day has no entry yet, the streak is counted from the
+// day before so an unfinished today does not reset it.
+// Learn: time.AddDate handles month and year boundaries for calendar arithmetic.
+// See: https://pkg.go.dev/time#Time.AddDate
+func CurrentStreak(dates map[string]bool, today time.Time) int {
+	day := today
+	if !dates[day.Format("2006-01-02")] {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	streak := 0
+	for dates[day.Format("2006-01-02")] {
+		streak++
+		day = day.AddDate(0, 0, -1)
+	}
+
+	return streak
+}
+
+// LongestStreak returns the length of the longest run of consecutive days
+// with an entry. Dates that don't parse as YYYY-MM-DD are ignored.
+// Learn: Parsing in UTC keeps day arithmetic free of daylight-saving shifts.
+// See: https://pkg.go.dev/time#Parse
+func LongestStreak(dates map[string]bool) int {
+	days := make([]time.Time, 0, len(dates))
+	for date, ok := range dates {
+		if !ok {
+			continue
+		}
+		if day, err := time.Parse(DefaultDateFormat, date); err == nil {
+			days = append(days, day)
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	longest, run := 0, 0
+	for i, day := range days {
+		if i > 0 && days[i-1].AddDate(0, 0, 1).Equal(day) {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+
+	return longest
+}
@@ -0,0 +1,105 @@
+package vault
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+// TestExistingDates verifies that existing entries are returned as a date set.
+func TestExistingDates(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	for _, date := range []string{"2024-01-01", "2024-01-03"} {
+		if err := vault.WriteEntry(date, []byte("# "+date)); err != nil {
+			t.Fatalf("Failed to write entry %s: %v", date, err)
+		}
+	}
+
+	dates, err := vault.ExistingDates()
+	if err != nil {
+		t.Fatalf("ExistingDates() failed: %v", err)
+	}
+
+	if len(dates) != 2 {
+		t.Errorf("Expected 2 dates, got %d", len(dates))
+	}
+
+	if !dates["2024-01-01"] || !dates["2024-01-03"] {
+		t.Errorf("Expected both written dates in set, got %v", dates)
+	}
+
+	if dates["2024-01-02"] {
+		t.Error("Date without an entry should not be in set")
+	}
+}
+
+// TestCurrentStreak verifies streak counting around today.
+func TestCurrentStreak(t *testing.T) {
+	today := time.Date(2024, 3, 2, 12, 0, 0, 0, time.Local)
+
+	testCases := []struct {
+		name     string
+		dates    []string
+		expected int
+	}{
+		{"NoEntries", nil, 0},
+		{"TodayOnly", []string{"2024-03-02"}, 1},
+		{"AcrossMonthBoundary", []string{"2024-02-28", "2024-02-29", "2024-03-01", "2024-03-02"}, 4},
+		{"TodayMissingKeepsStreak", []string{"2024-02-29", "2024-03-01"}, 2},
+		{"GapBreaksStreak", []string{"2024-02-27", "2024-03-01", "2024-03-02"}, 2},
+		{"YesterdayMissing", []string{"2024-02-29"}, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dates := make(map[string]bool)
+			for _, date := range tc.dates {
+				dates[date] = true
+			}
+
+			if streak := CurrentStreak(dates, today); streak != tc.expected {
+				t.Errorf("CurrentStreak() = %d, expected %d", streak, tc.expected)
+			}
+		})
+	}
+}
+
+// TestLongestStreak verifies runs are found across gaps and leap days.
+func TestLongestStreak(t *testing.T) {
+	testCases := []struct {
+		name     string
+		dates    []string
+		expected int
+	}{
+		{"NoEntries", nil, 0},
+		{"SingleDay", []string{"2024-01-01"}, 1},
+		{"LeapDay", []string{"2024-02-28", "2024-02-29", "2024-03-01"}, 3},
+		{"NonLeapYear", []string{"2023-02-27", "2023-02-28", "2023-03-01"}, 3},
+		{"AcrossYearEnd", []string{"2023-12-31", "2024-01-01"}, 2},
+		{"LongerEarlierRun", []string{"2024-01-01", "2024-01-02", "2024-01-03", "2024-01-10", "2024-01-11"}, 3},
+		{"InvalidIgnored", []string{"notes", "2024-01-01", "2024-01-02"}, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dates := make(map[string]bool)
+			for _, date := range tc.dates {
+				dates[date] = true
+			}
+
+			if streak := LongestStreak(dates); streak != tc.expected {
+				t.Errorf("LongestStreak() = %d, expected %d", streak, tc.expected)
+			}
+		})
+	}
+}
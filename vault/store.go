@@ -0,0 +1,38 @@
+package vault
+
+// Store is the set of entry operations shared by the filesystem Vault and
+// the in-memory Memory store. Code that only reads, writes and lists
+// entries should accept a Store so it can be tested without a temp
+// directory; operations tied to files on disk, such as encryption,
+// backups and layout migration, stay on *Vault.
+// Learn: Small interfaces defined next to their implementations let callers swap them.
+// See: https://go.dev/doc/effective_go#interfaces
+type Store interface {
+	// DatePath returns where the entry for date (YYYY-MM-DD) is kept
+	DatePath(date string) string
+	// EntryExists reports whether there is an entry for date
+	EntryExists(date string) bool
+	// ReadEntry returns the entry for date, or an error wrapping
+	// ErrEntryNotFound when there is none
+	ReadEntry(date string) ([]byte, error)
+	// WriteEntry creates or replaces the entry for date
+	WriteEntry(date string, content []byte) error
+	// CreateEntry starts the entry for date from the template, failing if
+	// it already exists
+	CreateEntry(date string) error
+	// AppendToEntry adds content to the end of the entry for date,
+	// creating it first if needed
+	AppendToEntry(date string, content []byte) error
+	// ListEntries returns entry filenames as YYYY-MM-DD.md, newest first
+	ListEntries() ([]string, error)
+	// ListEntriesInfo returns metadata for every entry, newest first
+	ListEntriesInfo() ([]EntryInfo, error)
+	// ExistingDates returns the set of dates that have an entry
+	ExistingDates() (map[string]bool, error)
+}
+
+// Both implementations are checked against Store at compile time
+var (
+	_ Store = (*Vault)(nil)
+	_ Store = (*Memory)(nil)
+)
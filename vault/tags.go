@@ -0,0 +1,91 @@
+package vault
+
+import (
+	"regexp"
+	"strings"
+)
+
+// tagPattern matches #hashtags that start a word. The preceding character
+// must not be part of a word, URL fragment or HTML entity, and the tag must
+// start with a letter so "#1" or "&#39;" are not treated as tags.
+// Learn: regexp in Go uses RE2 syntax, which has no lookbehind, so the
+// leading boundary is captured as its own group instead.
+// See: https://pkg.go.dev/regexp/syntax
+var tagPattern = regexp.MustCompile(`(^|[^\w&/#])#([A-Za-z][\w-]*)`)
+
+// headingPattern matches ATX heading lines such as "# Title" or "##".
+var headingPattern = regexp.MustCompile(`^#{1,6}(\s|$)`)
+
+// inlineCodePattern matches inline code spans so their contents are ignored.
+var inlineCodePattern = regexp.MustCompile("`[^`]*`")
+
+// ExtractTags returns the unique, lowercased #tags in markdown content in the
+// order they first appear. Headings, fenced code blocks and inline code are
+// skipped so "# Heading" and "#include" in code are never reported as tags.
+func ExtractTags(content []byte) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	inFence := false
+
+	for _, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		// Toggle fenced code blocks on ``` or ~~~ delimiters
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence || headingPattern.MatchString(trimmed) {
+			continue
+		}
+
+		line = inlineCodePattern.ReplaceAllString(line, "")
+		for _, match := range tagPattern.FindAllStringSubmatch(line, -1) {
+			tag := strings.ToLower(match[2])
+			if !seen[tag] {
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	return tags
+}
+
+// EntriesByTag returns the dates (YYYY-MM-DD) of entries containing the given
+// tag, newest first. The tag is matched case-insensitively, with or without
+// its leading '#'.
+func (v *Vault) EntriesByTag(tag string) ([]string, error) {
+	tag = strings.ToLower(strings.TrimPrefix(tag, "#"))
+
+	index, err := v.TagIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	return index[tag], nil
+}
+
+// TagIndex maps every tag in the vault to the dates of entries using it.
+// Dates for each tag are ordered newest first.
+// Learn: Building an index once is cheaper than rescanning files per query.
+func (v *Vault) TagIndex() (map[string][]string, error) {
+	filenames, err := v.ListEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string][]string)
+	for _, filename := range filenames {
+		date := strings.TrimSuffix(filename, ".md")
+		content, err := v.ReadEntry(date)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range ExtractTags(content) {
+			index[tag] = append(index[tag], date)
+		}
+	}
+
+	return index, nil
+}
@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestExtractTags verifies hashtag detection and the cases that must be ignored.
+func TestExtractTags(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{"Empty", "", nil},
+		{"SimpleTags", "Worked on #logmd and #Go today. More #go later.", []string{"logmd", "go"}},
+		{"HeadingIsNotTag", "# Heading\n\n## Sub heading #notatag\n\nBody #real", []string{"real"}},
+		{"LineStartTag", "#morning pages", []string{"morning"}},
+		{"FencedCodeIgnored", "```c\n#include <stdio.h>\n```\n~~~\n#define X\n~~~\nafter #tag", []string{"tag"}},
+		{"InlineCodeIgnored", "Use `#pragma` here #note", []string{"note"}},
+		{"URLsAndEntitiesIgnored", "See http://x.com/page#section and &#39; and a#b", nil},
+		{"NumbersIgnored", "Issue #123 and #2024", nil},
+		{"HyphensAndUnderscores", "#deep-work and #side_project.", []string{"deep-work", "side_project"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tags := ExtractTags([]byte(tc.content))
+			if strings.Join(tags, ",") != strings.Join(tc.expected, ",") {
+				t.Errorf("ExtractTags(%q) = %v, expected %v", tc.content, tags, tc.expected)
+			}
+		})
+	}
+}
+
+// TestEntriesByTag verifies tag lookups across the vault.
+func TestEntriesByTag(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	entries := map[string]string{
+		"2024-01-01": "# Work\n\nShipped #release notes. #work",
+		"2024-01-02": "# Rest\n\nA quiet #weekend.",
+		"2024-01-03": "# Work again\n\nBack to #Work.",
+	}
+	for date, content := range entries {
+		if err := vault.WriteEntry(date, []byte(content)); err != nil {
+			t.Fatalf("Failed to write entry %s: %v", date, err)
+		}
+	}
+
+	dates, err := vault.EntriesByTag("#WORK")
+	if err != nil {
+		t.Fatalf("EntriesByTag() failed: %v", err)
+	}
+	if strings.Join(dates, ",") != "2024-01-03,2024-01-01" {
+		t.Errorf("Expected [2024-01-03 2024-01-01], got %v", dates)
+	}
+
+	// The "# Work" headings must not create a tag called "work" on their own
+	dates, err = vault.EntriesByTag("rest")
+	if err != nil {
+		t.Fatalf("EntriesByTag() failed: %v", err)
+	}
+	if len(dates) != 0 {
+		t.Errorf("Heading text should not be indexed as a tag, got %v", dates)
+	}
+
+	index, err := vault.TagIndex()
+	if err != nil {
+		t.Fatalf("TagIndex() failed: %v", err)
+	}
+	if len(index) != 3 {
+		t.Errorf("Expected 3 tags in index, got %v", index)
+	}
+}
@@ -0,0 +1,210 @@
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"logmd/logging"
+)
+
+// TrashDirName is the hidden directory inside the vault where DeleteEntry
+// moves entries, so a delete can be undone with RestoreEntry. Entry
+// listings never look inside hidden directories.
+const TrashDirName = ".trash"
+
+// trashTimeFormat is the timestamp suffix of trashed files, e.g.
+// "2024-01-15.20240116-093000.250.md" for an entry deleted at 9:30am.
+const trashTimeFormat = "20060102-150405.000"
+
+// TrashItem is an entry file waiting in the trash.
+type TrashItem struct {
+	// Date is the entry's date in YYYY-MM-DD format
+	Date string
+	// DeletedAt is when the entry was moved to the trash
+	DeletedAt time.Time
+	// Path is the absolute path of the trashed file
+	Path string
+	// Size is the file size in bytes
+	Size int64
+}
+
+// TrashDir returns the absolute path of the vault's trash directory.
+func (v *Vault) TrashDir() string {
+	return filepath.Join(v.Directory, TrashDirName)
+}
+
+// DeleteEntry deletes the entry for date. The file is moved into TrashDir
+// with a timestamp suffix, or removed for good when DeletePermanently is
+// set. It returns an error wrapping ErrEntryNotFound when there is no entry.
+// Learn: os.Rename is atomic within a filesystem, so a file is never half moved.
+// See: https://pkg.go.dev/os#Rename
+func (v *Vault) DeleteEntry(date string) error {
+	plan, err := v.PlanDelete(date)
+	if err != nil {
+		return err
+	}
+
+	if _, err := plan.apply(); err != nil {
+		return fmt.Errorf("failed to delete entry %s: %w", date, err)
+	}
+	return nil
+}
+
+// PlanDelete returns the plan DeleteEntry would carry out for date: a move
+// into TrashDir, creating it if needed, or a removal when DeletePermanently
+// is set. It fails with ErrEntryNotFound like DeleteEntry.
+func (v *Vault) PlanDelete(date string) (Plan, error) {
+	path := v.DatePath(date)
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return Plan{}, fmt.Errorf("%w: %s", ErrEntryNotFound, date)
+	}
+
+	if v.DeletePermanently {
+		return Plan{Removes: []string{path}}, nil
+	}
+
+	var plan Plan
+	if _, err := os.Stat(v.TrashDir()); errors.Is(err, fs.ErrNotExist) {
+		plan.Mkdirs = []string{v.TrashDir()}
+	}
+	now := time.Now()
+	if v.Location != nil {
+		now = now.In(v.Location)
+	}
+	// An earlier copy deleted in the same millisecond keeps its own file
+	trashPath := v.trashPath(date, now)
+	for {
+		if _, err := os.Stat(trashPath); errors.Is(err, fs.ErrNotExist) {
+			break
+		}
+		now = now.Add(time.Millisecond)
+		trashPath = v.trashPath(date, now)
+	}
+	plan.Moves = []Move{{From: path, To: trashPath}}
+	return plan, nil
+}
+
+// trashPath returns where the entry for date deleted at deletedAt is kept.
+func (v *Vault) trashPath(date string, deletedAt time.Time) string {
+	return filepath.Join(v.TrashDir(), fmt.Sprintf("%s.%s.md", date, deletedAt.Format(trashTimeFormat)))
+}
+
+// Trash returns the entries in the trash, most recently deleted first.
+// Files in the trash directory that DeleteEntry didn't put there are
+// skipped, and a missing trash directory is an empty trash.
+func (v *Vault) Trash() ([]TrashItem, error) {
+	files, err := os.ReadDir(v.TrashDir())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trash directory: %w", err)
+	}
+
+	var items []TrashItem
+	for _, file := range files {
+		item, ok := v.parseTrashName(file.Name())
+		if !ok || file.IsDir() {
+			continue
+		}
+		if info, err := file.Info(); err == nil {
+			item.Size = info.Size()
+		}
+		items = append(items, item)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if !items[i].DeletedAt.Equal(items[j].DeletedAt) {
+			return items[i].DeletedAt.After(items[j].DeletedAt)
+		}
+		return items[i].Date > items[j].Date
+	})
+	return items, nil
+}
+
+// parseTrashName splits a trashed filename into its date and deletion time.
+func (v *Vault) parseTrashName(name string) (TrashItem, bool) {
+	// Dates contain no dots, so the first one ends the date
+	date, stamp, ok := strings.Cut(strings.TrimSuffix(name, ".md"), ".")
+	if !ok || !strings.HasSuffix(name, ".md") {
+		return TrashItem{}, false
+	}
+	if _, err := time.Parse(DefaultDateFormat, date); err != nil {
+		return TrashItem{}, false
+	}
+	location := v.Location
+	if location == nil {
+		location = time.Local
+	}
+	deletedAt, err := time.ParseInLocation(trashTimeFormat, stamp, location)
+	if err != nil {
+		return TrashItem{}, false
+	}
+	return TrashItem{Date: date, DeletedAt: deletedAt, Path: filepath.Join(v.TrashDir(), name)}, true
+}
+
+// RestoreEntry moves the most recently deleted copy of the entry for date
+// out of the trash and back into place, returning what was restored. It
+// refuses to overwrite an entry written for that date since the delete.
+func (v *Vault) RestoreEntry(date string) (TrashItem, error) {
+	items, err := v.Trash()
+	if err != nil {
+		return TrashItem{}, err
+	}
+
+	for _, item := range items {
+		if item.Date != date {
+			continue
+		}
+		path := v.DatePath(date)
+		if _, err := os.Stat(path); err == nil {
+			return TrashItem{}, fmt.Errorf("entry %s already exists; move or delete it before restoring", date)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return TrashItem{}, fmt.Errorf("failed to create directory for entry %s: %w", date, err)
+		}
+		logging.Debugf("move %s to %s", item.Path, path)
+		if err := os.Rename(item.Path, path); err != nil {
+			return TrashItem{}, fmt.Errorf("failed to restore entry %s: %w", date, err)
+		}
+		return item, nil
+	}
+	return TrashItem{}, fmt.Errorf("no deleted entry for %s in the trash", date)
+}
+
+// EmptyTrash permanently removes the deleted entries in the trash, returning
+// how many were removed. The trash directory goes too once it is empty;
+// files DeleteEntry didn't put there are left alone.
+func (v *Vault) EmptyTrash() (int, error) {
+	plan, err := v.PlanEmptyTrash()
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := plan.apply(); err != nil {
+		return 0, fmt.Errorf("failed to empty trash: %w", err)
+	}
+	return len(plan.Removes), nil
+}
+
+// PlanEmptyTrash returns the plan EmptyTrash would carry out: removing each
+// trashed entry, then the trash directory if nothing else is left in it. An
+// empty trash has an empty plan.
+func (v *Vault) PlanEmptyTrash() (Plan, error) {
+	items, err := v.Trash()
+	if err != nil || len(items) == 0 {
+		return Plan{}, err
+	}
+
+	plan := Plan{Rmdirs: []string{v.TrashDir()}}
+	for _, item := range items {
+		plan.Removes = append(plan.Removes, item.Path)
+	}
+	return plan, nil
+}
@@ -0,0 +1,235 @@
+package vault
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestDeleteAndRestoreEntry tests moving entries to the trash and back.
+func TestDeleteAndRestoreEntry(t *testing.T) {
+	for _, layout := range []Layout{LayoutFlat, LayoutNested} {
+		t.Run(string(layout), func(t *testing.T) {
+			tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+			if err != nil {
+				t.Fatalf("Failed to create temp dir: %v", err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			vault, err := NewWithLayout(tmpDir, layout)
+			if err != nil {
+				t.Fatalf("NewWithLayout() failed: %v", err)
+			}
+			for _, date := range []string{"2024-01-15", "2024-01-16"} {
+				if err := vault.WriteEntry(date, []byte("# "+date+"\n")); err != nil {
+					t.Fatalf("Failed to write entry: %v", err)
+				}
+			}
+
+			if err := vault.DeleteEntry("2024-01-15"); err != nil {
+				t.Fatalf("DeleteEntry() failed: %v", err)
+			}
+			if vault.EntryExists("2024-01-15") {
+				t.Error("Expected the deleted entry to be gone")
+			}
+
+			// The trash is left out of every listing
+			entries, err := vault.ListEntries()
+			if err != nil || len(entries) != 1 {
+				t.Errorf("Expected 1 listed entry besides the trash, got %v, %v", entries, err)
+			}
+			recursive, err := vault.ListEntriesRecursive()
+			if err != nil || len(recursive) != 1 {
+				t.Errorf("Expected 1 entry found recursively, got %v, %v", recursive, err)
+			}
+
+			items, err := vault.Trash()
+			if err != nil || len(items) != 1 || items[0].Date != "2024-01-15" {
+				t.Fatalf("Expected the entry in the trash, got %+v, %v", items, err)
+			}
+			if filepath.Dir(items[0].Path) != vault.TrashDir() || items[0].Size == 0 {
+				t.Errorf("Unexpected trash item %+v", items[0])
+			}
+
+			// A new entry for the date blocks restoring over it
+			if err := vault.WriteEntry("2024-01-15", []byte("# Rewritten\n")); err != nil {
+				t.Fatalf("Failed to write entry: %v", err)
+			}
+			if _, err := vault.RestoreEntry("2024-01-15"); err == nil || !strings.Contains(err.Error(), "already exists") {
+				t.Errorf("Expected restoring over an entry to fail, got %v", err)
+			}
+
+			// Deleting the same date again keeps both copies, and restore
+			// brings back the latest
+			if err := vault.DeleteEntry("2024-01-15"); err != nil {
+				t.Fatalf("DeleteEntry() failed: %v", err)
+			}
+			if items, _ := vault.Trash(); len(items) != 2 {
+				t.Fatalf("Expected 2 trashed copies, got %d", len(items))
+			}
+			if _, err := vault.RestoreEntry("2024-01-15"); err != nil {
+				t.Fatalf("RestoreEntry() failed: %v", err)
+			}
+			content, err := vault.ReadEntry("2024-01-15")
+			if err != nil || string(content) != "# Rewritten\n" {
+				t.Errorf("Expected the latest copy restored, got %q, %v", content, err)
+			}
+
+			if _, err := vault.RestoreEntry("2024-01-20"); err == nil {
+				t.Error("Expected an error restoring an entry that isn't in the trash")
+			}
+			if err := vault.DeleteEntry("2024-01-20"); !errors.Is(err, ErrEntryNotFound) {
+				t.Errorf("Expected ErrEntryNotFound, got %v", err)
+			}
+
+			removed, err := vault.EmptyTrash()
+			if err != nil || removed != 1 {
+				t.Errorf("Expected 1 entry removed, got %d, %v", removed, err)
+			}
+			if items, err := vault.Trash(); err != nil || len(items) != 0 {
+				t.Errorf("Expected an empty trash, got %+v, %v", items, err)
+			}
+		})
+	}
+}
+
+// TestDeleteEntryPermanently tests deleting without the trash.
+func TestDeleteEntryPermanently(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	vault.DeletePermanently = true
+	if err := vault.WriteEntry("2024-01-15", []byte("# Gone\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	if err := vault.DeleteEntry("2024-01-15"); err != nil {
+		t.Fatalf("DeleteEntry() failed: %v", err)
+	}
+	if vault.EntryExists("2024-01-15") {
+		t.Error("Expected the entry to be removed")
+	}
+	if _, err := os.Stat(vault.TrashDir()); !os.IsNotExist(err) {
+		t.Error("Expected no trash directory")
+	}
+}
+
+// TestPlanDelete tests the plans printed for delete and trash empty.
+func TestPlanDelete(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	if err := vault.WriteEntry("2024-01-15", []byte("# Kept\n")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	if _, err := vault.PlanDelete("2024-02-01"); !errors.Is(err, ErrEntryNotFound) {
+		t.Errorf("Expected ErrEntryNotFound, got %v", err)
+	}
+
+	// Trashing creates the trash and moves the file into it
+	plan, err := vault.PlanDelete("2024-01-15")
+	if err != nil {
+		t.Fatalf("PlanDelete() failed: %v", err)
+	}
+	if len(plan.Mkdirs) != 1 || plan.Mkdirs[0] != vault.TrashDir() {
+		t.Errorf("Expected the trash directory to be created, got %v", plan.Mkdirs)
+	}
+	if len(plan.Moves) != 1 || plan.Moves[0].From != vault.DatePath("2024-01-15") || filepath.Dir(plan.Moves[0].To) != vault.TrashDir() {
+		t.Errorf("Expected a move into the trash, got %+v", plan.Moves)
+	}
+
+	vault.DeletePermanently = true
+	plan, err = vault.PlanDelete("2024-01-15")
+	if err != nil {
+		t.Fatalf("PlanDelete() failed: %v", err)
+	}
+	if len(plan.Moves) != 0 || len(plan.Removes) != 1 || plan.Removes[0] != vault.DatePath("2024-01-15") {
+		t.Errorf("Expected only a removal, got %+v", plan)
+	}
+	if !vault.EntryExists("2024-01-15") {
+		t.Error("Expected planning to leave the entry alone")
+	}
+
+	// Emptying removes each trashed file, then the trash
+	if plan, err := vault.PlanEmptyTrash(); err != nil || len(plan.Removes) != 0 {
+		t.Errorf("Expected an empty plan for an empty trash, got %+v, %v", plan, err)
+	}
+	vault.DeletePermanently = false
+	if err := vault.DeleteEntry("2024-01-15"); err != nil {
+		t.Fatalf("DeleteEntry() failed: %v", err)
+	}
+	plan, err = vault.PlanEmptyTrash()
+	if err != nil {
+		t.Fatalf("PlanEmptyTrash() failed: %v", err)
+	}
+	if len(plan.Removes) != 1 || len(plan.Rmdirs) != 1 || plan.Rmdirs[0] != vault.TrashDir() {
+		t.Errorf("Expected the trashed file and the trash to be removed, got %+v", plan)
+	}
+}
+
+// TestTrashSkipsOtherFiles tests that only trashed entries are listed.
+func TestTrashSkipsOtherFiles(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	os.MkdirAll(vault.TrashDir(), 0700)
+	for _, name := range []string{"notes.txt", "2024-01-15.md", "2024-01-15.yesterday.md", "2024-01-15.20240116-093000.250.md"} {
+		os.WriteFile(filepath.Join(vault.TrashDir(), name), []byte("x"), 0644)
+	}
+
+	items, err := vault.Trash()
+	if err != nil {
+		t.Fatalf("Trash() failed: %v", err)
+	}
+	if len(items) != 1 || items[0].DeletedAt.Format("2006-01-02 15:04:05") != "2024-01-16 09:30:00" {
+		t.Errorf("Expected only the timestamped entry, got %+v", items)
+	}
+
+	// Emptying removes just what the plan lists, keeping the other files
+	plan, err := vault.PlanEmptyTrash()
+	if err != nil {
+		t.Fatalf("PlanEmptyTrash() failed: %v", err)
+	}
+	if len(plan.Removes) != 1 || plan.Removes[0] != items[0].Path {
+		t.Errorf("Expected only the trashed entry in the plan, got %+v", plan)
+	}
+	removed, err := vault.EmptyTrash()
+	if err != nil {
+		t.Fatalf("EmptyTrash() failed: %v", err)
+	}
+	if removed != 1 {
+		t.Errorf("Expected 1 entry removed, got %d", removed)
+	}
+	if _, err := os.Stat(items[0].Path); !os.IsNotExist(err) {
+		t.Errorf("Expected the trashed entry removed, got %v", err)
+	}
+	for _, name := range []string{"notes.txt", "2024-01-15.md", "2024-01-15.yesterday.md"} {
+		if _, err := os.Stat(filepath.Join(vault.TrashDir(), name)); err != nil {
+			t.Errorf("Expected %s to survive emptying the trash: %v", name, err)
+		}
+	}
+}
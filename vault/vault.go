@@ -7,20 +7,94 @@
 package vault
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"time"
+
+	"logmd/logging"
 )
 
+// DefaultDateFormat is the time layout used for entry filenames (YYYY-MM-DD).
+const DefaultDateFormat = "2006-01-02"
+
 // Vault represents a journal directory with its path and configuration.
 // Learn: Struct types should have clear, descriptive names and documented fields.
 // See: https://go.dev/doc/effective_go#names
 type Vault struct {
 	// Directory is the absolute path to the journal's root directory
 	Directory string
+	// Layout controls where entry files live inside Directory
+	Layout Layout
+	// Passphrase, when set, encrypts entries on write and decrypts them on read
+	Passphrase string
+	// DateFormat is the Go time layout of entry filenames; empty uses
+	// DefaultDateFormat. Dates passed to and returned by the vault are
+	// always YYYY-MM-DD whatever the filenames look like
+	DateFormat string
+	// FrontMatter lists the YAML front matter fields CreateEntry starts new
+	// entries with; nil writes no front matter
+	FrontMatter []string
+	// Location is the timezone whose date TodayPath, TodayExists and
+	// CreateTodayEntry use; nil uses local time
+	Location *time.Location
+	// DayStartHour is the hour a new day starts for those methods, so
+	// before it today is still the previous date
+	DayStartHour int
+	// DeletePermanently makes DeleteEntry remove files instead of moving
+	// them to the trash
+	DeletePermanently bool
+}
+
+// ParseDateFormat checks a Go time layout for entry filenames, returning
+// DefaultDateFormat for an empty one. The layout must keep the year, month
+// and day so each date gets its own filename that parses back to it, and
+// it can't contain path separators.
+// Learn: Formatting a sample date and parsing it back shows what a layout keeps.
+// See: https://pkg.go.dev/time#pkg-constants
+func ParseDateFormat(format string) (string, error) {
+	if format == "" {
+		return DefaultDateFormat, nil
+	}
+	if strings.ContainsAny(format, `/\`) {
+		return "", fmt.Errorf("invalid date format %q (filenames can't contain path separators)", format)
+	}
+
+	sample := time.Date(2024, time.November, 23, 0, 0, 0, 0, time.UTC)
+	parsed, err := time.Parse(format, sample.Format(format))
+	if err != nil || !parsed.Equal(sample) {
+		return "", fmt.Errorf("invalid date format %q (needs a year, month and day, e.g. 2006_01_02)", format)
+	}
+	return format, nil
+}
+
+// Layout describes how entry files are arranged in the vault directory.
+type Layout string
+
+const (
+	// LayoutFlat stores every entry directly in the vault directory (the default)
+	LayoutFlat Layout = "flat"
+	// LayoutNested stores entries in year/month subdirectories, e.g. 2024/01/2024-01-15.md
+	LayoutNested Layout = "nested"
+)
+
+// ParseLayout converts a configured layout name to a Layout.
+// An empty name selects LayoutFlat for backward compatibility.
+func ParseLayout(name string) (Layout, error) {
+	switch Layout(name) {
+	case "", LayoutFlat:
+		return LayoutFlat, nil
+	case LayoutNested:
+		return LayoutNested, nil
+	default:
+		return "", fmt.Errorf("invalid layout %q (expected flat or nested)", name)
+	}
 }
 
 // EntryInfo contains metadata about a journal entry.
@@ -28,48 +102,155 @@ type Vault struct {
 // See: https://go.dev/tour/moretypes/2
 type EntryInfo struct {
 	// Date is the entry date in YYYY-MM-DD format
-	Date string
+	Date string `json:"date"`
 	// Path is the absolute file path to the entry
-	Path string
+	Path string `json:"path"`
 	// Exists indicates whether the file exists on disk
-	Exists bool
+	Exists bool `json:"exists"`
 	// Size is the file size in bytes (0 if file doesn't exist)
-	Size int64
+	Size int64 `json:"size"`
 	// ModTime is the last modification time
-	ModTime time.Time
+	ModTime time.Time `json:"mod_time"`
+}
+
+// ErrNoDirectory is returned by Open when the journal directory is missing.
+var ErrNoDirectory = errors.New("journal directory does not exist")
+
+// ErrEntryNotFound is returned, wrapped with the date, when an entry
+// doesn't exist. Check for it with errors.Is rather than the message.
+var ErrEntryNotFound = errors.New("entry does not exist")
+
+// ErrInvalidDate is wrapped by errors for a date given by the user that
+// isn't in YYYY-MM-DD format, so callers can tell a mistyped date apart
+// from other failures.
+var ErrInvalidDate = errors.New("invalid date format")
+
+// entryNotFoundError reports a missing entry as "entry <date> does not
+// exist" while unwrapping to ErrEntryNotFound.
+type entryNotFoundError struct {
+	date string
+}
+
+func (e *entryNotFoundError) Error() string {
+	return fmt.Sprintf("entry %s does not exist", e.date)
+}
+
+func (e *entryNotFoundError) Unwrap() error {
+	return ErrEntryNotFound
 }
 
 // New creates a new Vault instance with the given directory path.
-// It ensures the directory exists with proper permissions (0700).
+// It ensures the directory exists with proper permissions (0700); use Open
+// where a missing directory is a mistake rather than a new journal.
 // Learn: Constructor functions in Go typically start with "New" and return pointers.
 // See: https://go.dev/doc/effective_go#constructors
 func New(directory string) (*Vault, error) {
+	return NewWithLayout(directory, LayoutFlat)
+}
+
+// NewWithLayout creates a Vault like New that stores entries using layout.
+// An empty layout selects LayoutFlat.
+func NewWithLayout(directory string, layout Layout) (*Vault, error) {
+	layout, err := ParseLayout(string(layout))
+	if err != nil {
+		return nil, err
+	}
+
 	absDir, err := filepath.Abs(directory)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve absolute path: %w", err)
 	}
 
 	// Create directory if it doesn't exist
+	logging.Debugf("ensure directory %s", absDir)
 	if err := os.MkdirAll(absDir, 0700); err != nil {
 		return nil, fmt.Errorf("failed to create directory %s: %w", absDir, err)
 	}
 
-	return &Vault{Directory: absDir}, nil
+	return &Vault{Directory: absDir, Layout: layout}, nil
+}
+
+// Open returns a Vault for an existing directory. Unlike New it never
+// creates anything, so a mistyped path fails with ErrNoDirectory instead
+// of starting an empty journal.
+func Open(directory string) (*Vault, error) {
+	return OpenWithLayout(directory, LayoutFlat)
+}
+
+// OpenWithLayout opens an existing directory like Open that stores entries
+// using layout. An empty layout selects LayoutFlat.
+func OpenWithLayout(directory string, layout Layout) (*Vault, error) {
+	layout, err := ParseLayout(string(layout))
+	if err != nil {
+		return nil, err
+	}
+
+	absDir, err := filepath.Abs(directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve absolute path: %w", err)
+	}
+
+	info, err := os.Stat(absDir)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: %s", ErrNoDirectory, absDir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open directory %s: %w", absDir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", absDir)
+	}
+
+	return &Vault{Directory: absDir, Layout: layout}, nil
 }
 
 // TodayPath returns the file path for today's journal entry.
-// The filename follows the format YYYY-MM-DD.md using local timezone.
+// The filename follows the vault's DateFormat, dated in the vault's Location.
 // Learn: Methods in Go are functions with receiver arguments.
 // See: https://go.dev/tour/methods/1
 func (v *Vault) TodayPath() string {
-	today := time.Now().Format("2006-01-02")
-	return filepath.Join(v.Directory, today+".md")
+	return v.DatePath(v.today())
+}
+
+// today returns today's date (YYYY-MM-DD) in the vault's Location, taking
+// DayStartHour hours off the clock first.
+func (v *Vault) today() string {
+	now := time.Now()
+	if v.Location != nil {
+		now = now.In(v.Location)
+	}
+	return now.Add(-time.Duration(v.DayStartHour) * time.Hour).Format(DefaultDateFormat)
 }
 
 // DatePath returns the file path for a specific date's journal entry.
-// The date string must be in YYYY-MM-DD format.
+// The date string must be in YYYY-MM-DD format; the filename uses the
+// vault's DateFormat.
 func (v *Vault) DatePath(date string) string {
-	return filepath.Join(v.Directory, date+".md")
+	return layoutPath(v.Directory, v.Layout, v.dateFormat(), date)
+}
+
+// dateFormat returns the layout of entry filenames.
+func (v *Vault) dateFormat() string {
+	if v.DateFormat == "" {
+		return DefaultDateFormat
+	}
+	return v.DateFormat
+}
+
+// layoutPath returns where the entry for date lives under directory in the
+// given layout, with a filename written in format. Strings that aren't
+// valid dates are used as the filename as they are, in the flat path.
+func layoutPath(directory string, layout Layout, format, date string) string {
+	day, err := time.Parse(DefaultDateFormat, date)
+	if err != nil {
+		return filepath.Join(directory, date+".md")
+	}
+
+	filename := day.Format(format) + ".md"
+	if layout == LayoutNested {
+		return filepath.Join(directory, day.Format("2006"), day.Format("01"), filename)
+	}
+	return filepath.Join(directory, filename)
 }
 
 // EntryExists checks if a journal entry exists for the given date.
@@ -83,12 +264,14 @@ func (v *Vault) EntryExists(date string) bool {
 
 // TodayExists checks if today's journal entry exists.
 func (v *Vault) TodayExists() bool {
-	today := time.Now().Format("2006-01-02")
-	return v.EntryExists(today)
+	return v.EntryExists(v.today())
 }
 
 // ReadEntry reads the content of a journal entry for the given date.
-// Returns an error if the file doesn't exist or can't be read.
+// Encrypted entries are decrypted with the vault's passphrase; plain entries
+// are returned as they are, so a vault can hold both.
+// Returns an error wrapping ErrEntryNotFound if the file doesn't exist, or
+// another error if it can't be read.
 // Learn: File I/O operations should always handle errors properly.
 // See: https://go.dev/doc/effective_go#errors
 func (v *Vault) ReadEntry(date string) ([]byte, error) {
@@ -96,21 +279,85 @@ func (v *Vault) ReadEntry(date string) ([]byte, error) {
 	content, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("entry %s does not exist", date)
+			return nil, &entryNotFoundError{date: date}
 		}
 		return nil, fmt.Errorf("failed to read entry %s: %w", date, err)
 	}
+
+	if IsEncrypted(content) {
+		if v.Passphrase == "" {
+			return nil, fmt.Errorf("failed to read entry %s: %w", date, ErrEncrypted)
+		}
+		if content, err = decryptContent(v.Passphrase, content); err != nil {
+			return nil, fmt.Errorf("failed to read entry %s: %w", date, err)
+		}
+	}
 	return content, nil
 }
 
 // WriteEntry writes content to a journal entry for the given date.
-// Creates the file if it doesn't exist, overwrites if it does.
+// Creates the file if it doesn't exist, overwrites if it does. With a
+// passphrase set the content is encrypted with AES-GCM before writing.
+// The content is written to a temp file that is renamed into place, so a
+// crash mid-write leaves either the old entry or the new one, never a mix.
+// Learn: os.Rename is atomic when source and target are on the same filesystem.
+// See: https://pkg.go.dev/os#Rename
 func (v *Vault) WriteEntry(date string, content []byte) error {
 	path := v.DatePath(date)
-	err := os.WriteFile(path, content, 0644)
-	if err != nil {
+	// Nested layouts need the year/month directories to exist first
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
 		return fmt.Errorf("failed to write entry %s: %w", date, err)
 	}
+	if v.Passphrase != "" {
+		salt, err := v.salt()
+		if err != nil {
+			return fmt.Errorf("failed to write entry %s: %w", date, err)
+		}
+		if content, err = encryptContent(v.Passphrase, salt, content); err != nil {
+			return fmt.Errorf("failed to encrypt entry %s: %w", date, err)
+		}
+	}
+	if err := writeFileAtomic(path, content, 0644); err != nil {
+		return fmt.Errorf("failed to write entry %s: %w", date, err)
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to a temp file next to path and renames it over
+// path. The temp file is removed if any step fails.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	logging.Debugf("write %s (%d bytes)", path, len(data))
+	// The leading dot and missing .md suffix keep it out of entry listings
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+
+	cleanup := func(err error) error {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		return cleanup(err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return cleanup(err)
+	}
+	// CreateTemp uses 0600, so widen to the usual entry permissions
+	if err := tmp.Chmod(perm); err != nil {
+		return cleanup(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return cleanup(err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
 	return nil
 }
 
@@ -121,15 +368,61 @@ func (v *Vault) CreateEntry(date string) error {
 		return fmt.Errorf("entry %s already exists", date)
 	}
 
-	template := fmt.Sprintf("# %s\n\n", date)
-	return v.WriteEntry(date, []byte(template))
+	return v.WriteEntry(date, []byte(v.entryTemplate(date)))
+}
+
+// entryTemplate returns the content CreateEntry starts a new entry with:
+// any configured front matter followed by a "# date" heading.
+func (v *Vault) entryTemplate(date string) string {
+	return frontMatterTemplate(v.FrontMatter, date) + headingTemplate(date)
+}
+
+// headingTemplate returns the heading every new entry starts with.
+func headingTemplate(date string) string {
+	return fmt.Sprintf("# %s\n\n", date)
+}
+
+// AppendToEntry appends content to the entry for the given date, creating it
+// from the template first if it doesn't exist. A newline is inserted if the
+// existing entry doesn't end with one, and the result always ends with one.
+func (v *Vault) AppendToEntry(date string, content []byte) error {
+	if !v.EntryExists(date) {
+		if err := v.CreateEntry(date); err != nil {
+			return err
+		}
+	}
+
+	existing, err := v.ReadEntry(date)
+	if err != nil {
+		return err
+	}
+
+	updated := existing
+	if len(updated) > 0 && !bytes.HasSuffix(updated, []byte("\n")) {
+		updated = append(updated, '\n')
+	}
+	updated = append(updated, content...)
+	if !bytes.HasSuffix(updated, []byte("\n")) {
+		updated = append(updated, '\n')
+	}
+
+	return v.WriteEntry(date, updated)
+}
+
+// CreateBlankEntry creates a new, completely empty journal entry.
+// Returns an error if the file already exists.
+func (v *Vault) CreateBlankEntry(date string) error {
+	if v.EntryExists(date) {
+		return fmt.Errorf("entry %s already exists", date)
+	}
+
+	return v.WriteEntry(date, []byte{})
 }
 
 // CreateTodayEntry creates today's journal entry with a simple template.
 // Returns an error if today's entry already exists.
 func (v *Vault) CreateTodayEntry() error {
-	today := time.Now().Format("2006-01-02")
-	return v.CreateEntry(today)
+	return v.CreateEntry(v.today())
 }
 
 // GetEntryInfo returns metadata about a journal entry.
@@ -153,59 +446,460 @@ func (v *Vault) GetEntryInfo(date string) EntryInfo {
 }
 
 // ListEntries returns all journal entries sorted by date (newest first).
-// Only returns .md files that match the YYYY-MM-DD.md pattern.
+// Only .md files whose names match the vault's DateFormat are included,
+// and they are returned as YYYY-MM-DD.md whatever the format, so trimming
+// ".md" always gives the date; use DatePath for the real file. With the
+// nested layout the year/month subdirectories are searched instead of the
+// root; names are returned without their directories either way.
 // Learn: Slices in Go are dynamic arrays with length and capacity.
 // See: https://go.dev/blog/slices-intro
 func (v *Vault) ListEntries() ([]string, error) {
-	entries, err := os.ReadDir(v.Directory)
+	files, err := v.readEntryFiles()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read directory %s: %w", v.Directory, err)
+		return nil, err
 	}
 
-	var mdFiles []string
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
+	names := make([]string, len(files))
+	for i, file := range files {
+		names[i] = file.entry.Name()
+	}
+
+	// Non-entry files are filtered out while sorting
+	names = sortEntriesNewestFirst(names, v.dateFormat())
+	for i, name := range names {
+		names[i] = v.entryName(name)
+	}
+	return names, nil
+}
+
+// ListEntriesPage returns at most limit entries starting offset entries
+// into the ListEntries order (newest first), along with the total number of
+// entries, so large vaults can be shown a page at a time. An offset at or
+// past the end gives an empty page rather than an error.
+func (v *Vault) ListEntriesPage(offset, limit int) ([]string, int, error) {
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("invalid page offset %d (must not be negative)", offset)
+	}
+	if limit <= 0 {
+		return nil, 0, fmt.Errorf("invalid page limit %d (must be positive)", limit)
+	}
+
+	names, err := v.ListEntries()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := len(names)
+	if offset >= total {
+		return []string{}, total, nil
+	}
+	end := min(offset+limit, total)
+	return names[offset:end], total, nil
+}
+
+// entryName converts an entry filename written in the vault's DateFormat
+// to the YYYY-MM-DD.md name ListEntries reports.
+func (v *Vault) entryName(filename string) string {
+	if v.dateFormat() == DefaultDateFormat {
+		return filename
+	}
+	date, _ := parseEntryDate(filename, v.dateFormat())
+	return date.Format(DefaultDateFormat) + ".md"
+}
+
+// readEntryFiles reads the directories that hold entries for the vault's
+// layout and returns their files in directory order. The DirEntry values carry
+// the metadata from the directory read, so callers don't need to stat again.
+// See: https://pkg.go.dev/io/fs#DirEntry
+func (v *Vault) readEntryFiles() ([]entryFile, error) {
+	dirs := []string{v.Directory}
+	if v.Layout == LayoutNested {
+		var err error
+		if dirs, err = nestedMonthDirs(v.Directory); err != nil {
+			return nil, err
+		}
+	}
+
+	var files []entryFile
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
 		}
-		name := entry.Name()
-		if strings.HasSuffix(name, ".md") && isValidDateFormat(name) {
-			mdFiles = append(mdFiles, name)
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			files = append(files, entryFile{dir: dir, entry: entry})
 		}
 	}
 
-	// Sort newest first
-	sort.Slice(mdFiles, func(i, j int) bool {
-		return mdFiles[i] > mdFiles[j]
+	return files, nil
+}
+
+// entryFile is a file found by readEntryFiles along with its directory.
+type entryFile struct {
+	dir   string
+	entry fs.DirEntry
+}
+
+// ListEntriesRecursive returns every entry file anywhere under the vault
+// directory as a path relative to it (e.g. "2024/01/2024-01-15.md"),
+// sorted newest first by date. Hidden directories are skipped, as are files
+// whose names don't match the vault's DateFormat.
+// Learn: filepath.WalkDir avoids an os.Lstat call per file, unlike filepath.Walk.
+// See: https://pkg.go.dev/path/filepath#WalkDir
+func (v *Vault) ListEntriesRecursive() ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(v.Directory, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != v.Directory && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(v.Directory, path)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, rel)
+		return nil
 	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk directory %s: %w", v.Directory, err)
+	}
+
+	// Non-entry files are filtered out while sorting
+	return sortEntriesNewestFirst(paths, v.dateFormat()), nil
+}
+
+// nestedMonthDirs returns the YYYY/MM subdirectories of root.
+func nestedMonthDirs(root string) ([]string, error) {
+	years, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", root, err)
+	}
+
+	var dirs []string
+	for _, year := range years {
+		if _, err := time.Parse("2006", year.Name()); err != nil || !year.IsDir() {
+			continue
+		}
+
+		yearDir := filepath.Join(root, year.Name())
+		months, err := os.ReadDir(yearDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read directory %s: %w", yearDir, err)
+		}
+		for _, month := range months {
+			if _, err := time.Parse("01", month.Name()); err == nil && month.IsDir() {
+				dirs = append(dirs, filepath.Join(yearDir, month.Name()))
+			}
+		}
+	}
+
+	return dirs, nil
+}
+
+// MigrateTo moves every entry from the vault's current layout into layout
+// and switches the vault to it, returning how many files were moved.
+// Nothing is moved if any destination already exists. Year and month
+// directories emptied by moving to the flat layout are removed.
+// Learn: os.Rename moves a file without copying when it stays on one filesystem.
+// See: https://pkg.go.dev/os#Rename
+func (v *Vault) MigrateTo(layout Layout) (int, error) {
+	plan, err := v.PlanMigration(layout)
+	if err != nil {
+		return 0, err
+	}
+
+	moved, err := plan.apply()
+	if err != nil {
+		return moved, err
+	}
+
+	v.Layout, _ = ParseLayout(string(layout))
+	return moved, nil
+}
 
-	return mdFiles, nil
+// MoveEntry renames the entry for from to the date to, for an entry filed
+// under the wrong day. Both dates must be YYYY-MM-DD; the source must exist
+// and the destination must not. The content is left as it is.
+func (v *Vault) MoveEntry(from, to string) error {
+	plan, err := v.PlanMove(from, to)
+	if err != nil {
+		return err
+	}
+
+	if _, err := plan.apply(); err != nil {
+		return fmt.Errorf("failed to move entry %s: %w", from, err)
+	}
+	return nil
+}
+
+// HasHeading reports whether the entry's heading, its first non-blank line
+// after any front matter, is "# text".
+func (v *Vault) HasHeading(date, text string) (bool, error) {
+	content, err := v.ReadEntry(date)
+	if err != nil {
+		return false, err
+	}
+
+	lines := strings.Split(string(content), "\n")
+	i := headingLine(lines)
+	return i >= 0 && strings.TrimSpace(lines[i]) == "# "+text, nil
+}
+
+// RewriteHeading replaces the entry's heading with "# to" when it reads
+// "# from", reporting whether anything changed. Front matter and the rest
+// of the entry are left as they are. It pairs with MoveEntry so a moved
+// entry's heading can follow its new date.
+func (v *Vault) RewriteHeading(date, from, to string) (bool, error) {
+	content, err := v.ReadEntry(date)
+	if err != nil {
+		return false, err
+	}
+
+	lines := strings.Split(string(content), "\n")
+	i := headingLine(lines)
+	if i < 0 || strings.TrimSpace(lines[i]) != "# "+from {
+		return false, nil
+	}
+
+	lines[i] = "# " + to
+	if err := v.WriteEntry(date, []byte(strings.Join(lines, "\n"))); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ListEntriesInRange returns entry filenames whose date falls within the
+// inclusive range [start, end], sorted newest first. Both bounds use the
+// YYYY-MM-DD format; an empty bound leaves that side of the range open.
+func (v *Vault) ListEntriesInRange(start, end string) ([]string, error) {
+	dates, err := parseDateRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	filenames, err := v.ListEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	inRange := make([]string, 0, len(filenames))
+	for _, filename := range filenames {
+		date, _ := parseEntryDate(filename, DefaultDateFormat)
+		if dates.contains(date) {
+			inRange = append(inRange, filename)
+		}
+	}
+
+	return inRange, nil
+}
+
+// dateRange is an inclusive range of entry dates. A zero bound leaves that
+// side of the range open.
+type dateRange struct {
+	start, end time.Time
+}
+
+// parseDateRange parses YYYY-MM-DD bounds, either of which may be empty.
+func parseDateRange(start, end string) (dateRange, error) {
+	var r dateRange
+	var err error
+
+	if start != "" {
+		if r.start, err = time.Parse(DefaultDateFormat, start); err != nil {
+			return r, fmt.Errorf("invalid start date %s (expected YYYY-MM-DD)", start)
+		}
+	}
+	if end != "" {
+		if r.end, err = time.Parse(DefaultDateFormat, end); err != nil {
+			return r, fmt.Errorf("invalid end date %s (expected YYYY-MM-DD)", end)
+		}
+	}
+	if start != "" && end != "" && r.start.After(r.end) {
+		return r, fmt.Errorf("start date %s is after end date %s", start, end)
+	}
+	return r, nil
+}
+
+// contains reports whether date falls within the range.
+func (r dateRange) contains(date time.Time) bool {
+	if !r.start.IsZero() && date.Before(r.start) {
+		return false
+	}
+	if !r.end.IsZero() && date.After(r.end) {
+		return false
+	}
+	return true
 }
 
 // ListEntriesInfo returns metadata for all journal entries sorted by date (newest first).
-// This includes both existing and non-existing entries for comprehensive listing.
+// Size and ModTime come from the directory read; a file removed after the
+// read is reported with Exists false, as GetEntryInfo would.
 func (v *Vault) ListEntriesInfo() ([]EntryInfo, error) {
+	var entries []EntryInfo
+	err := v.WalkEntries(func(info EntryInfo) error {
+		entries = append(entries, info)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// WalkEntries calls fn with the metadata of each journal entry, newest
+// first, without collecting them into a slice. Only one directory is held
+// in memory at a time, so with the nested layout memory stays flat however
+// many years the vault spans; the flat layout reads its one directory
+// whole. A non-nil error from fn stops the walk and is returned as is.
+// Learn: Callback iteration lets the caller decide what to keep.
+// See: https://pkg.go.dev/path/filepath#WalkDir
+func (v *Vault) WalkEntries(fn func(EntryInfo) error) error {
+	dirs := []string{v.Directory}
+	if v.Layout == LayoutNested {
+		var err error
+		if dirs, err = nestedMonthDirs(v.Directory); err != nil {
+			return err
+		}
+		// Year and month names sort by date, so newest months come last
+		slices.Reverse(dirs)
+	}
+
+	for _, dir := range dirs {
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			return fmt.Errorf("failed to read directory %s: %w", dir, err)
+		}
+
+		// Sorting the names keeps the directory order, which sorts quickly
+		names := make([]string, 0, len(files))
+		byName := make(map[string]fs.DirEntry, len(files))
+		for _, file := range files {
+			if !file.IsDir() {
+				names = append(names, file.Name())
+				byName[file.Name()] = file
+			}
+		}
+		names = sortEntriesNewestFirst(names, v.dateFormat())
+
+		// Build metadata from the directory read instead of statting each file
+		for _, name := range names {
+			info := EntryInfo{
+				Date: strings.TrimSuffix(v.entryName(name), ".md"),
+				Path: filepath.Join(dir, name),
+			}
+			if stat, err := byName[name].Info(); err == nil {
+				info.Exists = true
+				info.Size = stat.Size()
+				info.ModTime = stat.ModTime()
+			}
+			if err := fn(info); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// EntriesModifiedSince returns metadata for entries whose files were
+// modified after t, most recently modified first. It filters the directory
+// metadata WalkEntries reads, so no entry is opened.
+// See: https://pkg.go.dev/sort#SliceStable
+func (v *Vault) EntriesModifiedSince(t time.Time) ([]EntryInfo, error) {
+	var modified []EntryInfo
+	err := v.WalkEntries(func(entry EntryInfo) error {
+		if entry.Exists && entry.ModTime.After(t) {
+			modified = append(modified, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Entries are newest date first already; the stable sort keeps that
+	// order for files modified at the same moment
+	sort.SliceStable(modified, func(i, j int) bool {
+		return modified[i].ModTime.After(modified[j].ModTime)
+	})
+	return modified, nil
+}
+
+// ExistingDates returns the set of dates (YYYY-MM-DD) that have an entry on disk.
+// Learn: A map with bool values is the idiomatic way to represent a set in Go.
+// See: https://go.dev/blog/maps
+func (v *Vault) ExistingDates() (map[string]bool, error) {
 	filenames, err := v.ListEntries()
 	if err != nil {
 		return nil, err
 	}
 
-	entries := make([]EntryInfo, 0, len(filenames))
+	dates := make(map[string]bool, len(filenames))
 	for _, filename := range filenames {
-		date := strings.TrimSuffix(filename, ".md")
-		entries = append(entries, v.GetEntryInfo(date))
+		dates[strings.TrimSuffix(filename, ".md")] = true
 	}
 
-	return entries, nil
+	return dates, nil
 }
 
-// isValidDateFormat checks if filename matches YYYY-MM-DD.md pattern.
+// isValidDateFormat checks if filename is an entry filename for the date
+// layout format, e.g. YYYY-MM-DD.md for DefaultDateFormat.
 // Learn: Helper functions should be unexported (lowercase) when used only within the package.
 // See: https://go.dev/doc/effective_go#names
-func isValidDateFormat(filename string) bool {
+func isValidDateFormat(filename, format string) bool {
+	_, ok := parseEntryDate(filename, format)
+	return ok
+}
+
+// parseEntryDate parses an entry filename (date + ".md") using the given layout.
+// Returns false for non-markdown files or names that don't match the layout.
+func parseEntryDate(filename, layout string) (time.Time, bool) {
 	if !strings.HasSuffix(filename, ".md") {
-		return false
+		return time.Time{}, false
 	}
-	datePart := strings.TrimSuffix(filename, ".md")
-	_, err := time.Parse("2006-01-02", datePart)
-	return err == nil
+	date, err := time.Parse(layout, strings.TrimSuffix(filename, ".md"))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// sortEntriesNewestFirst drops filenames that don't parse with layout and
+// orders the rest by their parsed date, newest first. Names may include
+// directories; only the base name is parsed. Comparing parsed times
+// rather than strings keeps the order correct for non-ISO layouts such as
+// DD-MM-YYYY. The sort is stable, so equal dates keep their input order.
+// Learn: sort.SliceStable preserves the relative order of equal elements.
+// See: https://pkg.go.dev/sort#SliceStable
+func sortEntriesNewestFirst(filenames []string, layout string) []string {
+	type datedFile struct {
+		name string
+		date time.Time
+	}
+
+	dated := make([]datedFile, 0, len(filenames))
+	for _, name := range filenames {
+		if date, ok := parseEntryDate(filepath.Base(name), layout); ok {
+			dated = append(dated, datedFile{name: name, date: date})
+		}
+	}
+
+	sort.SliceStable(dated, func(i, j int) bool {
+		return dated[i].date.After(dated[j].date)
+	})
+
+	sorted := make([]string, len(dated))
+	for i, file := range dated {
+		sorted[i] = file.name
+	}
+	return sorted
 }
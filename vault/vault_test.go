@@ -1,8 +1,10 @@
 package vault
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -54,6 +56,42 @@ func TestNewWithInvalidPath(t *testing.T) {
 	}
 }
 
+// TestOpen verifies Open uses existing directories and never creates one.
+func TestOpen(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := Open(tmpDir)
+	if err != nil {
+		t.Fatalf("Open() failed: %v", err)
+	}
+	if vault.Directory != tmpDir || vault.Layout != LayoutFlat {
+		t.Errorf("Expected a flat vault at %s, got %+v", tmpDir, *vault)
+	}
+
+	missing := filepath.Join(tmpDir, "typo")
+	if _, err := Open(missing); !errors.Is(err, ErrNoDirectory) {
+		t.Errorf("Expected ErrNoDirectory for a missing directory, got %v", err)
+	}
+	if _, err := os.Stat(missing); !os.IsNotExist(err) {
+		t.Error("Open() should not create the directory")
+	}
+
+	file := filepath.Join(tmpDir, "file.md")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if _, err := Open(file); err == nil {
+		t.Error("Expected error opening a file as a vault")
+	}
+	if _, err := OpenWithLayout(tmpDir, "yearly"); err == nil {
+		t.Error("Expected error for an invalid layout")
+	}
+}
+
 // TestTodayPath verifies that TodayPath returns the correct format.
 // Learn: Subtests allow organizing related test cases using t.Run().
 // See: https://pkg.go.dev/testing#T.Run
@@ -79,6 +117,21 @@ func TestTodayPath(t *testing.T) {
 	if filepath.Base(todayPath) != expectedSuffix {
 		t.Errorf("Expected filename %s, got %s", expectedSuffix, filepath.Base(todayPath))
 	}
+
+	// A Location dates today in that timezone instead of local time
+	loc := time.FixedZone("UTC+14", 14*60*60)
+	vault.Location = loc
+	expectedSuffix = time.Now().In(loc).Format("2006-01-02.md")
+	if filepath.Base(vault.TodayPath()) != expectedSuffix {
+		t.Errorf("Expected filename %s in UTC+14, got %s", expectedSuffix, filepath.Base(vault.TodayPath()))
+	}
+
+	// Before DayStartHour the date is still the previous day's
+	vault.DayStartHour = 23
+	expectedSuffix = time.Now().In(loc).Add(-23 * time.Hour).Format("2006-01-02.md")
+	if filepath.Base(vault.TodayPath()) != expectedSuffix {
+		t.Errorf("Expected filename %s with the day starting at 23:00, got %s", expectedSuffix, filepath.Base(vault.TodayPath()))
+	}
 }
 
 // TestDatePath verifies that DatePath returns correct paths for specific dates.
@@ -196,8 +249,8 @@ func TestReadEntry(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error when reading non-existent entry")
 	}
-	if !strings.Contains(err.Error(), "does not exist") {
-		t.Errorf("Expected 'does not exist' error, got: %v", err)
+	if !errors.Is(err, ErrEntryNotFound) || err.Error() != "entry 2024-01-15 does not exist" {
+		t.Errorf("Expected ErrEntryNotFound, got: %v", err)
 	}
 
 	// Create the entry
@@ -267,6 +320,126 @@ func TestWriteEntry(t *testing.T) {
 	}
 }
 
+// TestWriteEntryAtomic verifies readers never observe a partially written
+// entry: every read during a large overwrite sees the old or the new content.
+func TestWriteEntryAtomic(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	testDate := "2024-01-15"
+	oldContent := "# Old Entry\n\nOriginal content.\n"
+	newContent := "# New Entry\n\n" + strings.Repeat("A much longer line of new content.\n", 200000)
+
+	if err := vault.WriteEntry(testDate, []byte(oldContent)); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+
+	// Read continuously while the large write is in progress
+	done := make(chan error)
+	go func() {
+		done <- vault.WriteEntry(testDate, []byte(newContent))
+	}()
+
+	path := vault.DatePath(testDate)
+	for writing := true; writing; {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("Failed to overwrite entry: %v", err)
+			}
+			writing = false
+		default:
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Failed to read entry during write: %v", err)
+		}
+		if got := string(content); got != oldContent && got != newContent {
+			t.Fatalf("Read a partial entry of %d bytes", len(got))
+		}
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read entry: %v", err)
+	}
+	if string(content) != newContent {
+		t.Error("Expected the new content after the write completed")
+	}
+
+	// Permissions are preserved and no temp files are left behind
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Failed to stat entry: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0644 {
+		t.Errorf("Expected permissions 0644, got %o", perm)
+	}
+	files, err := os.ReadDir(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to read vault directory: %v", err)
+	}
+	if len(files) != 1 {
+		t.Errorf("Expected only the entry file, found %d files", len(files))
+	}
+}
+
+// TestAppendToEntry verifies appending creates the entry once and keeps
+// content newline-terminated.
+func TestAppendToEntry(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	// Missing entries are created from the template first
+	if err := vault.AppendToEntry("2024-01-15", []byte("- first")); err != nil {
+		t.Fatalf("AppendToEntry() failed: %v", err)
+	}
+	if err := vault.AppendToEntry("2024-01-15", []byte("- second\n")); err != nil {
+		t.Fatalf("AppendToEntry() failed: %v", err)
+	}
+
+	content, err := vault.ReadEntry("2024-01-15")
+	if err != nil {
+		t.Fatalf("Failed to read entry: %v", err)
+	}
+	expected := "# 2024-01-15\n\n- first\n- second\n"
+	if string(content) != expected {
+		t.Errorf("Expected content %q, got %q", expected, string(content))
+	}
+
+	// Existing entries without a trailing newline get one before the new content
+	if err := vault.WriteEntry("2024-01-16", []byte("# Notes")); err != nil {
+		t.Fatalf("Failed to write entry: %v", err)
+	}
+	if err := vault.AppendToEntry("2024-01-16", []byte("- item")); err != nil {
+		t.Fatalf("AppendToEntry() failed: %v", err)
+	}
+	content, err = vault.ReadEntry("2024-01-16")
+	if err != nil {
+		t.Fatalf("Failed to read entry: %v", err)
+	}
+	if string(content) != "# Notes\n- item\n" {
+		t.Errorf("Expected newline-separated content, got %q", string(content))
+	}
+}
+
 // TestCreateEntry verifies entry creation with template.
 func TestCreateEntry(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
@@ -309,6 +482,41 @@ func TestCreateEntry(t *testing.T) {
 	}
 }
 
+// TestCreateBlankEntry verifies that blank entries are created empty.
+func TestCreateBlankEntry(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	testDate := "2024-01-15"
+
+	if err := vault.CreateBlankEntry(testDate); err != nil {
+		t.Fatalf("Failed to create blank entry: %v", err)
+	}
+
+	content, err := vault.ReadEntry(testDate)
+	if err != nil {
+		t.Fatalf("Failed to read blank entry: %v", err)
+	}
+
+	if len(content) != 0 {
+		t.Errorf("Expected empty content, got %q", string(content))
+	}
+
+	// Test creating entry that already exists
+	err = vault.CreateBlankEntry(testDate)
+	if err == nil {
+		t.Error("Expected error when creating existing entry")
+	}
+}
+
 // TestCreateTodayEntry verifies today's entry creation.
 func TestCreateTodayEntry(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
@@ -509,30 +717,624 @@ func TestListEntriesInfo(t *testing.T) {
 	}
 }
 
+// TestWalkEntries verifies entries are streamed newest first in both
+// layouts and that an error from the callback stops the walk.
+func TestWalkEntries(t *testing.T) {
+	dates := []string{"2023-12-31", "2024-01-15", "2024-01-10", "2024-02-01"}
+	expected := []string{"2024-02-01", "2024-01-15", "2024-01-10", "2023-12-31"}
+
+	for _, layout := range []Layout{LayoutFlat, LayoutNested} {
+		t.Run(string(layout), func(t *testing.T) {
+			tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+			if err != nil {
+				t.Fatalf("Failed to create temp dir: %v", err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			vault, err := NewWithLayout(tmpDir, layout)
+			if err != nil {
+				t.Fatalf("NewWithLayout() failed: %v", err)
+			}
+			for _, date := range dates {
+				if err := vault.CreateEntry(date); err != nil {
+					t.Fatalf("Failed to create entry %s: %v", date, err)
+				}
+			}
+
+			var walked []string
+			err = vault.WalkEntries(func(info EntryInfo) error {
+				if info.Path != vault.DatePath(info.Date) || !info.Exists {
+					t.Errorf("Unexpected metadata for %s: %+v", info.Date, info)
+				}
+				walked = append(walked, info.Date)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("WalkEntries() failed: %v", err)
+			}
+			if !reflect.DeepEqual(walked, expected) {
+				t.Errorf("Expected %v, got %v", expected, walked)
+			}
+
+			// Stopping early returns the callback's error
+			stop := errors.New("stop")
+			walked = nil
+			err = vault.WalkEntries(func(info EntryInfo) error {
+				walked = append(walked, info.Date)
+				if len(walked) == 2 {
+					return stop
+				}
+				return nil
+			})
+			if !errors.Is(err, stop) || len(walked) != 2 {
+				t.Errorf("Expected the walk to stop after 2 entries with the callback's error, got %v after %v", err, walked)
+			}
+		})
+	}
+}
+
+// TestEntriesModifiedSince verifies entries are filtered and ordered by modification time.
+func TestEntriesModifiedSince(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	now := time.Now()
+	modTimes := map[string]time.Time{
+		"2024-01-01": now.Add(-time.Hour),      // old entry edited recently
+		"2024-01-02": now.Add(-48 * time.Hour), // untouched for days
+		"2024-01-03": now.Add(-2 * time.Hour),
+	}
+	for date, modTime := range modTimes {
+		if err := vault.CreateEntry(date); err != nil {
+			t.Fatalf("CreateEntry(%s) failed: %v", date, err)
+		}
+		if err := os.Chtimes(vault.DatePath(date), modTime, modTime); err != nil {
+			t.Fatalf("Chtimes(%s) failed: %v", date, err)
+		}
+	}
+
+	entries, err := vault.EntriesModifiedSince(now.Add(-24 * time.Hour))
+	if err != nil {
+		t.Fatalf("EntriesModifiedSince() failed: %v", err)
+	}
+	var dates []string
+	for _, entry := range entries {
+		dates = append(dates, entry.Date)
+	}
+	if expected := []string{"2024-01-01", "2024-01-03"}; !reflect.DeepEqual(dates, expected) {
+		t.Errorf("Expected %v (most recently modified first), got %v", expected, dates)
+	}
+
+	if entries, err := vault.EntriesModifiedSince(now); err != nil || len(entries) != 0 {
+		t.Errorf("EntriesModifiedSince(now) = %v, %v; expected none", entries, err)
+	}
+}
+
+// TestListEntriesInfoMatchesGetEntryInfo verifies metadata from the directory
+// read agrees with statting each entry individually.
+func TestListEntriesInfoMatchesGetEntryInfo(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	for _, layout := range []Layout{LayoutFlat, LayoutNested} {
+		vault, err := NewWithLayout(filepath.Join(tmpDir, string(layout)), layout)
+		if err != nil {
+			t.Fatalf("NewWithLayout() failed: %v", err)
+		}
+		if err := vault.WriteEntry("2024-01-15", []byte("# Short")); err != nil {
+			t.Fatalf("WriteEntry() failed: %v", err)
+		}
+		if err := vault.WriteEntry("2024-02-01", []byte("# A longer entry body")); err != nil {
+			t.Fatalf("WriteEntry() failed: %v", err)
+		}
+
+		entries, err := vault.ListEntriesInfo()
+		if err != nil {
+			t.Fatalf("ListEntriesInfo() failed: %v", err)
+		}
+		if len(entries) != 2 {
+			t.Fatalf("%s: expected 2 entries, got %d", layout, len(entries))
+		}
+
+		for _, entry := range entries {
+			want := vault.GetEntryInfo(entry.Date)
+			if entry.Path != want.Path || entry.Exists != want.Exists ||
+				entry.Size != want.Size || !entry.ModTime.Equal(want.ModTime) {
+				t.Errorf("%s: ListEntriesInfo() = %+v, GetEntryInfo() = %+v", layout, entry, want)
+			}
+		}
+	}
+}
+
 // TestIsValidDateFormat verifies the date format validation function.
 func TestIsValidDateFormat(t *testing.T) {
 	testCases := []struct {
 		filename string
+		format   string
 		valid    bool
 	}{
-		{"2024-01-15.md", true},
-		{"2023-12-31.md", true},
-		{"2024-02-29.md", true},  // Leap year
-		{"2023-02-29.md", false}, // Not a leap year
-		{"2024-13-01.md", false}, // Invalid month
-		{"2024-01-32.md", false}, // Invalid day
-		{"not-a-date.md", false},
-		{"2024-01-15.txt", false}, // Wrong extension
-		{"README.md", false},
-		{"", false},
+		{"2024-01-15.md", DefaultDateFormat, true},
+		{"2023-12-31.md", DefaultDateFormat, true},
+		{"2024-02-29.md", DefaultDateFormat, true},  // Leap year
+		{"2023-02-29.md", DefaultDateFormat, false}, // Not a leap year
+		{"2024-13-01.md", DefaultDateFormat, false}, // Invalid month
+		{"2024-01-32.md", DefaultDateFormat, false}, // Invalid day
+		{"not-a-date.md", DefaultDateFormat, false},
+		{"2024-01-15.txt", DefaultDateFormat, false}, // Wrong extension
+		{"README.md", DefaultDateFormat, false},
+		{"", DefaultDateFormat, false},
+		{"2024_01_15.md", "2006_01_02", true},
+		{"2024-01-15.md", "2006_01_02", false},
+		{"2024-01-15-Monday.md", "2006-01-02-Monday", true},
+		{"2024-01-15.md", "2006-01-02-Monday", false},
 	}
 
 	for _, tc := range testCases {
-		t.Run(tc.filename, func(t *testing.T) {
-			result := isValidDateFormat(tc.filename)
+		t.Run(tc.format+"/"+tc.filename, func(t *testing.T) {
+			result := isValidDateFormat(tc.filename, tc.format)
 			if result != tc.valid {
-				t.Errorf("isValidDateFormat(%s) = %v, expected %v", tc.filename, result, tc.valid)
+				t.Errorf("isValidDateFormat(%s, %s) = %v, expected %v", tc.filename, tc.format, result, tc.valid)
 			}
 		})
 	}
 }
+
+// TestDateFormat verifies alternative filename formats are used for paths
+// and listings while dates stay YYYY-MM-DD.
+func TestDateFormat(t *testing.T) {
+	testCases := []struct {
+		format   string
+		layout   Layout
+		expected string // path of the 2024-01-15 entry relative to the vault
+	}{
+		{"2006_01_02", LayoutFlat, "2024_01_15.md"},
+		{"02.01.2006", LayoutFlat, "15.01.2024.md"},
+		{"2006-01-02-Monday", LayoutNested, filepath.Join("2024", "01", "2024-01-15-Monday.md")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+			if err != nil {
+				t.Fatalf("Failed to create temp dir: %v", err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			vault, err := NewWithLayout(tmpDir, tc.layout)
+			if err != nil {
+				t.Fatalf("NewWithLayout() failed: %v", err)
+			}
+			if vault.DateFormat, err = ParseDateFormat(tc.format); err != nil {
+				t.Fatalf("ParseDateFormat(%q) failed: %v", tc.format, err)
+			}
+
+			for _, date := range []string{"2023-12-31", "2024-01-15", "2024-01-02"} {
+				if err := vault.CreateEntry(date); err != nil {
+					t.Fatalf("CreateEntry(%s) failed: %v", date, err)
+				}
+			}
+			// A default-format file doesn't match and is ignored
+			if err := os.WriteFile(filepath.Join(tmpDir, "2024-02-01.md"), []byte("# stray\n"), 0644); err != nil {
+				t.Fatalf("Failed to write file: %v", err)
+			}
+
+			if got := vault.DatePath("2024-01-15"); got != filepath.Join(tmpDir, tc.expected) {
+				t.Errorf("Expected DatePath %s, got %s", filepath.Join(tmpDir, tc.expected), got)
+			}
+			if _, err := os.Stat(filepath.Join(tmpDir, tc.expected)); err != nil {
+				t.Errorf("Expected the entry on disk at %s: %v", tc.expected, err)
+			}
+
+			// Listings report YYYY-MM-DD names, newest first
+			expected := []string{"2024-01-15.md", "2024-01-02.md", "2023-12-31.md"}
+			if got, err := vault.ListEntries(); err != nil || !reflect.DeepEqual(got, expected) {
+				t.Errorf("ListEntries() = %v, %v; expected %v", got, err, expected)
+			}
+			if got, err := vault.ListEntriesInRange("2024-01-01", "2024-01-31"); err != nil || !reflect.DeepEqual(got, expected[:2]) {
+				t.Errorf("ListEntriesInRange() = %v, %v; expected %v", got, err, expected[:2])
+			}
+
+			infos, err := vault.ListEntriesInfo()
+			if err != nil || len(infos) != 3 {
+				t.Fatalf("ListEntriesInfo() = %v, %v; expected 3 entries", infos, err)
+			}
+			if infos[0].Date != "2024-01-15" || infos[0].Path != vault.DatePath("2024-01-15") {
+				t.Errorf("Expected the newest entry to be 2024-01-15 at its DatePath, got %+v", infos[0])
+			}
+
+			content, err := vault.ReadEntry("2024-01-15")
+			if err != nil || string(content) != "# 2024-01-15\n\n" {
+				t.Errorf("ReadEntry() = %q, %v; expected the templated entry", content, err)
+			}
+		})
+	}
+}
+
+// TestParseDateFormat verifies unusable filename layouts are rejected.
+func TestParseDateFormat(t *testing.T) {
+	if format, err := ParseDateFormat(""); err != nil || format != DefaultDateFormat {
+		t.Errorf("ParseDateFormat(\"\") = %q, %v; expected the default", format, err)
+	}
+
+	for _, format := range []string{"2006-01", "01-02", "journal", "2006/01/02"} {
+		if _, err := ParseDateFormat(format); err == nil {
+			t.Errorf("Expected ParseDateFormat(%q) to fail", format)
+		}
+	}
+}
+
+// TestSortEntriesNewestFirst verifies date-aware ordering for ISO and non-ISO layouts.
+func TestSortEntriesNewestFirst(t *testing.T) {
+	testCases := []struct {
+		name      string
+		layout    string
+		filenames []string
+		expected  []string
+	}{
+		{
+			name:      "ISO",
+			layout:    DefaultDateFormat,
+			filenames: []string{"2024-01-02.md", "2023-12-31.md", "README.md", "2024-01-10.md"},
+			expected:  []string{"2024-01-10.md", "2024-01-02.md", "2023-12-31.md"},
+		},
+		{
+			// String comparison would put 31-12-2023 first
+			name:      "DayMonthYear",
+			layout:    "02-01-2006",
+			filenames: []string{"31-12-2023.md", "02-01-2024.md", "10-01-2024.md", "2024-01-05.md"},
+			expected:  []string{"10-01-2024.md", "02-01-2024.md", "31-12-2023.md"},
+		},
+		{
+			name:      "Empty",
+			layout:    DefaultDateFormat,
+			filenames: nil,
+			expected:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sorted := sortEntriesNewestFirst(tc.filenames, tc.layout)
+			if strings.Join(sorted, ",") != strings.Join(tc.expected, ",") {
+				t.Errorf("Expected %v, got %v", tc.expected, sorted)
+			}
+		})
+	}
+}
+
+// TestListEntriesInRange verifies inclusive and open-ended date range filtering.
+func TestListEntriesInRange(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	for _, date := range []string{"2023-12-31", "2024-01-01", "2024-01-15", "2024-01-31", "2024-02-01"} {
+		if err := vault.WriteEntry(date, []byte("# "+date)); err != nil {
+			t.Fatalf("Failed to write entry %s: %v", date, err)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		start    string
+		end      string
+		expected []string
+	}{
+		{"Month", "2024-01-01", "2024-01-31", []string{"2024-01-31.md", "2024-01-15.md", "2024-01-01.md"}},
+		{"OpenStart", "", "2024-01-01", []string{"2024-01-01.md", "2023-12-31.md"}},
+		{"OpenEnd", "2024-01-31", "", []string{"2024-02-01.md", "2024-01-31.md"}},
+		{"SingleDay", "2024-01-15", "2024-01-15", []string{"2024-01-15.md"}},
+		{"NoMatches", "2025-01-01", "2025-12-31", []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := vault.ListEntriesInRange(tc.start, tc.end)
+			if err != nil {
+				t.Fatalf("ListEntriesInRange() failed: %v", err)
+			}
+			if strings.Join(entries, ",") != strings.Join(tc.expected, ",") {
+				t.Errorf("Expected %v, got %v", tc.expected, entries)
+			}
+		})
+	}
+
+	// Invalid input
+	if _, err := vault.ListEntriesInRange("2024-13-01", ""); err == nil {
+		t.Error("Expected error for invalid start date")
+	}
+	if _, err := vault.ListEntriesInRange("", "yesterday"); err == nil {
+		t.Error("Expected error for invalid end date")
+	}
+	if _, err := vault.ListEntriesInRange("2024-02-01", "2024-01-01"); err == nil {
+		t.Error("Expected error when start is after end")
+	}
+}
+
+// TestListEntriesPage tests paging through entries newest first.
+func TestListEntriesPage(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	for _, date := range []string{"2024-01-01", "2024-01-02", "2024-01-03", "2024-01-04", "2024-01-05"} {
+		if err := vault.WriteEntry(date, []byte("# "+date)); err != nil {
+			t.Fatalf("Failed to write entry %s: %v", date, err)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		offset   int
+		limit    int
+		expected []string
+	}{
+		{"FirstPage", 0, 2, []string{"2024-01-05.md", "2024-01-04.md"}},
+		{"MiddlePage", 2, 2, []string{"2024-01-03.md", "2024-01-02.md"}},
+		{"LastPartialPage", 4, 2, []string{"2024-01-01.md"}},
+		{"LimitPastEnd", 0, 10, []string{"2024-01-05.md", "2024-01-04.md", "2024-01-03.md", "2024-01-02.md", "2024-01-01.md"}},
+		{"OffsetAtEnd", 5, 2, []string{}},
+		{"OffsetPastEnd", 50, 2, []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			page, total, err := vault.ListEntriesPage(tc.offset, tc.limit)
+			if err != nil {
+				t.Fatalf("ListEntriesPage() failed: %v", err)
+			}
+			if total != 5 {
+				t.Errorf("Expected a total of 5, got %d", total)
+			}
+			if page == nil || strings.Join(page, ",") != strings.Join(tc.expected, ",") {
+				t.Errorf("Expected %v, got %v", tc.expected, page)
+			}
+		})
+	}
+
+	// Invalid bounds
+	if _, _, err := vault.ListEntriesPage(-1, 2); err == nil {
+		t.Error("Expected error for a negative offset")
+	}
+	if _, _, err := vault.ListEntriesPage(0, 0); err == nil {
+		t.Error("Expected error for a zero limit")
+	}
+}
+
+// TestNestedLayout verifies entries are stored and listed in year/month directories.
+func TestNestedLayout(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := NewWithLayout(tmpDir, LayoutNested)
+	if err != nil {
+		t.Fatalf("NewWithLayout() failed: %v", err)
+	}
+
+	expectedPath := filepath.Join(vault.Directory, "2024", "01", "2024-01-15.md")
+	if path := vault.DatePath("2024-01-15"); path != expectedPath {
+		t.Errorf("Expected nested path %s, got %s", expectedPath, path)
+	}
+
+	for _, date := range []string{"2024-01-15", "2023-12-31"} {
+		if err := vault.CreateEntry(date); err != nil {
+			t.Fatalf("CreateEntry() failed: %v", err)
+		}
+	}
+	if !vault.EntryExists("2024-01-15") {
+		t.Error("Expected nested entry to exist")
+	}
+	if content, err := vault.ReadEntry("2023-12-31"); err != nil || string(content) != "# 2023-12-31\n\n" {
+		t.Errorf("Expected nested entry content, got %q (err %v)", content, err)
+	}
+
+	// Stray files outside YYYY/MM directories are ignored
+	if err := os.WriteFile(filepath.Join(tmpDir, "2024-02-01.md"), []byte("flat"), 0644); err != nil {
+		t.Fatalf("Failed to write stray file: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(tmpDir, "notes", "01"), 0700); err != nil {
+		t.Fatalf("Failed to create stray dir: %v", err)
+	}
+
+	entries, err := vault.ListEntries()
+	if err != nil {
+		t.Fatalf("ListEntries() failed: %v", err)
+	}
+	if strings.Join(entries, ",") != "2024-01-15.md,2023-12-31.md" {
+		t.Errorf("Expected nested entries newest first, got %v", entries)
+	}
+
+	if _, err := NewWithLayout(tmpDir, "yearly"); err == nil {
+		t.Error("Expected error for unknown layout")
+	}
+}
+
+// TestMigrateTo verifies entries move between layouts and back.
+func TestMigrateTo(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+	for _, date := range []string{"2024-01-15", "2024-02-01"} {
+		if err := vault.CreateEntry(date); err != nil {
+			t.Fatalf("CreateEntry() failed: %v", err)
+		}
+	}
+
+	moved, err := vault.MigrateTo(LayoutNested)
+	if err != nil || moved != 2 {
+		t.Fatalf("MigrateTo(nested) moved %d entries (err %v), expected 2", moved, err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "2024", "02", "2024-02-01.md")); err != nil {
+		t.Errorf("Expected entry in nested directory: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "2024-02-01.md")); !os.IsNotExist(err) {
+		t.Error("Expected flat file to be moved away")
+	}
+
+	moved, err = vault.MigrateTo(LayoutFlat)
+	if err != nil || moved != 2 {
+		t.Fatalf("MigrateTo(flat) moved %d entries (err %v), expected 2", moved, err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "2024")); !os.IsNotExist(err) {
+		t.Error("Expected empty year directory to be removed")
+	}
+	if !vault.EntryExists("2024-01-15") {
+		t.Error("Expected entry back in flat layout")
+	}
+
+	// Existing destination files are never overwritten
+	if err := os.MkdirAll(filepath.Join(tmpDir, "2024", "01"), 0700); err != nil {
+		t.Fatalf("Failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "2024", "01", "2024-01-15.md"), []byte("other"), 0644); err != nil {
+		t.Fatalf("Failed to write conflicting file: %v", err)
+	}
+	if _, err := vault.MigrateTo(LayoutNested); err == nil {
+		t.Error("Expected error when destination exists")
+	}
+}
+
+// TestListEntriesRecursive verifies entries are found in any subdirectory.
+func TestListEntriesRecursive(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() failed: %v", err)
+	}
+
+	files := []string{
+		"2024-01-10.md",
+		filepath.Join("travel", "2024-01-12.md"),
+		filepath.Join("2023", "12", "2023-12-31.md"),
+		filepath.Join(".archive", "2024-01-20.md"), // Hidden directory
+		filepath.Join("travel", "packing-list.md"), // Not a date
+		filepath.Join("travel", "2024-01-11.txt"),  // Not markdown
+	}
+	for _, file := range files {
+		path := filepath.Join(tmpDir, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatalf("Failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("# Entry"), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+
+	entries, err := vault.ListEntriesRecursive()
+	if err != nil {
+		t.Fatalf("ListEntriesRecursive() failed: %v", err)
+	}
+
+	expected := []string{
+		filepath.Join("travel", "2024-01-12.md"),
+		"2024-01-10.md",
+		filepath.Join("2023", "12", "2023-12-31.md"),
+	}
+	if strings.Join(entries, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected %v, got %v", expected, entries)
+	}
+
+	// The flat listing is unchanged
+	flat, err := vault.ListEntries()
+	if err != nil {
+		t.Fatalf("ListEntries() failed: %v", err)
+	}
+	if len(flat) != 1 || flat[0] != "2024-01-10.md" {
+		t.Errorf("Expected ListEntries to only see top-level entries, got %v", flat)
+	}
+}
+
+// TestMoveEntry tests renaming an entry to another date.
+func TestMoveEntry(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "logmd-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	vault, err := NewWithLayout(tmpDir, LayoutNested)
+	if err != nil {
+		t.Fatalf("NewWithLayout() failed: %v", err)
+	}
+	for _, date := range []string{"2024-01-15", "2024-03-01"} {
+		if err := vault.CreateEntry(date); err != nil {
+			t.Fatalf("CreateEntry() failed: %v", err)
+		}
+	}
+
+	errorCases := []struct{ from, to string }{
+		{"2024-1-15", "2024-02-15"},  // invalid source date
+		{"2024-01-15", "tomorrow"},   // invalid destination date
+		{"2024-01-16", "2024-02-15"}, // missing source
+		{"2024-01-15", "2024-03-01"}, // existing destination
+	}
+	for _, tc := range errorCases {
+		if err := vault.MoveEntry(tc.from, tc.to); err == nil {
+			t.Errorf("MoveEntry(%s, %s) should fail", tc.from, tc.to)
+		}
+	}
+
+	if err := vault.MoveEntry("2024-01-15", "2024-02-15"); err != nil {
+		t.Fatalf("MoveEntry() failed: %v", err)
+	}
+	if vault.EntryExists("2024-01-15") || !vault.EntryExists("2024-02-15") {
+		t.Error("Expected the entry to move to 2024-02-15")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "2024", "01")); !os.IsNotExist(err) {
+		t.Error("Expected the emptied month directory to be removed")
+	}
+
+	// The heading follows the new date only when it still names the old one
+	changed, err := vault.RewriteHeading("2024-02-15", "2024-01-15", "2024-02-15")
+	if err != nil || !changed {
+		t.Fatalf("RewriteHeading() = %v, %v; expected a change", changed, err)
+	}
+	if content, _ := vault.ReadEntry("2024-02-15"); string(content) != "# 2024-02-15\n\n" {
+		t.Errorf("Expected rewritten heading, got %q", content)
+	}
+
+	changed, err = vault.RewriteHeading("2024-03-01", "2024-01-15", "2024-03-01")
+	if err != nil || changed {
+		t.Errorf("RewriteHeading() = %v, %v; expected no change for another heading", changed, err)
+	}
+}